@@ -21,6 +21,8 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 
 	mygroupv1alpha1 "github.com/crossplane/provider-grpc/apis/mygroup/v1alpha1"
+	mygroupv1alpha2 "github.com/crossplane/provider-grpc/apis/mygroup/v1alpha2"
+	mygroupv1alpha3 "github.com/crossplane/provider-grpc/apis/mygroup/v1alpha3"
 	grpcv1alpha1 "github.com/crossplane/provider-grpc/apis/v1alpha1"
 )
 
@@ -29,6 +31,8 @@ func init() {
 	AddToSchemes = append(AddToSchemes,
 		grpcv1alpha1.SchemeBuilder.AddToScheme,
 		mygroupv1alpha1.SchemeBuilder.AddToScheme,
+		mygroupv1alpha2.SchemeBuilder.AddToScheme,
+		mygroupv1alpha3.SchemeBuilder.AddToScheme,
 	)
 }
 
@@ -20,11 +20,86 @@ import (
 	"reflect"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 )
 
+// Access determines who may see a list on the backend.
+// +kubebuilder:validation:Enum=Private;Public
+type Access string
+
+// Supported list access levels.
+const (
+	AccessPrivate Access = "Private"
+	AccessPublic  Access = "Public"
+)
+
+// A DeletePolicy determines whether deleting a GrpcKind waits for the backend
+// to finish deleting its list.
+// +kubebuilder:validation:Enum=Blocking;Async
+type DeletePolicy string
+
+// Supported delete policies.
+const (
+	// DeleteBlocking keeps the GrpcKind until the backend no longer reports
+	// its list.
+	DeleteBlocking DeletePolicy = "Blocking"
+
+	// DeleteAsync forgets the GrpcKind as soon as the backend accepts the
+	// deletion of its list.
+	DeleteAsync DeletePolicy = "Async"
+)
+
+// A MergeStrategy determines how a GrpcKind's items are reconciled with items
+// other clients added to its list.
+// +kubebuilder:validation:Enum=Replace;Union
+type MergeStrategy string
+
+// Supported merge strategies.
+const (
+	// MergeReplace replaces the backend's items with the GrpcKind's items.
+	MergeReplace MergeStrategy = "Replace"
+
+	// MergeUnion adds the GrpcKind's items to the backend's items, keeping
+	// any items other clients added.
+	MergeUnion MergeStrategy = "Union"
+)
+
+// ListItemMetadata is the metadata of a list item, if the backend supports
+// item metadata.
+type ListItemMetadata struct {
+	// Weight of the item.
+	// +optional
+	Weight *int32 `json:"weight,omitempty"`
+
+	// Labels of the item.
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+}
+
+// A ConfigMapReference references a Kubernetes ConfigMap.
+type ConfigMapReference struct {
+	// Name of the ConfigMap.
+	Name string `json:"name"`
+
+	// Namespace of the ConfigMap.
+	Namespace string `json:"namespace"`
+}
+
+// A ConfigMapKeyReference references a key of a Kubernetes ConfigMap.
+type ConfigMapKeyReference struct {
+	// Name of the ConfigMap.
+	Name string `json:"name"`
+
+	// Namespace of the ConfigMap.
+	Namespace string `json:"namespace"`
+
+	// Key of the ConfigMap's data.
+	Key string `json:"key"`
+}
+
 // GrpcKindParameters are the configurable fields of a GrpcKind.
 type GrpcKindParameters struct {
 	Name string `json:"name"`
@@ -32,11 +107,145 @@ type GrpcKindParameters struct {
 	Description *string `json:"description,omitempty"`
 	// +optional
 	ListItems []int32 `json:"listItems,omitempty"`
+	// ListItemsFromRef references a ConfigMap key whose value is a JSON
+	// array of the list's items, e.g. [1, 2, 3]. The items are read each
+	// reconcile, in place of ListItems, so that changes to the ConfigMap
+	// are applied to the list.
+	// +optional
+	ListItemsFromRef *ConfigMapKeyReference `json:"listItemsFromRef,omitempty"`
+	// ListItemMetadata is the metadata of the item of ListItems at the same
+	// index, if the backend supports item metadata. Metadata without a
+	// corresponding item is ignored.
+	// +optional
+	ListItemMetadata []ListItemMetadata `json:"listItemMetadata,omitempty"`
+	// StructuredListItems are the items of the list when they aren't all
+	// int32s, in which case ListItems is empty and ListItemMetadata is the
+	// metadata of the item of StructuredListItems at the same index. Each
+	// item may be any JSON value, if the backend supports structured items.
+	// +kubebuilder:validation:Schemaless
+	// +kubebuilder:pruning:PreserveUnknownFields
+	// +optional
+	StructuredListItems []runtime.RawExtension `json:"structuredListItems,omitempty"`
+	// Access determines who may see the list, if the backend supports
+	// per-list access control.
+	// +optional
+	Access *Access `json:"access,omitempty"`
+	// Owner is the team or user that owns the list, if the backend supports
+	// labelling lists with their owner.
+	// +optional
+	Owner *string `json:"owner,omitempty"`
+	// Tenant is the tenant the list belongs to, if the backend partitions
+	// lists by tenant. Lists of different tenants may have the same name.
+	// +optional
+	Tenant *string `json:"tenant,omitempty"`
+	// DeletePolicy determines whether deleting the GrpcKind waits for the
+	// backend to finish deleting the list.
+	// +kubebuilder:default=Blocking
+	// +optional
+	DeletePolicy *DeletePolicy `json:"deletePolicy,omitempty"`
+	// MergeStrategy determines whether updating the list replaces its items
+	// or keeps items other clients added to it.
+	// +kubebuilder:default=Replace
+	// +optional
+	MergeStrategy *MergeStrategy `json:"mergeStrategy,omitempty"`
+	// TTL is how long the backend should keep the list before expiring it,
+	// if the backend supports auto-expiring lists.
+	// +optional
+	TTL *metav1.Duration `json:"ttl,omitempty"`
+	// ExportConfigMapRef references a ConfigMap the list's observed items
+	// are written to, for other workloads to consume. The ConfigMap is
+	// created if it doesn't exist.
+	// +optional
+	ExportConfigMapRef *ConfigMapReference `json:"exportConfigMapRef,omitempty"`
 }
 
 // GrpcKindObservation are the observable fields of a GrpcKind.
 type GrpcKindObservation struct {
 	Status string `json:"status"`
+	// Description is the description last applied to the backend list.
+	// +optional
+	Description *string `json:"description,omitempty"`
+	// CreatedAt is when the backend reports the list was created.
+	// +optional
+	CreatedAt *metav1.Time `json:"createdAt,omitempty"`
+	// UpdatedAt is when the backend reports the list was last updated.
+	// +optional
+	UpdatedAt *metav1.Time `json:"updatedAt,omitempty"`
+	// Compression is the compression the backend used when it last
+	// responded with the list, e.g. gzip, or identity if it didn't compress
+	// its response.
+	// +optional
+	Compression string `json:"compression,omitempty"`
+	// RetryCount is the number of consecutive failed attempts to create or
+	// update the list.
+	// +optional
+	RetryCount int32 `json:"retryCount,omitempty"`
+	// NextRetryTime is when the list may next be created or updated, after
+	// failed attempts.
+	// +optional
+	NextRetryTime *metav1.Time `json:"nextRetryTime,omitempty"`
+	// AddedItems are the items the list most recently gained between two
+	// observations of it.
+	// +optional
+	AddedItems []int32 `json:"addedItems,omitempty"`
+	// RemovedItems are the items the list most recently lost between two
+	// observations of it.
+	// +optional
+	RemovedItems []int32 `json:"removedItems,omitempty"`
+	// BackendVersion is the version the backend reported when the list was
+	// last observed.
+	// +optional
+	BackendVersion string `json:"backendVersion,omitempty"`
+	// RejectedGeneration is the generation of the spec whose items the
+	// backend rejected as duplicates. The list isn't applied again until the
+	// spec changes.
+	// +optional
+	RejectedGeneration *int64 `json:"rejectedGeneration,omitempty"`
+	// FailedItems are the items the backend reported it failed to apply when
+	// the list was last updated. Only they are retried.
+	// +optional
+	FailedItems []int32 `json:"failedItems,omitempty"`
+	// Summary summarizes the most useful observations of the list.
+	// +optional
+	Summary *GrpcKindSummary `json:"summary,omitempty"`
+	// RPCHistory is the most recent calls made to the backend for the list,
+	// oldest first, for post-mortems. Only the last 10 calls are kept.
+	// +optional
+	RPCHistory []RPCAttempt `json:"rpcHistory,omitempty"`
+	// QuotaUsed is how much of its quota the list's tenant had used when the
+	// list was last observed, as reported by the backend.
+	// +optional
+	QuotaUsed *int64 `json:"quotaUsed,omitempty"`
+	// QuotaLimit is the quota of the list's tenant when the list was last
+	// observed, as reported by the backend.
+	// +optional
+	QuotaLimit *int64 `json:"quotaLimit,omitempty"`
+}
+
+// An RPCAttempt is a call made to the backend for a GrpcKind's list.
+type RPCAttempt struct {
+	// Method is the RPC that was called, e.g. GetList.
+	Method string `json:"method"`
+	// Code is the gRPC status code the call returned, e.g. OK or NotFound.
+	Code string `json:"code"`
+	// Time is when the call was made.
+	Time metav1.Time `json:"time"`
+}
+
+// A GrpcKindSummary summarizes the most useful observations of a GrpcKind's
+// backend list, so they may be consumed from one place.
+type GrpcKindSummary struct {
+	// Status is the status the backend reported for the list.
+	// +optional
+	Status string `json:"status,omitempty"`
+	// ItemCount is the number of items the list had.
+	ItemCount int32 `json:"itemCount"`
+	// LastReconcileTime is when the list was observed.
+	// +optional
+	LastReconcileTime *metav1.Time `json:"lastReconcileTime,omitempty"`
+	// BackendVersion is the version the backend reported.
+	// +optional
+	BackendVersion string `json:"backendVersion,omitempty"`
 }
 
 // A GrpcKindSpec defines the desired state of a GrpcKind.
@@ -58,7 +267,10 @@ type GrpcKindStatus struct {
 // +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
 // +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
 // +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:printcolumn:name="CREATED-AT",type="date",JSONPath=".status.atProvider.createdAt",priority=1
+// +kubebuilder:printcolumn:name="UPDATED-AT",type="date",JSONPath=".status.atProvider.updatedAt",priority=1
 // +kubebuilder:subresource:status
+// +kubebuilder:storageversion
 // +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,grpc}
 type GrpcKind struct {
 	metav1.TypeMeta   `json:",inline"`
@@ -0,0 +1,66 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// +kubebuilder:object:root=true
+
+// A NamespacedGrpcKind is a namespace scoped GrpcKind, for clusters whose
+// tenants are isolated by namespace using RBAC. It has the same spec and status
+// as a GrpcKind.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:printcolumn:name="CREATED-AT",type="date",JSONPath=".status.atProvider.createdAt",priority=1
+// +kubebuilder:printcolumn:name="UPDATED-AT",type="date",JSONPath=".status.atProvider.updatedAt",priority=1
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Namespaced,categories={crossplane,managed,grpc}
+type NamespacedGrpcKind struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   GrpcKindSpec   `json:"spec"`
+	Status GrpcKindStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// NamespacedGrpcKindList contains a list of NamespacedGrpcKind
+type NamespacedGrpcKindList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []NamespacedGrpcKind `json:"items"`
+}
+
+// NamespacedGrpcKind type metadata.
+var (
+	NamespacedGrpcKindKind             = reflect.TypeOf(NamespacedGrpcKind{}).Name()
+	NamespacedGrpcKindGroupKind        = schema.GroupKind{Group: Group, Kind: NamespacedGrpcKindKind}.String()
+	NamespacedGrpcKindKindAPIVersion   = NamespacedGrpcKindKind + "." + SchemeGroupVersion.String()
+	NamespacedGrpcKindGroupVersionKind = SchemeGroupVersion.WithKind(NamespacedGrpcKindKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&NamespacedGrpcKind{}, &NamespacedGrpcKindList{})
+}
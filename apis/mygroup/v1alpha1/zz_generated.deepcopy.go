@@ -22,9 +22,40 @@ limitations under the License.
 package v1alpha1
 
 import (
+	"k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigMapKeyReference) DeepCopyInto(out *ConfigMapKeyReference) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigMapKeyReference.
+func (in *ConfigMapKeyReference) DeepCopy() *ConfigMapKeyReference {
+	if in == nil {
+		return nil
+	}
+	out := new(ConfigMapKeyReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigMapReference) DeepCopyInto(out *ConfigMapReference) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigMapReference.
+func (in *ConfigMapReference) DeepCopy() *ConfigMapReference {
+	if in == nil {
+		return nil
+	}
+	out := new(ConfigMapReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GrpcKind) DeepCopyInto(out *GrpcKind) {
 	*out = *in
@@ -87,6 +118,65 @@ func (in *GrpcKindList) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GrpcKindObservation) DeepCopyInto(out *GrpcKindObservation) {
 	*out = *in
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	if in.CreatedAt != nil {
+		in, out := &in.CreatedAt, &out.CreatedAt
+		*out = (*in).DeepCopy()
+	}
+	if in.UpdatedAt != nil {
+		in, out := &in.UpdatedAt, &out.UpdatedAt
+		*out = (*in).DeepCopy()
+	}
+	if in.NextRetryTime != nil {
+		in, out := &in.NextRetryTime, &out.NextRetryTime
+		*out = (*in).DeepCopy()
+	}
+	if in.AddedItems != nil {
+		in, out := &in.AddedItems, &out.AddedItems
+		*out = make([]int32, len(*in))
+		copy(*out, *in)
+	}
+	if in.RemovedItems != nil {
+		in, out := &in.RemovedItems, &out.RemovedItems
+		*out = make([]int32, len(*in))
+		copy(*out, *in)
+	}
+	if in.RejectedGeneration != nil {
+		in, out := &in.RejectedGeneration, &out.RejectedGeneration
+		*out = new(int64)
+		**out = **in
+	}
+	if in.FailedItems != nil {
+		in, out := &in.FailedItems, &out.FailedItems
+		*out = make([]int32, len(*in))
+		copy(*out, *in)
+	}
+	if in.Summary != nil {
+		in, out := &in.Summary, &out.Summary
+		*out = new(GrpcKindSummary)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RPCHistory != nil {
+		in, out := &in.RPCHistory, &out.RPCHistory
+		*out = make([]RPCAttempt, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.QuotaUsed != nil {
+		in, out := &in.QuotaUsed, &out.QuotaUsed
+		*out = new(int64)
+		**out = **in
+	}
+	if in.QuotaLimit != nil {
+		in, out := &in.QuotaLimit, &out.QuotaLimit
+		*out = new(int64)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GrpcKindObservation.
@@ -112,6 +202,60 @@ func (in *GrpcKindParameters) DeepCopyInto(out *GrpcKindParameters) {
 		*out = make([]int32, len(*in))
 		copy(*out, *in)
 	}
+	if in.ListItemsFromRef != nil {
+		in, out := &in.ListItemsFromRef, &out.ListItemsFromRef
+		*out = new(ConfigMapKeyReference)
+		**out = **in
+	}
+	if in.ListItemMetadata != nil {
+		in, out := &in.ListItemMetadata, &out.ListItemMetadata
+		*out = make([]ListItemMetadata, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.StructuredListItems != nil {
+		in, out := &in.StructuredListItems, &out.StructuredListItems
+		*out = make([]runtime.RawExtension, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Access != nil {
+		in, out := &in.Access, &out.Access
+		*out = new(Access)
+		**out = **in
+	}
+	if in.Owner != nil {
+		in, out := &in.Owner, &out.Owner
+		*out = new(string)
+		**out = **in
+	}
+	if in.Tenant != nil {
+		in, out := &in.Tenant, &out.Tenant
+		*out = new(string)
+		**out = **in
+	}
+	if in.DeletePolicy != nil {
+		in, out := &in.DeletePolicy, &out.DeletePolicy
+		*out = new(DeletePolicy)
+		**out = **in
+	}
+	if in.MergeStrategy != nil {
+		in, out := &in.MergeStrategy, &out.MergeStrategy
+		*out = new(MergeStrategy)
+		**out = **in
+	}
+	if in.TTL != nil {
+		in, out := &in.TTL, &out.TTL
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.ExportConfigMapRef != nil {
+		in, out := &in.ExportConfigMapRef, &out.ExportConfigMapRef
+		*out = new(ConfigMapReference)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GrpcKindParameters.
@@ -145,7 +289,7 @@ func (in *GrpcKindSpec) DeepCopy() *GrpcKindSpec {
 func (in *GrpcKindStatus) DeepCopyInto(out *GrpcKindStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
-	out.AtProvider = in.AtProvider
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GrpcKindStatus.
@@ -157,3 +301,124 @@ func (in *GrpcKindStatus) DeepCopy() *GrpcKindStatus {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GrpcKindSummary) DeepCopyInto(out *GrpcKindSummary) {
+	*out = *in
+	if in.LastReconcileTime != nil {
+		in, out := &in.LastReconcileTime, &out.LastReconcileTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GrpcKindSummary.
+func (in *GrpcKindSummary) DeepCopy() *GrpcKindSummary {
+	if in == nil {
+		return nil
+	}
+	out := new(GrpcKindSummary)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ListItemMetadata) DeepCopyInto(out *ListItemMetadata) {
+	*out = *in
+	if in.Weight != nil {
+		in, out := &in.Weight, &out.Weight
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ListItemMetadata.
+func (in *ListItemMetadata) DeepCopy() *ListItemMetadata {
+	if in == nil {
+		return nil
+	}
+	out := new(ListItemMetadata)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NamespacedGrpcKind) DeepCopyInto(out *NamespacedGrpcKind) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NamespacedGrpcKind.
+func (in *NamespacedGrpcKind) DeepCopy() *NamespacedGrpcKind {
+	if in == nil {
+		return nil
+	}
+	out := new(NamespacedGrpcKind)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NamespacedGrpcKind) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NamespacedGrpcKindList) DeepCopyInto(out *NamespacedGrpcKindList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]NamespacedGrpcKind, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NamespacedGrpcKindList.
+func (in *NamespacedGrpcKindList) DeepCopy() *NamespacedGrpcKindList {
+	if in == nil {
+		return nil
+	}
+	out := new(NamespacedGrpcKindList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NamespacedGrpcKindList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RPCAttempt) DeepCopyInto(out *RPCAttempt) {
+	*out = *in
+	in.Time.DeepCopyInto(&out.Time)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RPCAttempt.
+func (in *RPCAttempt) DeepCopy() *RPCAttempt {
+	if in == nil {
+		return nil
+	}
+	out := new(RPCAttempt)
+	in.DeepCopyInto(out)
+	return out
+}
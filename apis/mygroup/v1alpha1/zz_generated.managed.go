@@ -84,3 +84,69 @@ func (mg *GrpcKind) SetPublishConnectionDetailsTo(r *xpv1.PublishConnectionDetai
 func (mg *GrpcKind) SetWriteConnectionSecretToReference(r *xpv1.SecretReference) {
 	mg.Spec.WriteConnectionSecretToReference = r
 }
+
+// GetCondition of this NamespacedGrpcKind.
+func (mg *NamespacedGrpcKind) GetCondition(ct xpv1.ConditionType) xpv1.Condition {
+	return mg.Status.GetCondition(ct)
+}
+
+// GetDeletionPolicy of this NamespacedGrpcKind.
+func (mg *NamespacedGrpcKind) GetDeletionPolicy() xpv1.DeletionPolicy {
+	return mg.Spec.DeletionPolicy
+}
+
+// GetProviderConfigReference of this NamespacedGrpcKind.
+func (mg *NamespacedGrpcKind) GetProviderConfigReference() *xpv1.Reference {
+	return mg.Spec.ProviderConfigReference
+}
+
+/*
+GetProviderReference of this NamespacedGrpcKind.
+Deprecated: Use GetProviderConfigReference.
+*/
+func (mg *NamespacedGrpcKind) GetProviderReference() *xpv1.Reference {
+	return mg.Spec.ProviderReference
+}
+
+// GetPublishConnectionDetailsTo of this NamespacedGrpcKind.
+func (mg *NamespacedGrpcKind) GetPublishConnectionDetailsTo() *xpv1.PublishConnectionDetailsTo {
+	return mg.Spec.PublishConnectionDetailsTo
+}
+
+// GetWriteConnectionSecretToReference of this NamespacedGrpcKind.
+func (mg *NamespacedGrpcKind) GetWriteConnectionSecretToReference() *xpv1.SecretReference {
+	return mg.Spec.WriteConnectionSecretToReference
+}
+
+// SetConditions of this NamespacedGrpcKind.
+func (mg *NamespacedGrpcKind) SetConditions(c ...xpv1.Condition) {
+	mg.Status.SetConditions(c...)
+}
+
+// SetDeletionPolicy of this NamespacedGrpcKind.
+func (mg *NamespacedGrpcKind) SetDeletionPolicy(r xpv1.DeletionPolicy) {
+	mg.Spec.DeletionPolicy = r
+}
+
+// SetProviderConfigReference of this NamespacedGrpcKind.
+func (mg *NamespacedGrpcKind) SetProviderConfigReference(r *xpv1.Reference) {
+	mg.Spec.ProviderConfigReference = r
+}
+
+/*
+SetProviderReference of this NamespacedGrpcKind.
+Deprecated: Use SetProviderConfigReference.
+*/
+func (mg *NamespacedGrpcKind) SetProviderReference(r *xpv1.Reference) {
+	mg.Spec.ProviderReference = r
+}
+
+// SetPublishConnectionDetailsTo of this NamespacedGrpcKind.
+func (mg *NamespacedGrpcKind) SetPublishConnectionDetailsTo(r *xpv1.PublishConnectionDetailsTo) {
+	mg.Spec.PublishConnectionDetailsTo = r
+}
+
+// SetWriteConnectionSecretToReference of this NamespacedGrpcKind.
+func (mg *NamespacedGrpcKind) SetWriteConnectionSecretToReference(r *xpv1.SecretReference) {
+	mg.Spec.WriteConnectionSecretToReference = r
+}
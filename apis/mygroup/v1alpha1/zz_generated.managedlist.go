@@ -27,3 +27,12 @@ func (l *GrpcKindList) GetItems() []resource.Managed {
 	}
 	return items
 }
+
+// GetItems of this NamespacedGrpcKindList.
+func (l *NamespacedGrpcKindList) GetItems() []resource.Managed {
+	items := make([]resource.Managed, len(l.Items))
+	for i := range l.Items {
+		items[i] = &l.Items[i]
+	}
+	return items
+}
@@ -0,0 +1,191 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha2
+
+import (
+	"encoding/json"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/conversion"
+
+	"github.com/crossplane/provider-grpc/apis/mygroup/v1alpha1"
+)
+
+const (
+	errNotGrpcKind            = "hub is not a v1alpha1 GrpcKind"
+	errMarshalStructuredItems = "cannot marshal structured list items"
+	errRestoreStructuredItems = "cannot restore structured list items"
+)
+
+// structuredItemsAnnotation holds the structured list items of a hub, and
+// their metadata, on a GrpcKind converted from it, because this version can't
+// represent them. They're restored when the GrpcKind is converted back, unless
+// it has items of its own by then, so that clients of this version don't wipe
+// them by updating the GrpcKind.
+const structuredItemsAnnotation = "mygroup.grpc.crossplane.io/v1alpha2-structured-list-items"
+
+// structuredItems are the value of the structuredItemsAnnotation.
+type structuredItems struct {
+	Items    []runtime.RawExtension      `json:"items"`
+	Metadata []v1alpha1.ListItemMetadata `json:"metadata,omitempty"`
+}
+
+// ConvertTo converts this GrpcKind to the v1alpha1 hub version. Item values
+// become the hub's plain list items, and their weights and labels are kept in
+// the index-aligned listItemMetadata so no information is lost. Structured
+// items kept in the structuredItemsAnnotation are restored if the GrpcKind has
+// no items.
+func (in *GrpcKind) ConvertTo(dst conversion.Hub) error {
+	hub, ok := dst.(*v1alpha1.GrpcKind)
+	if !ok {
+		return errors.New(errNotGrpcKind)
+	}
+	hub.ObjectMeta = *in.ObjectMeta.DeepCopy()
+	in.Spec.ResourceSpec.DeepCopyInto(&hub.Spec.ResourceSpec)
+
+	p := in.Spec.ForProvider.DeepCopy()
+	hub.Spec.ForProvider = v1alpha1.GrpcKindParameters{
+		Name:               p.Name,
+		Description:        p.Description,
+		Access:             p.Access,
+		Owner:              p.Owner,
+		Tenant:             p.Tenant,
+		DeletePolicy:       p.DeletePolicy,
+		MergeStrategy:      p.MergeStrategy,
+		TTL:                p.TTL,
+		ExportConfigMapRef: p.ExportConfigMapRef,
+		ListItemsFromRef:   p.ListItemsFromRef,
+	}
+	hub.Spec.ForProvider.ListItems, hub.Spec.ForProvider.ListItemMetadata = toHubItems(p.ListItems)
+	if err := restoreStructuredItems(hub, len(p.ListItems) == 0); err != nil {
+		return err
+	}
+
+	in.Status.ResourceStatus.DeepCopyInto(&hub.Status.ResourceStatus)
+	in.Status.AtProvider.DeepCopyInto(&hub.Status.AtProvider)
+	return nil
+}
+
+// ConvertFrom converts the v1alpha1 hub version to this GrpcKind. Structured
+// list items can't be represented by this version, so a hub that has them
+// converts to a GrpcKind without items, which keeps them and their metadata in
+// the structuredItemsAnnotation.
+func (in *GrpcKind) ConvertFrom(src conversion.Hub) error {
+	hub, ok := src.(*v1alpha1.GrpcKind)
+	if !ok {
+		return errors.New(errNotGrpcKind)
+	}
+	in.ObjectMeta = *hub.ObjectMeta.DeepCopy()
+	hub.Spec.ResourceSpec.DeepCopyInto(&in.Spec.ResourceSpec)
+
+	p := hub.Spec.ForProvider.DeepCopy()
+	in.Spec.ForProvider = GrpcKindParameters{
+		Name:               p.Name,
+		Description:        p.Description,
+		ListItems:          fromHubItems(p.ListItems, p.ListItemMetadata),
+		Access:             p.Access,
+		Owner:              p.Owner,
+		Tenant:             p.Tenant,
+		DeletePolicy:       p.DeletePolicy,
+		MergeStrategy:      p.MergeStrategy,
+		TTL:                p.TTL,
+		ExportConfigMapRef: p.ExportConfigMapRef,
+		ListItemsFromRef:   p.ListItemsFromRef,
+	}
+	if len(p.StructuredListItems) > 0 {
+		b, err := json.Marshal(structuredItems{Items: p.StructuredListItems, Metadata: p.ListItemMetadata})
+		if err != nil {
+			return errors.Wrap(err, errMarshalStructuredItems)
+		}
+		a := in.GetAnnotations()
+		if a == nil {
+			a = map[string]string{}
+		}
+		a[structuredItemsAnnotation] = string(b)
+		in.SetAnnotations(a)
+	}
+
+	hub.Status.ResourceStatus.DeepCopyInto(&in.Status.ResourceStatus)
+	hub.Status.AtProvider.DeepCopyInto(&in.Status.AtProvider)
+	return nil
+}
+
+// restoreStructuredItems removes the structuredItemsAnnotation from the
+// supplied hub, and restores the structured items it holds if restore is true.
+func restoreStructuredItems(hub *v1alpha1.GrpcKind, restore bool) error {
+	a := hub.GetAnnotations()
+	v, ok := a[structuredItemsAnnotation]
+	if !ok {
+		return nil
+	}
+	delete(a, structuredItemsAnnotation)
+	if len(a) == 0 {
+		a = nil
+	}
+	hub.SetAnnotations(a)
+
+	if !restore {
+		return nil
+	}
+	si := structuredItems{}
+	if err := json.Unmarshal([]byte(v), &si); err != nil {
+		return errors.Wrap(err, errRestoreStructuredItems)
+	}
+	hub.Spec.ForProvider.StructuredListItems, hub.Spec.ForProvider.ListItemMetadata = si.Items, si.Metadata
+	return nil
+}
+
+// toHubItems splits items into their values and their metadata. Trailing
+// items without a weight or labels are trimmed from the metadata, which is nil
+// when no item has any.
+func toHubItems(items []ListItem) ([]int32, []v1alpha1.ListItemMetadata) {
+	if items == nil {
+		return nil, nil
+	}
+	values := make([]int32, len(items))
+	md := make([]v1alpha1.ListItemMetadata, len(items))
+	last := -1
+	for i, it := range items {
+		values[i] = it.Value
+		md[i] = v1alpha1.ListItemMetadata{Weight: it.Weight, Labels: it.Labels}
+		if it.Weight != nil || len(it.Labels) > 0 {
+			last = i
+		}
+	}
+	if last < 0 {
+		return values, nil
+	}
+	return values, md[:last+1]
+}
+
+// fromHubItems joins item values with their metadata. Metadata entries beyond
+// the last value are ignored.
+func fromHubItems(values []int32, md []v1alpha1.ListItemMetadata) []ListItem {
+	if values == nil {
+		return nil
+	}
+	items := make([]ListItem, len(values))
+	for i, v := range values {
+		items[i] = ListItem{Value: v}
+		if i < len(md) {
+			items[i].Weight = md[i].Weight
+			items[i].Labels = md[i].Labels
+		}
+	}
+	return items
+}
@@ -0,0 +1,212 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha2
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/pointer"
+
+	"github.com/crossplane/provider-grpc/apis/mygroup/v1alpha1"
+)
+
+func TestConvertTo(t *testing.T) {
+	cases := map[string]struct {
+		reason string
+		items  []ListItem
+		want   v1alpha1.GrpcKindParameters
+	}{
+		"NoItems": {
+			reason: "A GrpcKind without items should convert to a hub without items or metadata.",
+			want:   v1alpha1.GrpcKindParameters{Name: "list"},
+		},
+		"PlainItems": {
+			reason: "Items without weights or labels should convert to plain values without metadata.",
+			items:  []ListItem{{Value: 1}, {Value: 2}},
+			want:   v1alpha1.GrpcKindParameters{Name: "list", ListItems: []int32{1, 2}},
+		},
+		"LabelledItems": {
+			reason: "Item weights and labels should be kept in index-aligned metadata, trimming trailing empty entries.",
+			items: []ListItem{
+				{Value: 1},
+				{Value: 2, Weight: pointer.Int32(5), Labels: map[string]string{"tier": "gold"}},
+				{Value: 3},
+			},
+			want: v1alpha1.GrpcKindParameters{
+				Name:      "list",
+				ListItems: []int32{1, 2, 3},
+				ListItemMetadata: []v1alpha1.ListItemMetadata{
+					{},
+					{Weight: pointer.Int32(5), Labels: map[string]string{"tier": "gold"}},
+				},
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			in := &GrpcKind{
+				ObjectMeta: metav1.ObjectMeta{Name: "cool"},
+				Spec:       GrpcKindSpec{ForProvider: GrpcKindParameters{Name: "list", ListItems: tc.items}},
+			}
+			hub := &v1alpha1.GrpcKind{}
+			if err := in.ConvertTo(hub); err != nil {
+				t.Fatalf("\n%s\nConvertTo(...): unexpected error: %v", tc.reason, err)
+			}
+			if diff := cmp.Diff(tc.want, hub.Spec.ForProvider); diff != "" {
+				t.Errorf("\n%s\nConvertTo(...): -want params, +got params:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff("cool", hub.GetName()); diff != "" {
+				t.Errorf("\n%s\nConvertTo(...): -want name, +got name:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestConvertFrom(t *testing.T) {
+	cases := map[string]struct {
+		reason string
+		hub    v1alpha1.GrpcKindParameters
+		want   []ListItem
+	}{
+		"NoItems": {
+			reason: "A hub without items should convert to a GrpcKind without items.",
+			hub:    v1alpha1.GrpcKindParameters{Name: "list"},
+		},
+		"PlainItems": {
+			reason: "Plain v1alpha1 items should convert to items without weights or labels.",
+			hub:    v1alpha1.GrpcKindParameters{Name: "list", ListItems: []int32{1, 2}},
+			want:   []ListItem{{Value: 1}, {Value: 2}},
+		},
+		"ExtraMetadata": {
+			reason: "Metadata beyond the last item value should be ignored.",
+			hub: v1alpha1.GrpcKindParameters{
+				Name:             "list",
+				ListItems:        []int32{1},
+				ListItemMetadata: []v1alpha1.ListItemMetadata{{Weight: pointer.Int32(2)}, {Weight: pointer.Int32(3)}},
+			},
+			want: []ListItem{{Value: 1, Weight: pointer.Int32(2)}},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			hub := &v1alpha1.GrpcKind{Spec: v1alpha1.GrpcKindSpec{ForProvider: tc.hub}}
+			got := &GrpcKind{}
+			if err := got.ConvertFrom(hub); err != nil {
+				t.Fatalf("\n%s\nConvertFrom(...): unexpected error: %v", tc.reason, err)
+			}
+			if diff := cmp.Diff(tc.want, got.Spec.ForProvider.ListItems); diff != "" {
+				t.Errorf("\n%s\nConvertFrom(...): -want items, +got items:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestConvertRoundTrip(t *testing.T) {
+	want := &GrpcKind{
+		ObjectMeta: metav1.ObjectMeta{Name: "cool"},
+		Spec: GrpcKindSpec{ForProvider: GrpcKindParameters{
+			Name:        "list",
+			Description: pointer.String("things"),
+			Tenant:      pointer.String("acme"),
+			ListItems: []ListItem{
+				{Value: 1, Labels: map[string]string{"a": "b"}},
+				{Value: 2, Weight: pointer.Int32(3)},
+				{Value: 4},
+			},
+			TTL:              &metav1.Duration{},
+			ListItemsFromRef: &v1alpha1.ConfigMapKeyReference{Name: "items", Namespace: "default", Key: "items"},
+		}},
+		Status: GrpcKindStatus{AtProvider: v1alpha1.GrpcKindObservation{Status: "SUCCESS", AddedItems: []int32{4}}},
+	}
+	hub := &v1alpha1.GrpcKind{}
+	if err := want.ConvertTo(hub); err != nil {
+		t.Fatalf("ConvertTo(...): unexpected error: %v", err)
+	}
+	got := &GrpcKind{}
+	if err := got.ConvertFrom(hub); err != nil {
+		t.Fatalf("ConvertFrom(...): unexpected error: %v", err)
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("ConvertFrom(ConvertTo(...)): -want, +got:\n%s\n", diff)
+	}
+}
+
+func TestConvertHubRoundTrip(t *testing.T) {
+	raw := func(s string) runtime.RawExtension { return runtime.RawExtension{Raw: []byte(s)} }
+	structured := v1alpha1.GrpcKindParameters{
+		Name:                "list",
+		StructuredListItems: []runtime.RawExtension{raw(`"a"`), raw(`{"nested":{"n":1}}`)},
+		ListItemMetadata:    []v1alpha1.ListItemMetadata{{}, {Weight: pointer.Int32(2)}},
+	}
+
+	cases := map[string]struct {
+		reason string
+		hub    v1alpha1.GrpcKindParameters
+		modify func(cr *GrpcKind)
+		want   v1alpha1.GrpcKindParameters
+	}{
+		"StructuredItems": {
+			reason: "Structured items a hub has should survive a round trip through this version.",
+			hub:    structured,
+			modify: func(cr *GrpcKind) { cr.Spec.ForProvider.Description = pointer.String("things") },
+			want: v1alpha1.GrpcKindParameters{
+				Name:                "list",
+				Description:         pointer.String("things"),
+				StructuredListItems: structured.StructuredListItems,
+				ListItemMetadata:    structured.ListItemMetadata,
+			},
+		},
+		"ReplacedItems": {
+			reason: "Items set using this version should replace the hub's structured items.",
+			hub:    structured,
+			modify: func(cr *GrpcKind) { cr.Spec.ForProvider.ListItems = []ListItem{{Value: 1}} },
+			want:   v1alpha1.GrpcKindParameters{Name: "list", ListItems: []int32{1}},
+		},
+		"PlainItems": {
+			reason: "A hub without structured items should round trip unchanged.",
+			hub:    v1alpha1.GrpcKindParameters{Name: "list", ListItems: []int32{1, 2}},
+			modify: func(_ *GrpcKind) {},
+			want:   v1alpha1.GrpcKindParameters{Name: "list", ListItems: []int32{1, 2}},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			hub := &v1alpha1.GrpcKind{
+				ObjectMeta: metav1.ObjectMeta{Name: "cool", Annotations: map[string]string{"cool": "annotation"}},
+				Spec:       v1alpha1.GrpcKindSpec{ForProvider: tc.hub},
+			}
+			cr := &GrpcKind{}
+			if err := cr.ConvertFrom(hub); err != nil {
+				t.Fatalf("\n%s\nConvertFrom(...): unexpected error: %v", tc.reason, err)
+			}
+			tc.modify(cr)
+			got := &v1alpha1.GrpcKind{}
+			if err := cr.ConvertTo(got); err != nil {
+				t.Fatalf("\n%s\nConvertTo(...): unexpected error: %v", tc.reason, err)
+			}
+			if diff := cmp.Diff(tc.want, got.Spec.ForProvider); diff != "" {
+				t.Errorf("\n%s\nConvertTo(ConvertFrom(...)): -want, +got:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(hub.GetAnnotations(), got.GetAnnotations()); diff != "" {
+				t.Errorf("\n%s\nConvertTo(ConvertFrom(...)): -want annotations, +got annotations:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
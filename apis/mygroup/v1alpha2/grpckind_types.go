@@ -0,0 +1,143 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha2
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	"github.com/crossplane/provider-grpc/apis/mygroup/v1alpha1"
+)
+
+// A ListItem is an item of a list, with optional metadata if the backend
+// supports item metadata.
+type ListItem struct {
+	// Value of the item.
+	Value int32 `json:"value"`
+
+	// Weight of the item.
+	// +optional
+	Weight *int32 `json:"weight,omitempty"`
+
+	// Labels of the item.
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+}
+
+// GrpcKindParameters are the configurable fields of a GrpcKind.
+type GrpcKindParameters struct {
+	Name string `json:"name"`
+	// +optional
+	Description *string `json:"description,omitempty"`
+	// ListItems are the items of the list.
+	// +optional
+	ListItems []ListItem `json:"listItems,omitempty"`
+	// ListItemsFromRef references a ConfigMap key whose value is a JSON
+	// array of the list's items, e.g. [1, 2, 3]. The items are read each
+	// reconcile, in place of ListItems, so that changes to the ConfigMap
+	// are applied to the list.
+	// +optional
+	ListItemsFromRef *v1alpha1.ConfigMapKeyReference `json:"listItemsFromRef,omitempty"`
+	// Access determines who may see the list, if the backend supports
+	// per-list access control.
+	// +optional
+	Access *v1alpha1.Access `json:"access,omitempty"`
+	// Owner is the team or user that owns the list, if the backend supports
+	// labelling lists with their owner.
+	// +optional
+	Owner *string `json:"owner,omitempty"`
+	// Tenant is the tenant the list belongs to, if the backend partitions
+	// lists by tenant. Lists of different tenants may have the same name.
+	// +optional
+	Tenant *string `json:"tenant,omitempty"`
+	// DeletePolicy determines whether deleting the GrpcKind waits for the
+	// backend to finish deleting the list.
+	// +kubebuilder:default=Blocking
+	// +optional
+	DeletePolicy *v1alpha1.DeletePolicy `json:"deletePolicy,omitempty"`
+	// MergeStrategy determines whether updating the list replaces its items
+	// or keeps items other clients added to it.
+	// +kubebuilder:default=Replace
+	// +optional
+	MergeStrategy *v1alpha1.MergeStrategy `json:"mergeStrategy,omitempty"`
+	// TTL is how long the backend should keep the list before expiring it,
+	// if the backend supports auto-expiring lists.
+	// +optional
+	TTL *metav1.Duration `json:"ttl,omitempty"`
+	// ExportConfigMapRef references a ConfigMap the list's observed items
+	// are written to, for other workloads to consume. The ConfigMap is
+	// created if it doesn't exist.
+	// +optional
+	ExportConfigMapRef *v1alpha1.ConfigMapReference `json:"exportConfigMapRef,omitempty"`
+}
+
+// A GrpcKindSpec defines the desired state of a GrpcKind.
+type GrpcKindSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       GrpcKindParameters `json:"forProvider"`
+}
+
+// A GrpcKindStatus represents the observed state of a GrpcKind. It's the same
+// in all versions of GrpcKind.
+type GrpcKindStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          v1alpha1.GrpcKindObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A GrpcKind is an example API type.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:printcolumn:name="CREATED-AT",type="date",JSONPath=".status.atProvider.createdAt",priority=1
+// +kubebuilder:printcolumn:name="UPDATED-AT",type="date",JSONPath=".status.atProvider.updatedAt",priority=1
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,grpc}
+type GrpcKind struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   GrpcKindSpec   `json:"spec"`
+	Status GrpcKindStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// GrpcKindList contains a list of GrpcKind
+type GrpcKindList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []GrpcKind `json:"items"`
+}
+
+// GrpcKind type metadata.
+var (
+	GrpcKindKind             = reflect.TypeOf(GrpcKind{}).Name()
+	GrpcKindGroupKind        = schema.GroupKind{Group: Group, Kind: GrpcKindKind}.String()
+	GrpcKindKindAPIVersion   = GrpcKindKind + "." + SchemeGroupVersion.String()
+	GrpcKindGroupVersionKind = SchemeGroupVersion.WithKind(GrpcKindKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&GrpcKind{}, &GrpcKindList{})
+}
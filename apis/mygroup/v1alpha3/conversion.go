@@ -0,0 +1,176 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha3
+
+import (
+	"bytes"
+	"encoding/json"
+	"strconv"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/conversion"
+
+	"github.com/crossplane/provider-grpc/apis/mygroup/v1alpha1"
+)
+
+const errNotGrpcKind = "hub is not a v1alpha1 GrpcKind"
+
+// ConvertTo converts this GrpcKind to the v1alpha1 hub version. Items whose
+// values are all int32s become the hub's plain list items; otherwise they
+// become its structured list items. Their weights and labels are kept in the
+// index-aligned listItemMetadata so no information is lost.
+func (in *GrpcKind) ConvertTo(dst conversion.Hub) error {
+	hub, ok := dst.(*v1alpha1.GrpcKind)
+	if !ok {
+		return errors.New(errNotGrpcKind)
+	}
+	hub.ObjectMeta = *in.ObjectMeta.DeepCopy()
+	in.Spec.ResourceSpec.DeepCopyInto(&hub.Spec.ResourceSpec)
+
+	p := in.Spec.ForProvider.DeepCopy()
+	hub.Spec.ForProvider = v1alpha1.GrpcKindParameters{
+		Name:               p.Name,
+		Description:        p.Description,
+		Access:             p.Access,
+		Owner:              p.Owner,
+		Tenant:             p.Tenant,
+		DeletePolicy:       p.DeletePolicy,
+		MergeStrategy:      p.MergeStrategy,
+		TTL:                p.TTL,
+		ExportConfigMapRef: p.ExportConfigMapRef,
+		ListItemsFromRef:   p.ListItemsFromRef,
+	}
+	hp := &hub.Spec.ForProvider
+	hp.ListItems, hp.StructuredListItems, hp.ListItemMetadata = toHubItems(p.ListItems)
+
+	in.Status.ResourceStatus.DeepCopyInto(&hub.Status.ResourceStatus)
+	in.Status.AtProvider.DeepCopyInto(&hub.Status.AtProvider)
+	return nil
+}
+
+// ConvertFrom converts the v1alpha1 hub version to this GrpcKind.
+func (in *GrpcKind) ConvertFrom(src conversion.Hub) error {
+	hub, ok := src.(*v1alpha1.GrpcKind)
+	if !ok {
+		return errors.New(errNotGrpcKind)
+	}
+	in.ObjectMeta = *hub.ObjectMeta.DeepCopy()
+	hub.Spec.ResourceSpec.DeepCopyInto(&in.Spec.ResourceSpec)
+
+	p := hub.Spec.ForProvider.DeepCopy()
+	in.Spec.ForProvider = GrpcKindParameters{
+		Name:               p.Name,
+		Description:        p.Description,
+		ListItems:          fromHubItems(p.ListItems, p.StructuredListItems, p.ListItemMetadata),
+		Access:             p.Access,
+		Owner:              p.Owner,
+		Tenant:             p.Tenant,
+		DeletePolicy:       p.DeletePolicy,
+		MergeStrategy:      p.MergeStrategy,
+		TTL:                p.TTL,
+		ExportConfigMapRef: p.ExportConfigMapRef,
+		ListItemsFromRef:   p.ListItemsFromRef,
+	}
+
+	hub.Status.ResourceStatus.DeepCopyInto(&in.Status.ResourceStatus)
+	hub.Status.AtProvider.DeepCopyInto(&in.Status.AtProvider)
+	return nil
+}
+
+// toHubItems splits items into their values and their metadata. The values
+// are returned as int32s if they all are, and as structured values otherwise.
+// Trailing items without a weight or labels are trimmed from the metadata,
+// which is nil when no item has any.
+func toHubItems(items []ListItem) ([]int32, []runtime.RawExtension, []v1alpha1.ListItemMetadata) {
+	if items == nil {
+		return nil, nil, nil
+	}
+	values := make([]int32, len(items))
+	structured := make([]runtime.RawExtension, len(items))
+	isInt := true
+	md := make([]v1alpha1.ListItemMetadata, len(items))
+	last := -1
+	for i, it := range items {
+		it.Value.DeepCopyInto(&structured[i])
+		if v, ok := int32Value(it.Value); ok {
+			values[i] = v
+		} else {
+			isInt = false
+		}
+		md[i] = v1alpha1.ListItemMetadata{Weight: it.Weight, Labels: it.Labels}
+		if it.Weight != nil || len(it.Labels) > 0 {
+			last = i
+		}
+	}
+	if last < 0 {
+		md = nil
+	} else {
+		md = md[:last+1]
+	}
+	if isInt {
+		return values, nil, md
+	}
+	return nil, structured, md
+}
+
+// fromHubItems joins item values with their metadata. Structured values are
+// used if there are any, and plain values otherwise. Metadata entries beyond
+// the last value are ignored.
+func fromHubItems(values []int32, structured []runtime.RawExtension, md []v1alpha1.ListItemMetadata) []ListItem {
+	if values == nil && structured == nil {
+		return nil
+	}
+	var items []ListItem
+	if structured != nil {
+		items = make([]ListItem, len(structured))
+		for i := range structured {
+			structured[i].DeepCopyInto(&items[i].Value)
+		}
+	} else {
+		items = make([]ListItem, len(values))
+		for i, v := range values {
+			items[i].Value = runtime.RawExtension{Raw: []byte(strconv.FormatInt(int64(v), 10))}
+		}
+	}
+	for i := range items {
+		if i < len(md) {
+			items[i].Weight = md[i].Weight
+			items[i].Labels = md[i].Labels
+		}
+	}
+	return items
+}
+
+// int32Value returns the supplied value as an int32, if it is one.
+func int32Value(v runtime.RawExtension) (int32, bool) {
+	d := json.NewDecoder(bytes.NewReader(v.Raw))
+	d.UseNumber()
+	var n interface{}
+	if err := d.Decode(&n); err != nil || d.More() {
+		return 0, false
+	}
+	num, ok := n.(json.Number)
+	if !ok {
+		return 0, false
+	}
+	i, err := strconv.ParseInt(num.String(), 10, 32)
+	if err != nil {
+		return 0, false
+	}
+	return int32(i), true
+}
@@ -0,0 +1,173 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha3
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/pointer"
+
+	"github.com/crossplane/provider-grpc/apis/mygroup/v1alpha1"
+)
+
+func raw(s string) runtime.RawExtension {
+	return runtime.RawExtension{Raw: []byte(s)}
+}
+
+func TestConvertTo(t *testing.T) {
+	cases := map[string]struct {
+		reason string
+		items  []ListItem
+		want   v1alpha1.GrpcKindParameters
+	}{
+		"NoItems": {
+			reason: "A GrpcKind without items should convert to a hub without items or metadata.",
+			want:   v1alpha1.GrpcKindParameters{Name: "list"},
+		},
+		"IntegerItems": {
+			reason: "Items that are all int32s should convert to plain values.",
+			items:  []ListItem{{Value: raw("1")}, {Value: raw("-2")}},
+			want:   v1alpha1.GrpcKindParameters{Name: "list", ListItems: []int32{1, -2}},
+		},
+		"StructuredItems": {
+			reason: "Items that aren't all int32s should convert to structured values, keeping their metadata.",
+			items: []ListItem{
+				{Value: raw("1")},
+				{Value: raw(`{"sku":"a-1","qty":2}`), Weight: pointer.Int32(5)},
+			},
+			want: v1alpha1.GrpcKindParameters{
+				Name:                "list",
+				StructuredListItems: []runtime.RawExtension{raw("1"), raw(`{"sku":"a-1","qty":2}`)},
+				ListItemMetadata:    []v1alpha1.ListItemMetadata{{}, {Weight: pointer.Int32(5)}},
+			},
+		},
+		"OutOfRangeItem": {
+			reason: "A number that doesn't fit an int32 should make the items structured.",
+			items:  []ListItem{{Value: raw("1")}, {Value: raw("4294967296")}},
+			want: v1alpha1.GrpcKindParameters{
+				Name:                "list",
+				StructuredListItems: []runtime.RawExtension{raw("1"), raw("4294967296")},
+			},
+		},
+		"FractionalItem": {
+			reason: "A fractional number should make the items structured.",
+			items:  []ListItem{{Value: raw("1.5")}},
+			want: v1alpha1.GrpcKindParameters{
+				Name:                "list",
+				StructuredListItems: []runtime.RawExtension{raw("1.5")},
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			in := &GrpcKind{
+				ObjectMeta: metav1.ObjectMeta{Name: "cool"},
+				Spec:       GrpcKindSpec{ForProvider: GrpcKindParameters{Name: "list", ListItems: tc.items}},
+			}
+			hub := &v1alpha1.GrpcKind{}
+			if err := in.ConvertTo(hub); err != nil {
+				t.Fatalf("\n%s\nConvertTo(...): unexpected error: %v", tc.reason, err)
+			}
+			if diff := cmp.Diff(tc.want, hub.Spec.ForProvider); diff != "" {
+				t.Errorf("\n%s\nConvertTo(...): -want params, +got params:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestConvertFrom(t *testing.T) {
+	cases := map[string]struct {
+		reason string
+		hub    v1alpha1.GrpcKindParameters
+		want   []ListItem
+	}{
+		"NoItems": {
+			reason: "A hub without items should convert to a GrpcKind without items.",
+			hub:    v1alpha1.GrpcKindParameters{Name: "list"},
+		},
+		"IntegerItems": {
+			reason: "Plain v1alpha1 items should convert to JSON numbers.",
+			hub: v1alpha1.GrpcKindParameters{
+				Name:             "list",
+				ListItems:        []int32{1, -2},
+				ListItemMetadata: []v1alpha1.ListItemMetadata{{Labels: map[string]string{"a": "b"}}},
+			},
+			want: []ListItem{{Value: raw("1"), Labels: map[string]string{"a": "b"}}, {Value: raw("-2")}},
+		},
+		"StructuredItems": {
+			reason: "Structured v1alpha1 items should convert as is.",
+			hub: v1alpha1.GrpcKindParameters{
+				Name:                "list",
+				StructuredListItems: []runtime.RawExtension{raw(`"a"`), raw(`{"b":true}`)},
+			},
+			want: []ListItem{{Value: raw(`"a"`)}, {Value: raw(`{"b":true}`)}},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			hub := &v1alpha1.GrpcKind{Spec: v1alpha1.GrpcKindSpec{ForProvider: tc.hub}}
+			got := &GrpcKind{}
+			if err := got.ConvertFrom(hub); err != nil {
+				t.Fatalf("\n%s\nConvertFrom(...): unexpected error: %v", tc.reason, err)
+			}
+			if diff := cmp.Diff(tc.want, got.Spec.ForProvider.ListItems); diff != "" {
+				t.Errorf("\n%s\nConvertFrom(...): -want items, +got items:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestConvertRoundTrip(t *testing.T) {
+	cases := map[string][]ListItem{
+		"IntegerItems": {
+			{Value: raw("1"), Labels: map[string]string{"a": "b"}},
+			{Value: raw("2"), Weight: pointer.Int32(3)},
+		},
+		"StructuredItems": {
+			{Value: raw("1")},
+			{Value: raw(`["x","y"]`), Weight: pointer.Int32(3)},
+			{Value: raw(`{"nested":{"n":1}}`)},
+		},
+	}
+	for name, items := range cases {
+		t.Run(name, func(t *testing.T) {
+			want := &GrpcKind{
+				ObjectMeta: metav1.ObjectMeta{Name: "cool"},
+				Spec: GrpcKindSpec{ForProvider: GrpcKindParameters{
+					Name:      "list",
+					Tenant:    pointer.String("acme"),
+					ListItems: items,
+				}},
+				Status: GrpcKindStatus{AtProvider: v1alpha1.GrpcKindObservation{Status: "SUCCESS"}},
+			}
+			hub := &v1alpha1.GrpcKind{}
+			if err := want.ConvertTo(hub); err != nil {
+				t.Fatalf("ConvertTo(...): unexpected error: %v", err)
+			}
+			got := &GrpcKind{}
+			if err := got.ConvertFrom(hub); err != nil {
+				t.Fatalf("ConvertFrom(...): unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(want, got); diff != "" {
+				t.Errorf("ConvertFrom(ConvertTo(...)): -want, +got:\n%s\n", diff)
+			}
+		})
+	}
+}
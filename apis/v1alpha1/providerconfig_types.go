@@ -29,6 +29,316 @@ import (
 type ProviderConfigSpec struct {
 	// Credentials required to authenticate to this provider.
 	Credentials ProviderCredentials `json:"credentials"`
+
+	// FallbackCredentials are tried in order when the primary Credentials
+	// cannot be extracted, e.g. while migrating between secret stores.
+	// +optional
+	FallbackCredentials []ProviderCredentials `json:"fallbackCredentials,omitempty"`
+
+	// ReadinessCheck makes the provider ask the backend's standard gRPC health
+	// service whether the list service is serving before reconciling a
+	// managed resource. Resources are requeued while it is not.
+	// +optional
+	ReadinessCheck bool `json:"readinessCheck,omitempty"`
+
+	// EmptyStatusIsSuccess makes the provider treat lists the backend reports
+	// without a status as successful, for backends that don't report one. By
+	// default their status is treated as unknown.
+	// +optional
+	EmptyStatusIsSuccess bool `json:"emptyStatusIsSuccess,omitempty"`
+
+	// ReadyStatuses are the list statuses reported by the backend that make
+	// a managed resource Ready, for backends whose lists are usable in
+	// statuses other than SUCCESS. Lists are Ready only when SUCCESS if
+	// unset.
+	// +kubebuilder:default={"SUCCESS"}
+	// +optional
+	ReadyStatuses []string `json:"readyStatuses,omitempty"`
+
+	// SendDescription determines whether list descriptions are sent to the
+	// backend. Disable it for backend versions that reject descriptions, in
+	// which case descriptions are neither sent nor checked for drift.
+	// +kubebuilder:default=true
+	// +optional
+	SendDescription *bool `json:"sendDescription,omitempty"`
+
+	// Reflection makes the provider use gRPC server reflection to discover
+	// which service the backend serves lists with, rather than assuming it is
+	// proto.ListService. The discovered service must have the CreateList,
+	// GetList, UpdateListItems, and DeleteList methods, with messages that are
+	// wire compatible with proto.ListService.
+	// +optional
+	Reflection bool `json:"reflection,omitempty"`
+
+	// MaxListItems is the maximum number of items a list managed using this
+	// ProviderConfig may have. Lists with more items are not sent to the
+	// backend. There is no limit if unset.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	MaxListItems *int32 `json:"maxListItems,omitempty"`
+
+	// ItemTolerance is how much an item of a list managed using this
+	// ProviderConfig may differ from the desired item before the list is
+	// considered to have drifted, e.g. for items that are measurements. Items
+	// must be equal if unset.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	ItemTolerance *int32 `json:"itemTolerance,omitempty"`
+
+	// UpdateChunkSize makes updates to lists with more items than this send
+	// them to UpdateListItems in chunks of at most this many items, rather
+	// than all at once. Each chunk carries x-list-chunk-offset and
+	// x-list-chunk-total metadata, which the backend must support. Chunks the
+	// backend rate limits are retried with backoff, and an update that fails
+	// part way through resumes from its first unapplied chunk.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	UpdateChunkSize *int32 `json:"updateChunkSize,omitempty"`
+
+	// CallTimeout bounds how long each CreateList, GetList, UpdateListItems,
+	// and DeleteList call may take. GrpcKinds may override it using the
+	// grpc.crossplane.io/call-timeout annotation. Calls are only bounded by
+	// the reconcile timeout if unset.
+	// +optional
+	CallTimeout *metav1.Duration `json:"callTimeout,omitempty"`
+
+	// CallTimeoutPerItem is added to the CallTimeout for each item of a list,
+	// so that calls concerning large lists may take longer. It has no effect
+	// unless CallTimeout is set.
+	// +optional
+	CallTimeoutPerItem *metav1.Duration `json:"callTimeoutPerItem,omitempty"`
+
+	// MaxCallTimeout bounds the CallTimeout of a list after it is scaled by
+	// the list's items using CallTimeoutPerItem. The scaled timeout is not
+	// bounded if unset.
+	// +optional
+	MaxCallTimeout *metav1.Duration `json:"maxCallTimeout,omitempty"`
+
+	// CreateWaitTimeout makes creating a list wait up to this long for the
+	// backend to report that it was created successfully, polling GetList,
+	// rather than returning while the list is pending and leaving it to be
+	// observed later. Creating the list fails if it's still pending when the
+	// timeout expires.
+	// +optional
+	CreateWaitTimeout *metav1.Duration `json:"createWaitTimeout,omitempty"`
+
+	// WaitForReady makes calls to the backend wait for it to become available
+	// rather than failing fast while it is unavailable. Calls still wait no
+	// longer than the CallTimeout, or the reconcile timeout if unset.
+	// +optional
+	WaitForReady bool `json:"waitForReady,omitempty"`
+
+	// Endpoint is the gRPC dial target of the backend. It may be a host:port
+	// address or use a scheme gRPC resolves, e.g. dns:///lists.example.org:443
+	// or unix:///var/run/lists.sock for a backend running as a sidecar.
+	// +optional
+	Endpoint *string `json:"endpoint,omitempty"`
+
+	// Resolver is the scheme of the gRPC name resolver that resolves the
+	// Endpoint and ReadEndpoint, e.g. xds in a service mesh, or a custom
+	// scheme a build of the provider registers. Endpoints that already use
+	// the scheme are passed to the resolver unmodified. The resolver must be
+	// registered. Endpoints are resolved using DNS if unset.
+	// +optional
+	Resolver *string `json:"resolver,omitempty"`
+
+	// ReadEndpoint is the gRPC dial target of a read replica of the backend.
+	// It's used only to get lists while observing them, while lists are
+	// created, updated, and deleted using the primary endpoint. It uses the
+	// same credentials and transport settings as the primary endpoint.
+	// +optional
+	ReadEndpoint *string `json:"readEndpoint,omitempty"`
+
+	// ServiceRef references a Kubernetes Service in front of the gRPC backend,
+	// which is dialed using its cluster-internal DNS name. It takes precedence
+	// over Endpoint.
+	// +optional
+	ServiceRef *ServiceReference `json:"serviceRef,omitempty"`
+
+	// Authority overrides the :authority header sent to the gRPC backend,
+	// e.g. to route requests through an ingress that is dialed by IP
+	// address. It doesn't affect the name used to verify the backend's TLS
+	// certificate.
+	// +optional
+	Authority *string `json:"authority,omitempty"`
+
+	// LoadBalancingPolicy determines how requests are balanced across the
+	// addresses the backend's endpoint resolves to. Defaults to pick_first.
+	// +optional
+	LoadBalancingPolicy *LoadBalancingPolicy `json:"loadBalancingPolicy,omitempty"`
+
+	// PreferredIPFamily makes the provider connect to the backend's IPv4 or
+	// IPv6 addresses first, when its endpoint's host has both. Addresses of
+	// the other family are tried if none of the preferred family can be
+	// connected to. Addresses are tried in the order they resolve if unset.
+	// +optional
+	PreferredIPFamily *IPFamily `json:"preferredIPFamily,omitempty"`
+
+	// Compression compresses requests sent to the backend. Backends usually
+	// compress their responses the same way. Requests are not compressed if
+	// unset.
+	// +optional
+	Compression *Compression `json:"compression,omitempty"`
+
+	// TLS configures the provider to connect to the gRPC backend using TLS.
+	// The connection is insecure if unset.
+	// +optional
+	TLS *TLSConfig `json:"tls,omitempty"`
+
+	// ServiceAccountToken makes the provider authenticate each call to the
+	// backend with a bearer token minted for a Kubernetes ServiceAccount, for
+	// backends that validate workload identity. Tokens are refreshed before
+	// they expire. It takes precedence over a token supplied in the
+	// credentials, and requires TLS.
+	// +optional
+	ServiceAccountToken *ServiceAccountTokenConfig `json:"serviceAccountToken,omitempty"`
+
+	// FieldMapping remaps the request fields a managed resource's fields are
+	// sent as, for backends whose messages number their fields differently,
+	// e.g. that identify a list by a key field rather than a name field.
+	// +optional
+	FieldMapping *FieldMapping `json:"fieldMapping,omitempty"`
+
+	// EmptyName determines what happens to managed resources whose list has
+	// no name. Reject reports an error rather than sending an empty name to
+	// the backend. Generate names the list after the managed resource's UID.
+	// Defaults to Reject.
+	// +optional
+	EmptyName *EmptyNamePolicy `json:"emptyName,omitempty"`
+
+	// DefaultDeletionPolicy is the deletion policy of managed resources that
+	// don't specify one, e.g. Orphan to keep a backend's lists when their
+	// managed resources are deleted. It's applied when a managed resource is
+	// first reconciled. Managed resources default to Delete if unset.
+	// +kubebuilder:validation:Enum=Orphan;Delete
+	// +optional
+	DefaultDeletionPolicy *xpv1.DeletionPolicy `json:"defaultDeletionPolicy,omitempty"`
+
+	// CallbackURL is an HTTP endpoint the provider POSTs the outcome of each
+	// call it makes to reconcile a managed resource to, e.g. to notify an
+	// external system that a list was created. Outcomes are posted on a best
+	// effort basis; they are dropped if the endpoint is unavailable.
+	// +kubebuilder:validation:Pattern=`^https?://`
+	// +optional
+	CallbackURL *string `json:"callbackURL,omitempty"`
+}
+
+// An EmptyNamePolicy determines what happens to managed resources whose list
+// has no name.
+// +kubebuilder:validation:Enum=Reject;Generate
+type EmptyNamePolicy string
+
+// Supported empty name policies.
+const (
+	EmptyNameReject   EmptyNamePolicy = "Reject"
+	EmptyNameGenerate EmptyNamePolicy = "Generate"
+)
+
+// A FieldMapping maps fields of a managed resource to the numbers of the
+// request fields they're sent as. Fields that aren't mapped are sent as the
+// list service's own request fields.
+type FieldMapping struct {
+	// Name is the number of the field a list's name is sent as in each
+	// request.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	Name *int32 `json:"name,omitempty"`
+
+	// Description is the number of the CreateList request field a list's
+	// description is sent as.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	Description *int32 `json:"description,omitempty"`
+
+	// ListItems is the number of the UpdateListItems request field a list's
+	// items are sent as.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	ListItems *int32 `json:"listItems,omitempty"`
+}
+
+// A TLSConfig configures a TLS connection to a gRPC backend.
+type TLSConfig struct {
+	// CASecretRef references a secret key containing the PEM encoded
+	// certificate authority used to verify the backend's certificate. The
+	// host's root certificate authorities are used if unset.
+	// +optional
+	CASecretRef *xpv1.SecretKeySelector `json:"caSecretRef,omitempty"`
+
+	// ServerName overrides the name used to verify the backend's
+	// certificate.
+	// +optional
+	ServerName string `json:"serverName,omitempty"`
+}
+
+// A LoadBalancingPolicy determines how requests are balanced across the
+// addresses of a gRPC backend.
+// +kubebuilder:validation:Enum=pick_first;round_robin
+type LoadBalancingPolicy string
+
+// An IPFamily is a version of the Internet Protocol.
+// +kubebuilder:validation:Enum=IPv4;IPv6
+type IPFamily string
+
+// Supported IP families.
+const (
+	IPv4 IPFamily = "IPv4"
+	IPv6 IPFamily = "IPv6"
+)
+
+// Supported load balancing policies.
+const (
+	LoadBalancingPickFirst  LoadBalancingPolicy = "pick_first"
+	LoadBalancingRoundRobin LoadBalancingPolicy = "round_robin"
+)
+
+// A Compression algorithm used to compress gRPC messages.
+// +kubebuilder:validation:Enum=gzip
+type Compression string
+
+// Supported compression algorithms.
+const (
+	CompressionGzip Compression = "gzip"
+)
+
+// A ServiceReference references a port of a Kubernetes Service.
+type ServiceReference struct {
+	// Name of the Service.
+	Name string `json:"name"`
+
+	// Namespace of the Service.
+	Namespace string `json:"namespace"`
+
+	// Port of the Service.
+	Port int32 `json:"port"`
+}
+
+// ServiceAccountTokenConfig configures the ServiceAccount tokens used to
+// authenticate to a backend. The provider must be allowed to create tokens for
+// the ServiceAccount.
+type ServiceAccountTokenConfig struct {
+	// ServiceAccountRef references the ServiceAccount tokens are minted for.
+	ServiceAccountRef ServiceAccountReference `json:"serviceAccountRef"`
+
+	// Audience the backend validates tokens are intended for. The API
+	// server's default audience is used if unset.
+	// +optional
+	Audience *string `json:"audience,omitempty"`
+
+	// ExpirationSeconds is how long a minted token is valid for.
+	// +kubebuilder:validation:Minimum=600
+	// +kubebuilder:default=3600
+	// +optional
+	ExpirationSeconds *int64 `json:"expirationSeconds,omitempty"`
+}
+
+// A ServiceAccountReference references a Kubernetes ServiceAccount.
+type ServiceAccountReference struct {
+	// Name of the ServiceAccount.
+	Name string `json:"name"`
+
+	// Namespace of the ServiceAccount.
+	Namespace string `json:"namespace"`
 }
 
 // ProviderCredentials required to authenticate.
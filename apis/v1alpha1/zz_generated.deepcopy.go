@@ -22,9 +22,41 @@ limitations under the License.
 package v1alpha1
 
 import (
+	"github.com/crossplane/crossplane-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FieldMapping) DeepCopyInto(out *FieldMapping) {
+	*out = *in
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(int32)
+		**out = **in
+	}
+	if in.ListItems != nil {
+		in, out := &in.ListItems, &out.ListItems
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FieldMapping.
+func (in *FieldMapping) DeepCopy() *FieldMapping {
+	if in == nil {
+		return nil
+	}
+	out := new(FieldMapping)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ProviderConfig) DeepCopyInto(out *ProviderConfig) {
 	*out = *in
@@ -88,6 +120,128 @@ func (in *ProviderConfigList) DeepCopyObject() runtime.Object {
 func (in *ProviderConfigSpec) DeepCopyInto(out *ProviderConfigSpec) {
 	*out = *in
 	in.Credentials.DeepCopyInto(&out.Credentials)
+	if in.FallbackCredentials != nil {
+		in, out := &in.FallbackCredentials, &out.FallbackCredentials
+		*out = make([]ProviderCredentials, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ReadyStatuses != nil {
+		in, out := &in.ReadyStatuses, &out.ReadyStatuses
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.SendDescription != nil {
+		in, out := &in.SendDescription, &out.SendDescription
+		*out = new(bool)
+		**out = **in
+	}
+	if in.MaxListItems != nil {
+		in, out := &in.MaxListItems, &out.MaxListItems
+		*out = new(int32)
+		**out = **in
+	}
+	if in.ItemTolerance != nil {
+		in, out := &in.ItemTolerance, &out.ItemTolerance
+		*out = new(int32)
+		**out = **in
+	}
+	if in.UpdateChunkSize != nil {
+		in, out := &in.UpdateChunkSize, &out.UpdateChunkSize
+		*out = new(int32)
+		**out = **in
+	}
+	if in.CallTimeout != nil {
+		in, out := &in.CallTimeout, &out.CallTimeout
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.CallTimeoutPerItem != nil {
+		in, out := &in.CallTimeoutPerItem, &out.CallTimeoutPerItem
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.MaxCallTimeout != nil {
+		in, out := &in.MaxCallTimeout, &out.MaxCallTimeout
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.CreateWaitTimeout != nil {
+		in, out := &in.CreateWaitTimeout, &out.CreateWaitTimeout
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.Endpoint != nil {
+		in, out := &in.Endpoint, &out.Endpoint
+		*out = new(string)
+		**out = **in
+	}
+	if in.Resolver != nil {
+		in, out := &in.Resolver, &out.Resolver
+		*out = new(string)
+		**out = **in
+	}
+	if in.ReadEndpoint != nil {
+		in, out := &in.ReadEndpoint, &out.ReadEndpoint
+		*out = new(string)
+		**out = **in
+	}
+	if in.ServiceRef != nil {
+		in, out := &in.ServiceRef, &out.ServiceRef
+		*out = new(ServiceReference)
+		**out = **in
+	}
+	if in.Authority != nil {
+		in, out := &in.Authority, &out.Authority
+		*out = new(string)
+		**out = **in
+	}
+	if in.LoadBalancingPolicy != nil {
+		in, out := &in.LoadBalancingPolicy, &out.LoadBalancingPolicy
+		*out = new(LoadBalancingPolicy)
+		**out = **in
+	}
+	if in.PreferredIPFamily != nil {
+		in, out := &in.PreferredIPFamily, &out.PreferredIPFamily
+		*out = new(IPFamily)
+		**out = **in
+	}
+	if in.Compression != nil {
+		in, out := &in.Compression, &out.Compression
+		*out = new(Compression)
+		**out = **in
+	}
+	if in.TLS != nil {
+		in, out := &in.TLS, &out.TLS
+		*out = new(TLSConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ServiceAccountToken != nil {
+		in, out := &in.ServiceAccountToken, &out.ServiceAccountToken
+		*out = new(ServiceAccountTokenConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.FieldMapping != nil {
+		in, out := &in.FieldMapping, &out.FieldMapping
+		*out = new(FieldMapping)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.EmptyName != nil {
+		in, out := &in.EmptyName, &out.EmptyName
+		*out = new(EmptyNamePolicy)
+		**out = **in
+	}
+	if in.DefaultDeletionPolicy != nil {
+		in, out := &in.DefaultDeletionPolicy, &out.DefaultDeletionPolicy
+		*out = new(v1.DeletionPolicy)
+		**out = **in
+	}
+	if in.CallbackURL != nil {
+		in, out := &in.CallbackURL, &out.CallbackURL
+		*out = new(string)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProviderConfigSpec.
@@ -190,6 +344,62 @@ func (in *ProviderCredentials) DeepCopy() *ProviderCredentials {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceAccountReference) DeepCopyInto(out *ServiceAccountReference) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceAccountReference.
+func (in *ServiceAccountReference) DeepCopy() *ServiceAccountReference {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceAccountReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceAccountTokenConfig) DeepCopyInto(out *ServiceAccountTokenConfig) {
+	*out = *in
+	out.ServiceAccountRef = in.ServiceAccountRef
+	if in.Audience != nil {
+		in, out := &in.Audience, &out.Audience
+		*out = new(string)
+		**out = **in
+	}
+	if in.ExpirationSeconds != nil {
+		in, out := &in.ExpirationSeconds, &out.ExpirationSeconds
+		*out = new(int64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceAccountTokenConfig.
+func (in *ServiceAccountTokenConfig) DeepCopy() *ServiceAccountTokenConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceAccountTokenConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceReference) DeepCopyInto(out *ServiceReference) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceReference.
+func (in *ServiceReference) DeepCopy() *ServiceReference {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *StoreConfig) DeepCopyInto(out *StoreConfig) {
 	*out = *in
@@ -280,3 +490,23 @@ func (in *StoreConfigStatus) DeepCopy() *StoreConfigStatus {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TLSConfig) DeepCopyInto(out *TLSConfig) {
+	*out = *in
+	if in.CASecretRef != nil {
+		in, out := &in.CASecretRef, &out.CASecretRef
+		*out = new(v1.SecretKeySelector)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TLSConfig.
+func (in *TLSConfig) DeepCopy() *TLSConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(TLSConfig)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -22,6 +22,9 @@ import (
 	"path/filepath"
 	"time"
 
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
 	"gopkg.in/alecthomas/kingpin.v2"
 	kerrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -40,6 +43,7 @@ import (
 	"github.com/crossplane/provider-grpc/apis/v1alpha1"
 	grpc "github.com/crossplane/provider-grpc/internal/controller"
 	"github.com/crossplane/provider-grpc/internal/controller/features"
+	"github.com/crossplane/provider-grpc/internal/controller/grpckind"
 )
 
 func main() {
@@ -51,7 +55,29 @@ func main() {
 		syncInterval     = app.Flag("sync", "How often all resources will be double-checked for drift from the desired state.").Short('s').Default("1h").Duration()
 		pollInterval     = app.Flag("poll", "How often individual resources will be checked for drift from the desired state").Default("1m").Duration()
 		maxReconcileRate = app.Flag("max-reconcile-rate", "The global maximum rate per second at which resources may checked for drift from the desired state.").Default("10").Int()
-
+		idleTimeout      = app.Flag("connection-idle-timeout", "How long a connection to a gRPC backend may go unused before it is closed. Zero keeps connections open.").Default("10m").Duration()
+		updateDebounce   = app.Flag("update-debounce", "How long the spec of a resource must go unchanged before it is updated, so that rapid changes are applied as one update. Zero disables debouncing.").Default("0s").Duration()
+		defaultAddress   = app.Flag("default-address", "The address of the gRPC backend used for ProviderConfigs that don't specify an endpoint or service.").Default(grpckind.Address).String()
+		breakerThreshold = app.Flag("breaker-failure-threshold", "How many consecutive calls to a gRPC backend must fail before calls to it are short-circuited. Zero disables circuit breaking.").Default("5").Int()
+		breakerCooldown  = app.Flag("breaker-cooldown", "How long calls to a gRPC backend are short-circuited once it failed too many consecutive times.").Default("30s").Duration()
+		requireTLS       = app.Flag("require-tls", "Refuse to connect to gRPC backends whose ProviderConfig doesn't configure TLS.").Default("false").Bool()
+		orphanInterval   = app.Flag("orphan-cleanup-interval", "How often gRPC backends are checked for lists no resource manages, which are deleted. Zero disables cleaning up orphaned lists.").Default("0s").Duration()
+		orphanGrace      = app.Flag("orphan-grace-period", "How long a list must have been found orphaned before it is deleted.").Default("1h").Duration()
+		orphanDryRun     = app.Flag("orphan-cleanup-dry-run", "Log orphaned lists rather than deleting them.").Default("false").Bool()
+		namespaced       = app.Flag("namespaced", "Reconcile namespace scoped NamespacedGrpcKinds rather than cluster scoped GrpcKinds.").Default("false").Bool()
+		traceEvents      = app.Flag("trace-events", "Record each step of reconciling a resource, such as observing drift or starting an update, as an event of the resource. Meant for demos and debugging.").Default("false").Bool()
+		watchLists       = app.Flag("watch-lists", "Watch lists using the WatchList RPC of gRPC backends that serve it, and observe them using the state the backend pushes rather than getting them every reconcile.").Default("false").Bool()
+		observeFresh     = app.Flag("observe-freshness", "How long a list observed up to date is assumed to stay up to date while its resource's spec is unchanged, rather than getting it every reconcile. Zero disables the fast path.").Default("0s").Duration()
+		retryBudget      = app.Flag("retry-budget", "How many resources may be retrying reconciles at once before further retries are deferred, so that they don't exhaust the controller's workers. Zero disables the budget.").Default("0").Int()
+		retryBudgetDelay = app.Flag("retry-budget-delay", "How long retries are deferred for while the retry budget is exhausted.").Default("30s").Duration()
+		maxReconcile     = app.Flag("max-reconcile-duration", "How long a reconcile may spend applying the chunks of a chunked update before it stops, keeping the chunks applied so far, and resumes in another reconcile. Zero disables the bound.").Default("0s").Duration()
+		batchWindow      = app.Flag("batch-window", "How long an update waits for updates of other lists of the same gRPC backend to join it, before they are all applied using a single BatchUpdateLists call, if the backend serves it. Zero disables batching.").Default("0s").Duration()
+		warmUp           = app.Flag("warm-up-connections", "Dial the default gRPC backend endpoint when the controller starts, so that its connection is ready before the first resource is reconciled.").Default("false").Bool()
+		redactLogs       = app.Flag("redact-logs", "Hash the names of lists and mask their descriptions in logs and events, for lists that may carry sensitive data.").Default("false").Bool()
+		traceInterval    = app.Flag("trace-event-interval", "How long a trace event suppresses identical events of the same resource.").Default("1m").Duration()
+		webhookCertDir   = app.Flag("webhook-tls-cert-dir", "The directory of the TLS certificate and key used by the webhook server. Crossplane sets it for packages with webhooks. Webhooks, including conversion between GrpcKind API versions, are disabled if unset.").Envar("WEBHOOK_TLS_CERT_DIR").String()
+
+		traceContext               = app.Flag("propagate-trace-context", "Start a trace for each reconcile of a resource and propagate it to the gRPC backend using OpenTelemetry's W3C Trace Context propagator.").Default("false").Bool()
 		namespace                  = app.Flag("namespace", "Namespace used to set as default scope in default secret store config.").Default("crossplane-system").Envar("POD_NAMESPACE").String()
 		enableExternalSecretStores = app.Flag("enable-external-secret-stores", "Enable support for ExternalSecretStores.").Default("false").Envar("ENABLE_EXTERNAL_SECRET_STORES").Bool()
 	)
@@ -84,6 +110,8 @@ func main() {
 		LeaderElectionResourceLock: resourcelock.LeasesResourceLock,
 		LeaseDuration:              func() *time.Duration { d := 60 * time.Second; return &d }(),
 		RenewDeadline:              func() *time.Duration { d := 50 * time.Second; return &d }(),
+
+		CertDir: *webhookCertDir,
 	})
 	kingpin.FatalIfError(err, "Cannot create controller manager")
 	kingpin.FatalIfError(apis.AddToScheme(mgr.GetScheme()), "Cannot add Grpc APIs to scheme")
@@ -115,6 +143,41 @@ func main() {
 		})), "cannot create default store config")
 	}
 
+	grpckind.SetDefaultAddress(*defaultAddress)
+	grpckind.IdleTimeout = *idleTimeout
+	grpckind.RequireTLS = *requireTLS
+	grpckind.BreakerThreshold = *breakerThreshold
+	grpckind.BreakerCooldown = *breakerCooldown
+	grpckind.UpdateDebounce = *updateDebounce
+	grpckind.PropagateTraceContext = *traceContext
+	if *traceContext {
+		// Spans aren't exported, but have trace IDs the backend's spans can
+		// join.
+		otel.SetTracerProvider(sdktrace.NewTracerProvider())
+		otel.SetTextMapPropagator(propagation.TraceContext{})
+	}
+	grpckind.Namespaced = *namespaced
+	grpckind.TraceEvents = *traceEvents
+	grpckind.TraceEventInterval = *traceInterval
+	grpckind.WatchLists = *watchLists
+	grpckind.ObserveFreshness = *observeFresh
+	grpckind.RetryBudget = *retryBudget
+	grpckind.RetryBudgetDelay = *retryBudgetDelay
+	grpckind.MaxReconcileDuration = *maxReconcile
+	grpckind.RedactLogs = *redactLogs
+	grpckind.BatchWindow = *batchWindow
+	grpckind.WarmUpConnections = *warmUp
+	grpckind.OrphanCleanupInterval = *orphanInterval
+	grpckind.OrphanGracePeriod = *orphanGrace
+	grpckind.OrphanCleanupDryRun = *orphanDryRun
+
 	kingpin.FatalIfError(grpc.Setup(mgr, o), "Cannot setup Grpc controllers")
+	// The GrpcKind CRD uses the webhook to convert between API versions, so
+	// without it GrpcKinds are only served at the version they're stored at.
+	if *webhookCertDir != "" {
+		kingpin.FatalIfError(grpckind.SetupWebhook(mgr), "Cannot setup Grpc webhooks")
+	} else {
+		log.Info("Webhooks disabled, since no webhook TLS certificate directory is set. GrpcKinds are neither validated nor converted between API versions.")
+	}
 	kingpin.FatalIfError(mgr.Start(ctrl.SetupSignalHandler()), "Cannot start controller manager")
 }
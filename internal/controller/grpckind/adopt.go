@@ -0,0 +1,43 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpckind
+
+import (
+	log "github.com/sirupsen/logrus"
+
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+
+	"github.com/crossplane/provider-grpc/apis/mygroup/v1alpha1"
+)
+
+// adopt makes the supplied GrpcKind manage the existing list it observed, if
+// the GrpcKind didn't create the list itself. The list's name becomes the
+// GrpcKind's external name. A list is only adopted while the external name
+// isn't settled, so a GrpcKind whose name changes keeps managing the list it
+// adopted. It returns true if the GrpcKind was changed and must be persisted.
+func adopt(cr *v1alpha1.GrpcKind) bool {
+	if !meta.GetExternalCreateSucceeded(cr).IsZero() || meta.GetExternalName(cr) == cr.Spec.ForProvider.Name {
+		return false
+	}
+	if externalNameSettled(cr) {
+		log.Warnf("Observe::Name of list \"%v\" changed to \"%v\" after it was adopted. Keeping its external name", logName(meta.GetExternalName(cr)), logName(cr.Spec.ForProvider.Name))
+		return false
+	}
+	log.Infof("Observe::Adopting existing list \"%v\"", logName(cr.Spec.ForProvider.Name))
+	meta.SetExternalName(cr, cr.Spec.ForProvider.Name)
+	return true
+}
@@ -0,0 +1,157 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpckind
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+
+	listServicepb "github.com/ashwinshirva/provider-grpc-server/proto"
+	"github.com/crossplane/provider-grpc/apis/mygroup/v1alpha1"
+)
+
+func TestAdopt(t *testing.T) {
+	type want struct {
+		o            managed.ExternalObservation
+		externalName string
+	}
+
+	cases := map[string]struct {
+		reason string
+		mg     func(cr *v1alpha1.GrpcKind)
+		items  []int32
+		want   want
+	}{
+		"ExistingMatching": {
+			reason: "An existing list that matches the desired state should be adopted without creating it.",
+			mg:     func(cr *v1alpha1.GrpcKind) { meta.SetExternalName(cr, "cool-grpckind") },
+			items:  []int32{1, 2},
+			want: want{
+				o: managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceUpToDate:        true,
+					ResourceLateInitialized: true,
+					ConnectionDetails:       managed.ConnectionDetails{},
+				},
+				externalName: "cool-list",
+			},
+		},
+		"ExistingDrifted": {
+			reason: "An existing list that differs from the desired state should be adopted, then updated.",
+			mg:     func(cr *v1alpha1.GrpcKind) { meta.SetExternalName(cr, "cool-grpckind") },
+			items:  []int32{1},
+			want: want{
+				o: managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceUpToDate:        false,
+					ResourceLateInitialized: true,
+					ConnectionDetails:       managed.ConnectionDetails{},
+				},
+				externalName: "cool-list",
+			},
+		},
+		"Created": {
+			reason: "A list the GrpcKind created should not be adopted.",
+			mg: func(cr *v1alpha1.GrpcKind) {
+				meta.SetExternalName(cr, "cool-grpckind")
+				meta.SetExternalCreateSucceeded(cr, time.Now())
+			},
+			items: []int32{1, 2},
+			want: want{
+				o: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  true,
+					ConnectionDetails: managed.ConnectionDetails{},
+				},
+				externalName: "cool-grpckind",
+			},
+		},
+		"AlreadyAdopted": {
+			reason: "A list that was already adopted should not be adopted again.",
+			mg:     func(cr *v1alpha1.GrpcKind) {},
+			items:  []int32{1, 2},
+			want: want{
+				o: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  true,
+					ConnectionDetails: managed.ConnectionDetails{},
+				},
+				externalName: "cool-list",
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := external{service: &ListService{grpcClient: &fakeListServiceClient{
+				MockGetList: func(_ context.Context, _ *listServicepb.GetListReq, _ ...grpc.CallOption) (*listServicepb.GetListResp, error) {
+					return &listServicepb.GetListResp{Status: "SUCCESS", Items: tc.items}, nil
+				},
+				MockCreateList: func(_ context.Context, _ *listServicepb.CreateListReq, _ ...grpc.CallOption) (*listServicepb.CreateListResp, error) {
+					t.Errorf("\n%s\ne.Observe(...): want no CreateList call", tc.reason)
+					return nil, nil
+				},
+			}}}
+			cr := grpcKindWith("cool-list", withItems(1, 2), tc.mg)
+
+			o, err := e.Observe(context.Background(), cr)
+			if err != nil {
+				t.Fatalf("\n%s\ne.Observe(...): %v", tc.reason, err)
+			}
+			if diff := cmp.Diff(tc.want.o, o); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.externalName, meta.GetExternalName(cr)); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want external name, +got external name:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(xpv1.Available(), cr.GetCondition(xpv1.TypeReady), cmpopts.IgnoreFields(xpv1.Condition{}, "LastTransitionTime")); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want condition, +got condition:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestCreateAlreadyExists(t *testing.T) {
+	e := external{service: &ListService{grpcClient: &fakeListServiceClient{
+		MockCreateList: func(_ context.Context, _ *listServicepb.CreateListReq, _ ...grpc.CallOption) (*listServicepb.CreateListResp, error) {
+			return nil, status.Error(codes.AlreadyExists, "list cool-list already exists")
+		},
+	}}}
+	cr := grpcKind("cool-list")
+	meta.SetExternalName(cr, "cool-grpckind")
+
+	if _, err := e.Create(context.Background(), cr); err != nil {
+		t.Errorf("e.Create(...): want a list that already exists to be adopted, got error %v", err)
+	}
+	if diff := cmp.Diff("cool-list", meta.GetExternalName(cr)); diff != "" {
+		t.Errorf("e.Create(...): -want external name, +got external name:\n%s\n", diff)
+	}
+	if cr.Status.AtProvider.RetryCount != 0 {
+		t.Errorf("e.Create(...): want adopting a list not to count as a failed attempt, got %d", cr.Status.AtProvider.RetryCount)
+	}
+}
@@ -0,0 +1,63 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpckind
+
+import (
+	"context"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+
+	"github.com/crossplane/provider-grpc/apis/mygroup/v1alpha1"
+)
+
+// A statusKeepingAnnotationUpdater updates the critical annotations the
+// managed reconciler sets before and after calling Create, but keeps the
+// status of the managed resource. The wrapped updater gets the resource again
+// before updating it, which resets its status to the one the API server
+// stored, so the status Create recorded, e.g. its retry backoff or the items
+// the backend rejected, would otherwise be lost. The reconciler persists the
+// kept status once Create returns.
+type statusKeepingAnnotationUpdater struct {
+	managed.CriticalAnnotationUpdater
+}
+
+// newCriticalAnnotationUpdater returns the CriticalAnnotationUpdater of the
+// managed reconciler, which retries updates using the supplied client.
+func newCriticalAnnotationUpdater(kube client.Client) managed.CriticalAnnotationUpdater {
+	return statusKeepingAnnotationUpdater{managed.NewRetryingCriticalAnnotationUpdater(kube)}
+}
+
+func (u statusKeepingAnnotationUpdater) UpdateCriticalAnnotations(ctx context.Context, o client.Object) error {
+	defer keepStatus(o)()
+	return u.CriticalAnnotationUpdater.UpdateCriticalAnnotations(ctx, o)
+}
+
+// keepStatus returns a function that restores the current status of the
+// supplied GrpcKind or NamespacedGrpcKind.
+func keepStatus(o client.Object) func() {
+	switch cr := o.(type) {
+	case *v1alpha1.GrpcKind:
+		s := *cr.Status.DeepCopy()
+		return func() { cr.Status = s }
+	case *v1alpha1.NamespacedGrpcKind:
+		s := *cr.Status.DeepCopy()
+		return func() { cr.Status = s }
+	}
+	return func() {}
+}
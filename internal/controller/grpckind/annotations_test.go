@@ -0,0 +1,193 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpckind
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	testingclock "k8s.io/utils/clock/testing"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	resourcefake "github.com/crossplane/crossplane-runtime/pkg/resource/fake"
+
+	listServicepb "github.com/ashwinshirva/provider-grpc-server/proto"
+	"github.com/crossplane/provider-grpc/apis"
+	"github.com/crossplane/provider-grpc/apis/mygroup/v1alpha1"
+)
+
+// reconcileGrpcKind stores the supplied GrpcKind using a fake API server
+// client, and returns a function that reconciles it the way Setup's managed
+// reconciler does, using externals returned by the supplied function. The
+// function returns the GrpcKind as stored after the reconcile, or nil if it no
+// longer exists.
+func reconcileGrpcKind(t *testing.T, cr *v1alpha1.GrpcKind, newExternal func() *external) func() *v1alpha1.GrpcKind {
+	t.Helper()
+	s := runtime.NewScheme()
+	if err := apis.AddToScheme(s); err != nil {
+		t.Fatalf("apis.AddToScheme(...): %v", err)
+	}
+	kube := fake.NewClientBuilder().WithScheme(s).WithObjects(cr).Build()
+	r := managed.NewReconciler(&resourcefake.Manager{Client: kube, Scheme: s},
+		resource.ManagedKind(v1alpha1.GrpcKindGroupVersionKind),
+		managed.WithExternalConnecter(managed.ExternalConnectorFn(func(_ context.Context, _ resource.Managed) (managed.ExternalClient, error) {
+			return newExternal(), nil
+		})),
+		managed.WithCriticalAnnotationUpdater(newCriticalAnnotationUpdater(kube)),
+		managed.WithInitializers())
+
+	return func() *v1alpha1.GrpcKind {
+		t.Helper()
+		nn := types.NamespacedName{Name: cr.GetName()}
+		if _, err := r.Reconcile(context.Background(), reconcile.Request{NamespacedName: nn}); err != nil {
+			t.Fatalf("r.Reconcile(...): %v", err)
+		}
+		got := &v1alpha1.GrpcKind{}
+		err := kube.Get(context.Background(), nn, got)
+		if kerrors.IsNotFound(err) {
+			return nil
+		}
+		if err != nil {
+			t.Fatalf("kube.Get(...): %v", err)
+		}
+		return got
+	}
+}
+
+func TestCreateBackoffPersisted(t *testing.T) {
+	clk := testingclock.NewFakeClock(time.Date(2022, 6, 1, 12, 0, 0, 0, time.UTC))
+	creates := 0
+	lc := &fakeListServiceClient{
+		MockGetList: func(_ context.Context, _ *listServicepb.GetListReq, _ ...grpc.CallOption) (*listServicepb.GetListResp, error) {
+			return nil, status.Error(codes.NotFound, "list cool-list does not exist")
+		},
+		MockCreateList: func(_ context.Context, _ *listServicepb.CreateListReq, _ ...grpc.CallOption) (*listServicepb.CreateListResp, error) {
+			creates++
+			return &listServicepb.CreateListResp{Status: statusFailed}, nil
+		},
+	}
+	cr := grpcKindWith("cool-list", withItems(1))
+	cr.SetName("cool")
+	reconcileOnce := reconcileGrpcKind(t, cr, func() *external {
+		return &external{clock: clk, service: &ListService{grpcClient: lc}}
+	})
+
+	// The managed reconciler gets the GrpcKind again to update its critical
+	// annotations once Create returns. The backoff Create recorded must
+	// survive that, or the next reconcile creates the list again at once.
+	got := reconcileOnce()
+	if got.Status.AtProvider.RetryCount != 1 || got.Status.AtProvider.NextRetryTime == nil {
+		t.Errorf("r.Reconcile(...): want the failed create's backoff persisted, got %d retries until %v", got.Status.AtProvider.RetryCount, got.Status.AtProvider.NextRetryTime)
+	}
+
+	reconcileOnce()
+	if creates != 1 {
+		t.Errorf("r.Reconcile(...): want no create while backing off, got %d creates", creates)
+	}
+
+	clk.Step(retryDelay(1))
+	reconcileOnce()
+	if creates != 2 {
+		t.Errorf("r.Reconcile(...): want the list created again once done backing off, got %d creates", creates)
+	}
+}
+
+func TestCreateDescriptionPersisted(t *testing.T) {
+	created, updates := false, 0
+	lc := &fakeListServiceClient{
+		MockGetList: func(_ context.Context, _ *listServicepb.GetListReq, _ ...grpc.CallOption) (*listServicepb.GetListResp, error) {
+			if !created {
+				return nil, status.Error(codes.NotFound, "list cool-list does not exist")
+			}
+			return &listServicepb.GetListResp{Status: statusSuccess, Items: []int32{1}}, nil
+		},
+		MockCreateList: func(_ context.Context, _ *listServicepb.CreateListReq, _ ...grpc.CallOption) (*listServicepb.CreateListResp, error) {
+			created = true
+			return &listServicepb.CreateListResp{Status: statusSuccess}, nil
+		},
+		MockUpdateListItems: func(_ context.Context, _ *listServicepb.UpdateListItemsReq, _ ...grpc.CallOption) (*listServicepb.UpdateListItemsResp, error) {
+			updates++
+			return &listServicepb.UpdateListItemsResp{Status: statusSuccess}, nil
+		},
+	}
+	cr := grpcKindWith("cool-list", withItems(1), withDescription(strPtr("cool")))
+	cr.SetName("cool")
+	reconcileOnce := reconcileGrpcKind(t, cr, func() *external {
+		return &external{service: &ListService{grpcClient: lc}}
+	})
+
+	// The backend doesn't report descriptions, so the description Create sent
+	// must be recorded as applied, or the next reconcile sends it again.
+	reconcileOnce()
+	got := reconcileOnce()
+	if d := got.Status.AtProvider.Description; d == nil || *d != "cool" {
+		t.Errorf("r.Reconcile(...): want the created description recorded as applied, got %v", d)
+	}
+	if updates != 0 {
+		t.Errorf("r.Reconcile(...): want no update of a list created with the desired description, got %d updates", updates)
+	}
+}
+
+func TestDeleteBlockingRequeued(t *testing.T) {
+	gets, deletes := 0, 0
+	lc := &fakeListServiceClient{
+		MockGetList: func(ctx context.Context, in *listServicepb.GetListReq, opts ...grpc.CallOption) (*listServicepb.GetListResp, error) {
+			gets++
+			if gets < 2 {
+				return &listServicepb.GetListResp{Status: statusDeleting}, nil
+			}
+			return listGone(ctx, in, opts...)
+		},
+		MockDeleteList: func(_ context.Context, _ *listServicepb.DeleteListReq, _ ...grpc.CallOption) (*listServicepb.DeleteListResp, error) {
+			deletes++
+			return &listServicepb.DeleteListResp{Status: statusDeleted}, nil
+		},
+	}
+	now := metav1.Now()
+	cr := grpcKindWith("cool-list")
+	cr.SetName("cool")
+	cr.SetDeletionTimestamp(&now)
+	cr.SetFinalizers([]string{"finalizer.managedresource.crossplane.io"})
+	reconcileOnce := reconcileGrpcKind(t, cr, func() *external {
+		return &external{service: &ListService{grpcClient: lc}}
+	})
+
+	// Delete returns once the backend accepted the deletion, and the list is
+	// reported to exist until the backend no longer reports it.
+	got := reconcileOnce()
+	if deletes != 1 || got.Status.AtProvider.Status != statusDeleting || len(got.GetFinalizers()) == 0 {
+		t.Errorf("r.Reconcile(...): want the list deleted once and recorded as being deleted, got %d deletes, status %q, finalizers %v", deletes, got.Status.AtProvider.Status, got.GetFinalizers())
+	}
+	got = reconcileOnce()
+	if deletes != 1 || len(got.GetFinalizers()) == 0 {
+		t.Errorf("r.Reconcile(...): want the finalizer kept, and the list not deleted again, while the backend deletes it, got %d deletes, finalizers %v", deletes, got.GetFinalizers())
+	}
+	if got := reconcileOnce(); got != nil {
+		t.Errorf("r.Reconcile(...): want the finalizer removed once the backend deleted the list, got %v", got.GetFinalizers())
+	}
+}
@@ -0,0 +1,107 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpckind
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"k8s.io/utils/clock"
+	"sigs.k8s.io/controller-runtime/pkg/ratelimiter"
+)
+
+// Bounds of the delay a backpressureLimiter imposes.
+const (
+	backpressureBaseDelay = 1 * time.Second
+	backpressureMaxDelay  = 2 * time.Minute
+)
+
+// A backpressureLimiter slows down all reconciles while the backend reports
+// that its resources are exhausted, e.g. because a quota was exceeded. It
+// extends the delay imposed by the RateLimiter it wraps.
+type backpressureLimiter struct {
+	ratelimiter.RateLimiter
+	clock clock.PassiveClock
+
+	mu    sync.Mutex
+	delay time.Duration
+	until time.Time
+}
+
+// newBackpressureLimiter returns a backpressureLimiter that wraps the supplied
+// RateLimiter.
+func newBackpressureLimiter(l ratelimiter.RateLimiter, c clock.PassiveClock) *backpressureLimiter {
+	return &backpressureLimiter{RateLimiter: l, clock: c}
+}
+
+// Exhausted records that the backend reported its resources are exhausted.
+// Each report made while reconciles are still being slowed down doubles how
+// long they are slowed down for.
+func (b *backpressureLimiter) Exhausted() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	now := b.clock.Now()
+	switch {
+	case now.After(b.until):
+		b.delay = backpressureBaseDelay
+	case b.delay*2 > backpressureMaxDelay:
+		b.delay = backpressureMaxDelay
+	default:
+		b.delay *= 2
+	}
+	b.until = now.Add(b.delay)
+	log.Infof("Backend resources are exhausted. Slowing down reconciles for %s", b.delay)
+}
+
+// When returns how long the supplied item should wait before it is reconciled.
+func (b *backpressureLimiter) When(item interface{}) time.Duration {
+	d := b.RateLimiter.When(item)
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if remaining := b.until.Sub(b.clock.Now()); remaining > d {
+		return remaining
+	}
+	return d
+}
+
+// intercept is a UnaryClientInterceptor that reports calls that fail because
+// the backend's resources are exhausted.
+func (b *backpressureLimiter) intercept(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	err := invoker(ctx, method, req, reply, cc, opts...)
+	if status.Code(err) == codes.ResourceExhausted {
+		b.Exhausted()
+	}
+	return err
+}
+
+// interceptStream is a StreamClientInterceptor that reports streams that end
+// because the backend's resources are exhausted.
+func (b *backpressureLimiter) interceptStream(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+	s, err := streamer(ctx, desc, cc, method, opts...)
+	return onEnd(desc, s, err, func(err error) {
+		if status.Code(err) == codes.ResourceExhausted {
+			b.Exhausted()
+		}
+	})
+}
@@ -0,0 +1,107 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpckind
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	testingclock "k8s.io/utils/clock/testing"
+	"sigs.k8s.io/controller-runtime/pkg/ratelimiter"
+)
+
+// A fakeRateLimiter always imposes the same delay.
+type fakeRateLimiter struct {
+	ratelimiter.RateLimiter
+	delay time.Duration
+}
+
+func (l *fakeRateLimiter) When(_ interface{}) time.Duration { return l.delay }
+
+func (l *fakeRateLimiter) Forget(_ interface{}) {}
+
+func TestBackpressure(t *testing.T) {
+	clk := testingclock.NewFakeClock(time.Now())
+	b := newBackpressureLimiter(&fakeRateLimiter{delay: 100 * time.Millisecond}, clk)
+
+	exhausted := func(_ context.Context, _ string, _, _ interface{}, _ *grpc.ClientConn, _ ...grpc.CallOption) error {
+		return status.Error(codes.ResourceExhausted, "quota exceeded")
+	}
+	ok := func(_ context.Context, _ string, _, _ interface{}, _ *grpc.ClientConn, _ ...grpc.CallOption) error {
+		return nil
+	}
+
+	if got := b.When("cool"); got != 100*time.Millisecond {
+		t.Errorf("b.When(...): want the wrapped delay before resources are exhausted, got %s", got)
+	}
+
+	// Successful calls don't slow reconciles down.
+	_ = b.intercept(context.Background(), "/cool", nil, nil, nil, ok)
+	if got := b.When("cool"); got != 100*time.Millisecond {
+		t.Errorf("b.When(...): want the wrapped delay after a successful call, got %s", got)
+	}
+
+	// Repeated exhaustion increases the delay.
+	for _, want := range []time.Duration{1 * time.Second, 2 * time.Second, 4 * time.Second} {
+		if err := b.intercept(context.Background(), "/cool", nil, nil, nil, exhausted); status.Code(err) != codes.ResourceExhausted {
+			t.Fatalf("b.intercept(...): want the call's error to be returned, got %v", err)
+		}
+		if got := b.When("cool"); got != want {
+			t.Errorf("b.When(...): want %s after repeated exhaustion, got %s", want, got)
+		}
+	}
+
+	// The delay is reset once reconciles are no longer slowed down.
+	clk.Step(5 * time.Second)
+	if got := b.When("cool"); got != 100*time.Millisecond {
+		t.Errorf("b.When(...): want the wrapped delay once the backpressure passed, got %s", got)
+	}
+	_ = b.intercept(context.Background(), "/cool", nil, nil, nil, exhausted)
+	if got := b.When("cool"); got != 1*time.Second {
+		t.Errorf("b.When(...): want the base delay after exhaustion resumes, got %s", got)
+	}
+}
+
+func TestBackpressureNotRetried(t *testing.T) {
+	clk := testingclock.NewFakeClock(time.Now())
+	b := newBackpressureLimiter(&fakeRateLimiter{delay: 100 * time.Millisecond}, clk)
+
+	calls := 0
+	exhausted := func(_ context.Context, _ string, _, _ interface{}, _ *grpc.ClientConn, _ ...grpc.CallOption) error {
+		calls++
+		return status.Error(codes.ResourceExhausted, "quota exceeded")
+	}
+
+	// Retrying the call within the reconcile would report the exhaustion again
+	// with each attempt, doubling the delay each time.
+	err := retryTransient(context.Background(), func() error {
+		return b.intercept(context.Background(), "/cool", nil, nil, nil, exhausted)
+	})
+	if status.Code(err) != codes.ResourceExhausted {
+		t.Fatalf("retryTransient(...): want the call's error to be returned, got %v", err)
+	}
+	if calls != 1 {
+		t.Errorf("retryTransient(...): want the exhausted call made once, got %d calls", calls)
+	}
+	if got := b.When("cool"); got != backpressureBaseDelay {
+		t.Errorf("b.When(...): want the base delay after a single exhausted reconcile, got %s", got)
+	}
+}
@@ -0,0 +1,263 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpckind
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"k8s.io/utils/clock"
+
+	listServicepb "github.com/ashwinshirva/provider-grpc-server/proto"
+)
+
+const (
+	errBatchFailedFmt = "backend failed to apply list %q in a batch"
+	errBatchShortFmt  = "backend answered %d of the %d lists of a batch"
+)
+
+// batchUpdateListsMethod is a bidirectional streaming RPC some backends serve
+// in addition to the generated ListService. Each message the client sends
+// replaces the items of a list, and the backend answers each with a message of
+// its own, in the order it received them, once the client closed the stream.
+// Request metadata, e.g. the tenant, applies to every list of the stream.
+const batchUpdateListsMethod = "/proto.ListService/BatchUpdateLists"
+
+// batchTimeout bounds how long a batch may take, since it outlives the
+// reconciles whose updates it carries.
+const batchTimeout = 30 * time.Second
+
+// BatchWindow is how long the first update of a batch waits for updates of
+// other lists of the same backend to join it, before they are all applied
+// using a single BatchUpdateLists call, if the backend serves it. Zero
+// disables batching.
+var BatchWindow time.Duration
+
+// A batchUpdateClient updates lists using the BatchUpdateLists RPC.
+type batchUpdateClient interface {
+	BatchUpdateLists(ctx context.Context, opts ...grpc.CallOption) (batchUpdateListsStream, error)
+}
+
+// A batchUpdateListsStream sends the new items of lists to BatchUpdateLists,
+// and receives the backend's answer for each.
+type batchUpdateListsStream interface {
+	Send(*listServicepb.UpdateListItemsReq) error
+	CloseSend() error
+	Recv() (*listServicepb.UpdateListItemsResp, error)
+}
+
+// newBatchUpdateClient returns a batchUpdateClient that uses the supplied
+// connection.
+func newBatchUpdateClient(cc grpc.ClientConnInterface) batchUpdateClient {
+	return &batchClient{cc: cc}
+}
+
+type batchClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func (c *batchClient) BatchUpdateLists(ctx context.Context, opts ...grpc.CallOption) (batchUpdateListsStream, error) {
+	stream, err := c.cc.NewStream(ctx, &grpc.StreamDesc{StreamName: "BatchUpdateLists", ClientStreams: true, ServerStreams: true}, batchUpdateListsMethod, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &batchUpdateListsClientStream{ClientStream: stream}, nil
+}
+
+type batchUpdateListsClientStream struct {
+	grpc.ClientStream
+}
+
+func (x *batchUpdateListsClientStream) Send(m *listServicepb.UpdateListItemsReq) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *batchUpdateListsClientStream) Recv() (*listServicepb.UpdateListItemsResp, error) {
+	m := new(listServicepb.UpdateListItemsResp)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// An updateBatcher coalesces the updates of lists that share a backend and
+// request metadata into a single BatchUpdateLists call per window. Each update
+// waits for the backend's answer for its own list, so that the status of each
+// managed resource reflects only how its list was applied.
+type updateBatcher struct {
+	window time.Duration
+	clock  clock.WithDelayedExecution
+
+	mu      sync.Mutex
+	batches map[batchKey]*updateBatch
+}
+
+// A batchKey identifies the updates that may share a batch: those sent to the
+// same backend, with the same request metadata. The backend is identified by
+// the connection the update is sent using, which reconciles of lists of the
+// same backend share, rather than by the client, which each reconcile
+// creates anew.
+type batchKey struct {
+	backend interface{}
+	md      string
+}
+
+type updateBatch struct {
+	md      metadata.MD
+	reqs    []*listServicepb.UpdateListItemsReq
+	results []chan error
+}
+
+// newUpdateBatcher returns an updateBatcher that batches updates for the
+// supplied window, or nil if the window is zero. A nil updateBatcher batches
+// no updates.
+func newUpdateBatcher(window time.Duration, c clock.WithDelayedExecution) *updateBatcher {
+	if window <= 0 {
+		return nil
+	}
+	return &updateBatcher{window: window, clock: c, batches: map[batchKey]*updateBatch{}}
+}
+
+// Apply adds the supplied update to the batch of its connection and request
+// metadata, starting one if there is none, and returns the backend's answer
+// for its list once the batch was applied using the supplied client. Updates
+// without a connection are batched by client. It returns an Unimplemented
+// status error if the backend doesn't serve BatchUpdateLists.
+func (b *updateBatcher) Apply(ctx context.Context, conn grpc.ClientConnInterface, c batchUpdateClient, req *listServicepb.UpdateListItemsReq) error {
+	md, _ := metadata.FromOutgoingContext(ctx)
+	key := batchKey{backend: conn, md: mdKey(md)}
+	if conn == nil {
+		key.backend = c
+	}
+	result := make(chan error, 1)
+
+	b.mu.Lock()
+	batch, ok := b.batches[key]
+	if !ok {
+		batch = &updateBatch{md: md.Copy()}
+		b.batches[key] = batch
+		b.clock.AfterFunc(b.window, func() { b.flush(key, c) })
+	}
+	batch.reqs = append(batch.reqs, req)
+	batch.results = append(batch.results, result)
+	b.mu.Unlock()
+
+	select {
+	case err := <-result:
+		return err
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// flush applies the batch of the supplied key, and answers each of its
+// updates.
+func (b *updateBatcher) flush(key batchKey, c batchUpdateClient) {
+	b.mu.Lock()
+	batch := b.batches[key]
+	delete(b.batches, key)
+	b.mu.Unlock()
+
+	errs := applyBatch(c, batch.md, batch.reqs)
+	for i, result := range batch.results {
+		result <- errs[i]
+	}
+}
+
+// applyBatch applies the supplied updates using a single BatchUpdateLists call,
+// and returns the error of each. An error of the call itself is the error of
+// every update the backend didn't answer.
+func applyBatch(c batchUpdateClient, md metadata.MD, reqs []*listServicepb.UpdateListItemsReq) []error {
+	ctx, cancel := context.WithTimeout(metadata.NewOutgoingContext(context.Background(), md), batchTimeout)
+	defer cancel()
+
+	errs := make([]error, len(reqs))
+	fail := func(from int, err error) []error {
+		for i := from; i < len(errs); i++ {
+			errs[i] = err
+		}
+		return errs
+	}
+
+	stream, err := c.BatchUpdateLists(ctx)
+	if err != nil {
+		return fail(0, err)
+	}
+	// A failed send means the stream ended, in which case receiving returns
+	// the status it ended with.
+	for _, req := range reqs {
+		if err := stream.Send(req); err != nil {
+			break
+		}
+	}
+	_ = stream.CloseSend()
+	for i, req := range reqs {
+		resp, err := stream.Recv()
+		if err != nil {
+			if i == 0 {
+				return fail(0, err)
+			}
+			return fail(i, errors.Wrapf(err, errBatchShortFmt, i, len(reqs)))
+		}
+		if resp.GetStatus() == statusFailed {
+			errs[i] = errors.Errorf(errBatchFailedFmt, logName(req.GetName()))
+		}
+	}
+	return errs
+}
+
+// applyBatched applies the supplied update together with those of other lists
+// of the same backend. The batch's stream carries the updates of several
+// GrpcKinds and outlives their reconciles, so it isn't wrapped like the calls
+// made for a single GrpcKind. Instead the update's fields are remapped before
+// it joins the batch, waiting for its answer is bounded by the call timeout,
+// and its outcome is recorded. Warnings in the batch's trailer don't say which
+// list they're about, so they aren't recorded.
+func (c *external) applyBatched(ctx context.Context, req *listServicepb.UpdateListItemsReq) error {
+	if fm := c.pc.FieldMapping; fm != nil {
+		req = remapFields(req, fm)
+	}
+	if c.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, c.timeout)
+		defer cancel()
+	}
+	err := c.batcher.Apply(ctx, c.service.conn, c.service.batchClient, req)
+	c.attempts.record("BatchUpdateLists", err)
+	return err
+}
+
+// mdKey returns a string that identifies the supplied metadata.
+func mdKey(md metadata.MD) string {
+	keys := make([]string, 0, len(md))
+	for k := range md {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	var sb strings.Builder
+	for _, k := range keys {
+		fmt.Fprintf(&sb, "%q=%q;", k, md[k])
+	}
+	return sb.String()
+}
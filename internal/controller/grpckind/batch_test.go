@@ -0,0 +1,226 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpckind
+
+import (
+	"context"
+	"io"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/clock"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	listServicepb "github.com/ashwinshirva/provider-grpc-server/proto"
+	"github.com/crossplane/provider-grpc/apis/mygroup/v1alpha1"
+	apisv1alpha1 "github.com/crossplane/provider-grpc/apis/v1alpha1"
+)
+
+// A fakeBatchUpdateClient records the lists of each BatchUpdateLists call, and
+// fails to apply the lists it's told to fail. Calls fail with err if it's set.
+type fakeBatchUpdateClient struct {
+	fail map[string]bool
+	err  error
+
+	mu    sync.Mutex
+	calls [][]string
+}
+
+func (f *fakeBatchUpdateClient) BatchUpdateLists(_ context.Context, _ ...grpc.CallOption) (batchUpdateListsStream, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.calls = append(f.calls, nil)
+	return &fakeBatchUpdateListsStream{client: f, call: len(f.calls) - 1}, nil
+}
+
+type fakeBatchUpdateListsStream struct {
+	client *fakeBatchUpdateClient
+	call   int
+	names  []string
+}
+
+func (s *fakeBatchUpdateListsStream) Send(m *listServicepb.UpdateListItemsReq) error {
+	s.client.mu.Lock()
+	defer s.client.mu.Unlock()
+	s.client.calls[s.call] = append(s.client.calls[s.call], m.GetName())
+	s.names = append(s.names, m.GetName())
+	return nil
+}
+
+func (s *fakeBatchUpdateListsStream) CloseSend() error { return nil }
+
+func (s *fakeBatchUpdateListsStream) Recv() (*listServicepb.UpdateListItemsResp, error) {
+	if s.client.err != nil {
+		return nil, s.client.err
+	}
+	if len(s.names) == 0 {
+		return nil, io.EOF
+	}
+	name := s.names[0]
+	s.names = s.names[1:]
+	if s.client.fail[name] {
+		return &listServicepb.UpdateListItemsResp{Status: statusFailed}, nil
+	}
+	return &listServicepb.UpdateListItemsResp{Status: statusSuccess}, nil
+}
+
+// A fakeBatchConn is a connection that serves BatchUpdateLists using the
+// supplied client, and no other RPCs.
+type fakeBatchConn struct {
+	client *fakeBatchUpdateClient
+}
+
+func (c *fakeBatchConn) Invoke(_ context.Context, method string, _, _ interface{}, _ ...grpc.CallOption) error {
+	return status.Errorf(codes.Unimplemented, "unexpected call to %s", method)
+}
+
+func (c *fakeBatchConn) NewStream(ctx context.Context, _ *grpc.StreamDesc, method string, _ ...grpc.CallOption) (grpc.ClientStream, error) {
+	if method != batchUpdateListsMethod {
+		return nil, status.Errorf(codes.Unimplemented, "unexpected call to %s", method)
+	}
+	s, _ := c.client.BatchUpdateLists(ctx)
+	return &fakeBatchClientStream{ctx: ctx, stream: s}, nil
+}
+
+type fakeBatchClientStream struct {
+	ctx    context.Context
+	stream batchUpdateListsStream
+}
+
+func (s *fakeBatchClientStream) Header() (metadata.MD, error) { return nil, nil }
+func (s *fakeBatchClientStream) Trailer() metadata.MD         { return nil }
+func (s *fakeBatchClientStream) CloseSend() error             { return s.stream.CloseSend() }
+func (s *fakeBatchClientStream) Context() context.Context     { return s.ctx }
+
+func (s *fakeBatchClientStream) SendMsg(m interface{}) error {
+	return s.stream.Send(m.(*listServicepb.UpdateListItemsReq))
+}
+
+func (s *fakeBatchClientStream) RecvMsg(m interface{}) error {
+	resp, err := s.stream.Recv()
+	if err != nil {
+		return err
+	}
+	m.(*listServicepb.UpdateListItemsResp).Status = resp.GetStatus()
+	return nil
+}
+
+func TestUpdateBatched(t *testing.T) {
+	bc := &fakeBatchUpdateClient{fail: map[string]bool{"failing-list": true}}
+	conn := &fakeBatchConn{client: bc}
+
+	// Each Connect creates a new ListService, as it would with cached
+	// connections, so updates are only batched if they're keyed by the
+	// connection they share.
+	c := connector{
+		kube: &test.MockClient{
+			MockGet: test.NewMockGetFn(nil, func(obj client.Object) error {
+				obj.(*apisv1alpha1.ProviderConfig).Spec.Credentials.Source = xpv1.CredentialsSourceNone
+				return nil
+			}),
+		},
+		usage:        resource.TrackerFn(func(_ context.Context, _ resource.Managed) error { return nil }),
+		newServiceFn: func(_ string, _ []byte, _ transport) (*ListService, error) { return NewListService(conn), nil },
+		batcher:      newUpdateBatcher(100*time.Millisecond, clock.RealClock{}),
+	}
+
+	names := []string{"cool-list", "cooler-list", "failing-list"}
+	crs := make([]*v1alpha1.GrpcKind, len(names))
+	errs := make([]error, len(names))
+	var wg sync.WaitGroup
+	for i, name := range names {
+		crs[i] = grpcKindWith(name, withItems(1, 2))
+		crs[i].SetUID(types.UID(name))
+		ext, err := c.Connect(context.Background(), crs[i])
+		if err != nil {
+			t.Fatalf("c.Connect(...): %v", err)
+		}
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			_, errs[i] = ext.Update(context.Background(), crs[i])
+		}(i)
+	}
+	wg.Wait()
+
+	if len(bc.calls) != 1 {
+		t.Fatalf("e.Update(...): want all lists applied in one BatchUpdateLists call, got %d calls", len(bc.calls))
+	}
+	got := append([]string{}, bc.calls[0]...)
+	sort.Strings(got)
+	if diff := cmp.Diff(names, got); diff != "" {
+		t.Errorf("e.Update(...): -want batched lists, +got batched lists:\n%s\n", diff)
+	}
+
+	// Each resource's status reflects how its own list was applied.
+	for i, cr := range crs {
+		failed := names[i] == "failing-list"
+		if failed != (errs[i] != nil) {
+			t.Errorf("e.Update(%s): want failed %t, got error %v", names[i], failed, errs[i])
+		}
+		if h := cr.Status.AtProvider.RPCHistory; len(h) == 0 || h[len(h)-1].Method != "BatchUpdateLists" {
+			t.Errorf("e.Update(%s): want the batched update recorded, got %+v", names[i], h)
+		}
+		if failed {
+			if cr.Status.AtProvider.RetryCount != 1 {
+				t.Errorf("e.Update(%s): want the failed update retried, got %d retries", names[i], cr.Status.AtProvider.RetryCount)
+			}
+			continue
+		}
+		if diff := cmp.Diff(xpv1.ReconcileSuccess(), cr.GetCondition(xpv1.TypeSynced), test.EquateConditions()); diff != "" {
+			t.Errorf("e.Update(%s): -want condition, +got condition:\n%s\n", names[i], diff)
+		}
+		if cr.Status.AtProvider.RetryCount != 0 {
+			t.Errorf("e.Update(%s): want no retries, got %d", names[i], cr.Status.AtProvider.RetryCount)
+		}
+	}
+}
+
+func TestUpdateBatchedUnimplemented(t *testing.T) {
+	updates := 0
+	e := external{
+		batcher: newUpdateBatcher(time.Millisecond, clock.RealClock{}),
+		service: &ListService{
+			batchClient: &fakeBatchUpdateClient{err: status.Error(codes.Unimplemented, "unknown method BatchUpdateLists")},
+			grpcClient: &fakeListServiceClient{
+				MockUpdateListItems: func(_ context.Context, _ *listServicepb.UpdateListItemsReq, _ ...grpc.CallOption) (*listServicepb.UpdateListItemsResp, error) {
+					updates++
+					return &listServicepb.UpdateListItemsResp{Status: statusSuccess}, nil
+				},
+			},
+		},
+	}
+
+	if _, err := e.Update(context.Background(), grpcKindWith("cool-list", withItems(1))); err != nil {
+		t.Fatalf("e.Update(...): %v", err)
+	}
+	if updates != 1 {
+		t.Errorf("e.Update(...): want UpdateListItems used if the backend doesn't serve BatchUpdateLists, got %d calls", updates)
+	}
+}
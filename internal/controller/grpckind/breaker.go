@@ -0,0 +1,184 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpckind
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"k8s.io/utils/clock"
+
+	"github.com/crossplane/provider-grpc/internal/grpcerr"
+)
+
+const errCircuitOpenFmt = "not calling backend %q for %s after %d consecutive failures"
+
+// BreakerThreshold is how many consecutive calls to a backend endpoint must
+// fail for a transient reason before calls to it are short-circuited. Zero
+// disables circuit breaking.
+var BreakerThreshold = 5
+
+// BreakerCooldown is how long calls to a backend endpoint are short-circuited
+// once its circuit breaker opens.
+var BreakerCooldown = 30 * time.Second
+
+// circuitBreakers stop calling backend endpoints that repeatedly fail, rather
+// than hammering them with calls that are likely to fail too. Once an
+// endpoint's breaker has been open for the cool-down it's half-open: a single
+// call is let through to probe the endpoint, while other calls are still
+// short-circuited. The breaker closes if the probe succeeds, and opens again
+// if it fails. A probe whose outcome is never recorded is followed by another
+// once the cool-down passed again.
+type circuitBreakers struct {
+	threshold int
+	cooldown  time.Duration
+	clock     clock.PassiveClock
+
+	mu        sync.Mutex
+	endpoints map[string]*breaker
+}
+
+type breaker struct {
+	failures  int
+	openUntil time.Time
+}
+
+// newCircuitBreakers returns circuitBreakers that open after the supplied
+// number of consecutive failures, for the supplied cool-down.
+func newCircuitBreakers(threshold int, cooldown time.Duration, c clock.PassiveClock) *circuitBreakers {
+	return &circuitBreakers{
+		threshold: threshold,
+		cooldown:  cooldown,
+		clock:     c,
+		endpoints: make(map[string]*breaker),
+	}
+}
+
+// Allow returns a transient error if the supplied endpoint's breaker is open.
+// If it's half-open the call Allow lets through is the probe, so the caller
+// must record the call's outcome.
+func (cb *circuitBreakers) Allow(endpoint string) error {
+	return cb.allow(endpoint, true)
+}
+
+// Check returns a transient error if the supplied endpoint's breaker is open,
+// like Allow, but doesn't let a probe through if it's half-open.
+func (cb *circuitBreakers) Check(endpoint string) error {
+	return cb.allow(endpoint, false)
+}
+
+func (cb *circuitBreakers) allow(endpoint string, probe bool) error {
+	if cb == nil || cb.threshold <= 0 {
+		return nil
+	}
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+
+	b, ok := cb.endpoints[endpoint]
+	if !ok || b.failures < cb.threshold {
+		return nil
+	}
+	now := cb.clock.Now()
+	if remaining := b.openUntil.Sub(now); remaining > 0 {
+		return status.Errorf(codes.Unavailable, errCircuitOpenFmt, endpoint, remaining.Round(time.Second), b.failures)
+	}
+	if probe {
+		// Short-circuit other calls while the probe is in flight.
+		b.openUntil = now.Add(cb.cooldown)
+	}
+	return nil
+}
+
+// Record records the outcome of a call to the supplied endpoint. Only errors
+// that indicate a transient failure of the backend count as failures.
+func (cb *circuitBreakers) Record(endpoint string, err error) {
+	if cb == nil || cb.threshold <= 0 {
+		return
+	}
+	if !grpcerr.IsRetryable(err) {
+		cb.Succeeded(endpoint)
+		return
+	}
+	cb.Failed(endpoint)
+}
+
+// Failed records that a call to the supplied endpoint failed, opening its
+// breaker if the threshold was reached.
+func (cb *circuitBreakers) Failed(endpoint string) {
+	if cb == nil || cb.threshold <= 0 {
+		return
+	}
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+
+	b, ok := cb.endpoints[endpoint]
+	if !ok {
+		b = &breaker{}
+		cb.endpoints[endpoint] = b
+	}
+	b.failures++
+	if b.failures >= cb.threshold {
+		b.openUntil = cb.clock.Now().Add(cb.cooldown)
+		log.Infof("Backend %q failed %d consecutive times. Not calling it for %s", endpoint, b.failures, cb.cooldown)
+	}
+}
+
+// Succeeded records that a call to the supplied endpoint succeeded, closing
+// its breaker.
+func (cb *circuitBreakers) Succeeded(endpoint string) {
+	if cb == nil || cb.threshold <= 0 {
+		return
+	}
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+	delete(cb.endpoints, endpoint)
+}
+
+// intercept is a UnaryClientInterceptor that short-circuits calls to endpoints
+// whose breaker is open, and records the outcome of other calls.
+func (cb *circuitBreakers) intercept(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	if err := cb.Allow(cc.Target()); err != nil {
+		return err
+	}
+	err := invoker(ctx, method, req, reply, cc, opts...)
+	// Calls we gave up on say nothing about the backend's health.
+	if ctx.Err() == nil {
+		cb.Record(cc.Target(), err)
+	}
+	return err
+}
+
+// interceptStream is a StreamClientInterceptor that short-circuits streams to
+// endpoints whose breaker is open, and records the outcome of other streams
+// once they ended.
+func (cb *circuitBreakers) interceptStream(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+	if err := cb.Allow(cc.Target()); err != nil {
+		return nil, err
+	}
+	s, err := streamer(ctx, desc, cc, method, opts...)
+	return onEnd(desc, s, err, func(err error) {
+		// Streams we gave up on say nothing about the backend's health.
+		if ctx.Err() == nil {
+			cb.Record(cc.Target(), err)
+		}
+	})
+}
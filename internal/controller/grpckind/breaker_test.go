@@ -0,0 +1,122 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpckind
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	testingclock "k8s.io/utils/clock/testing"
+)
+
+func TestCircuitBreaker(t *testing.T) {
+	clk := testingclock.NewFakeClock(time.Now())
+	cb := newCircuitBreakers(3, 30*time.Second, clk)
+
+	cc, err := grpc.Dial("passthrough:///cool:50051", grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Fatalf("grpc.Dial(...): %v", err)
+	}
+	defer func() { _ = cc.Close() }()
+
+	calls := 0
+	unavailable := func(_ context.Context, _ string, _, _ interface{}, _ *grpc.ClientConn, _ ...grpc.CallOption) error {
+		calls++
+		return status.Error(codes.Unavailable, "backend unavailable")
+	}
+	notFound := func(_ context.Context, _ string, _, _ interface{}, _ *grpc.ClientConn, _ ...grpc.CallOption) error {
+		calls++
+		return status.Error(codes.NotFound, "no such list")
+	}
+	ok := func(_ context.Context, _ string, _, _ interface{}, _ *grpc.ClientConn, _ ...grpc.CallOption) error {
+		calls++
+		return nil
+	}
+
+	// Errors that don't indicate the backend is failing don't count.
+	_ = cb.intercept(context.Background(), "/cool", nil, nil, cc, unavailable)
+	_ = cb.intercept(context.Background(), "/cool", nil, nil, cc, unavailable)
+	_ = cb.intercept(context.Background(), "/cool", nil, nil, cc, notFound)
+	_ = cb.intercept(context.Background(), "/cool", nil, nil, cc, unavailable)
+	_ = cb.intercept(context.Background(), "/cool", nil, nil, cc, unavailable)
+	if err := cb.Allow(cc.Target()); err != nil {
+		t.Fatalf("cb.Allow(...): want the breaker closed before %d consecutive failures, got %v", 3, err)
+	}
+
+	// The breaker opens after the threshold of consecutive failures, and
+	// short-circuits calls with a transient error.
+	_ = cb.intercept(context.Background(), "/cool", nil, nil, cc, unavailable)
+	calls = 0
+	if err := cb.intercept(context.Background(), "/cool", nil, nil, cc, ok); status.Code(err) != codes.Unavailable {
+		t.Errorf("cb.intercept(...): want an Unavailable error while the breaker is open, got %v", err)
+	}
+	if calls != 0 {
+		t.Errorf("cb.intercept(...): want no calls while the breaker is open, got %d", calls)
+	}
+	if err := cb.Allow("elsewhere:50051"); err != nil {
+		t.Errorf("cb.Allow(...): want other endpoints unaffected, got %v", err)
+	}
+
+	// A failed call after the cool-down opens the breaker again.
+	clk.Step(30 * time.Second)
+	_ = cb.intercept(context.Background(), "/cool", nil, nil, cc, unavailable)
+	if calls != 1 {
+		t.Errorf("cb.intercept(...): want a call let through after the cool-down, got %d", calls)
+	}
+	if err := cb.Allow(cc.Target()); status.Code(err) != codes.Unavailable {
+		t.Errorf("cb.Allow(...): want the breaker open after the call let through failed, got %v", err)
+	}
+
+	// Once the cool-down passed only one call is let through, to probe the
+	// backend. Checking the breaker doesn't use up the probe.
+	clk.Step(30 * time.Second)
+	if err := cb.Check(cc.Target()); err != nil {
+		t.Errorf("cb.Check(...): want the breaker half-open after the cool-down, got %v", err)
+	}
+	if err := cb.Allow(cc.Target()); err != nil {
+		t.Errorf("cb.Allow(...): want the probe let through after the cool-down, got %v", err)
+	}
+	if err := cb.Allow(cc.Target()); status.Code(err) != codes.Unavailable {
+		t.Errorf("cb.Allow(...): want calls short-circuited while the probe is in flight, got %v", err)
+	}
+
+	// A probe whose outcome is never recorded is followed by another once
+	// the cool-down passed again. A successful probe closes the breaker.
+	clk.Step(30 * time.Second)
+	if err := cb.intercept(context.Background(), "/cool", nil, nil, cc, ok); err != nil {
+		t.Errorf("cb.intercept(...): want the call let through after the cool-down, got %v", err)
+	}
+	_ = cb.intercept(context.Background(), "/cool", nil, nil, cc, unavailable)
+	if err := cb.Allow(cc.Target()); err != nil {
+		t.Errorf("cb.Allow(...): want the breaker closed after a successful call, got %v", err)
+	}
+}
+
+func TestCircuitBreakerDisabled(t *testing.T) {
+	cb := newCircuitBreakers(0, time.Minute, testingclock.NewFakeClock(time.Now()))
+	for i := 0; i < 10; i++ {
+		cb.Failed("cool:50051")
+	}
+	if err := cb.Allow("cool:50051"); err != nil {
+		t.Errorf("cb.Allow(...): want no short-circuiting with a zero threshold, got %v", err)
+	}
+}
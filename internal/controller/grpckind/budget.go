@@ -0,0 +1,99 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpckind
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	"github.com/crossplane/provider-grpc/apis/mygroup/v1alpha1"
+)
+
+// MaxReconcileDuration bounds how long a reconcile may spend sending the
+// chunks of a chunked update, so that updating a very large list doesn't
+// monopolize a worker. Once it's exceeded the chunks applied so far are kept,
+// and the GrpcKind is reconciled again to resume the update. At least one
+// chunk is applied per reconcile. Zero disables the bound.
+var MaxReconcileDuration time.Duration
+
+// TypeUpdateComplete indicates whether the last update of a GrpcKind's list
+// applied all of its items, or stopped part way through because the reconcile
+// ran out of time.
+const TypeUpdateComplete xpv1.ConditionType = "UpdateComplete"
+
+// Condition reasons used for the UpdateComplete condition.
+const (
+	reasonUpdateComplete  xpv1.ConditionReason = "Complete"
+	reasonPartialProgress xpv1.ConditionReason = "PartialProgress"
+)
+
+// An updateIncompleteError is returned when a chunked update stopped part way
+// through because the reconcile ran out of time.
+type updateIncompleteError struct {
+	applied int
+	total   int
+}
+
+func (e *updateIncompleteError) Error() string {
+	return fmt.Sprintf("applied %d of %d items before the reconcile ran out of time", e.applied, e.total)
+}
+
+// outOfTime returns true if the reconcile has used up its time.
+func (c *external) outOfTime() bool {
+	if c.deadline.IsZero() {
+		return false
+	}
+	return !c.now().Time.Before(c.deadline)
+}
+
+// recordProgress records whether the supplied error returned by an update
+// means the update stopped part way through, in which case the GrpcKind is
+// reconciled again to resume it. It returns true if it did, in which case the
+// update is not considered failed.
+func (c *external) recordProgress(cr *v1alpha1.GrpcKind, err error) bool {
+	var ie *updateIncompleteError
+	if !errors.As(err, &ie) {
+		if err == nil && cr.GetCondition(TypeUpdateComplete).Status == corev1.ConditionFalse {
+			cr.Status.SetConditions(xpv1.Condition{
+				Type:               TypeUpdateComplete,
+				Status:             corev1.ConditionTrue,
+				LastTransitionTime: c.now(),
+				Reason:             reasonUpdateComplete,
+			})
+		}
+		return false
+	}
+
+	log.Infof("Update:: Update of list \"%v\" is incomplete: %v. Resuming it in the next reconcile", logName(cr.Spec.ForProvider.Name), ie)
+	cr.Status.SetConditions(xpv1.Condition{
+		Type:               TypeUpdateComplete,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: c.now(),
+		Reason:             reasonPartialProgress,
+		Message:            ie.Error(),
+	})
+	if c.requeue != nil {
+		c.requeue(cr.DeepCopy())
+	}
+	return true
+}
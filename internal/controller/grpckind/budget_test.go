@@ -0,0 +1,104 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpckind
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/grpc"
+	corev1 "k8s.io/api/core/v1"
+	testingclock "k8s.io/utils/clock/testing"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	listServicepb "github.com/ashwinshirva/provider-grpc-server/proto"
+	"github.com/crossplane/provider-grpc/apis/mygroup/v1alpha1"
+	apisv1alpha1 "github.com/crossplane/provider-grpc/apis/v1alpha1"
+)
+
+func TestUpdateOutOfTime(t *testing.T) {
+	items := []int32{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}
+	clk := testingclock.NewFakeClock(time.Now())
+	backend := &chunkedBackend{limitOffset: -1}
+	chunks := newChunkTracker()
+	requeued := 0
+
+	// Each chunk takes a second to apply.
+	newExternal := func() *external {
+		return &external{
+			clock:    clk,
+			deadline: clk.Now().Add(2 * time.Second),
+			pc:       apisv1alpha1.ProviderConfigSpec{UpdateChunkSize: int32Ptr(3)},
+			chunks:   chunks,
+			requeue:  func(_ *v1alpha1.GrpcKind) { requeued++ },
+			service: &ListService{grpcClient: &fakeListServiceClient{
+				MockUpdateListItems: func(ctx context.Context, in *listServicepb.UpdateListItemsReq, opts ...grpc.CallOption) (*listServicepb.UpdateListItemsResp, error) {
+					clk.Step(time.Second)
+					return backend.UpdateListItems(ctx, in, opts...)
+				},
+			}},
+		}
+	}
+	cr := grpcKindWith("cool-list", withItems(items...))
+	cr.SetUID("cool-uid")
+
+	// The first reconcile runs out of time after two chunks. Their progress is
+	// kept, and the GrpcKind is reconciled again.
+	if _, err := newExternal().Update(context.Background(), cr); err != nil {
+		t.Fatalf("e.Update(...): want no error when the reconcile runs out of time, got %v", err)
+	}
+	if diff := cmp.Diff([]int{0, 3}, backend.offsets); diff != "" {
+		t.Errorf("e.Update(...): -want chunk offsets, +got chunk offsets:\n%s\n", diff)
+	}
+	want := xpv1.Condition{
+		Type:    TypeUpdateComplete,
+		Status:  corev1.ConditionFalse,
+		Reason:  reasonPartialProgress,
+		Message: "applied 6 of 10 items before the reconcile ran out of time",
+	}
+	if diff := cmp.Diff(want, cr.GetCondition(TypeUpdateComplete), test.EquateConditions()); diff != "" {
+		t.Errorf("e.Update(...): -want condition, +got condition:\n%s\n", diff)
+	}
+	if requeued != 1 {
+		t.Errorf("e.Update(...): want the GrpcKind reconciled again, got %d requeues", requeued)
+	}
+	if cr.Status.AtProvider.RetryCount != 0 {
+		t.Errorf("e.Update(...): want partial progress not treated as a failure, got %d retries", cr.Status.AtProvider.RetryCount)
+	}
+
+	// The next reconcile resumes the update and completes it.
+	if _, err := newExternal().Update(context.Background(), cr); err != nil {
+		t.Fatalf("e.Update(...): %v", err)
+	}
+	if diff := cmp.Diff([]int{0, 3, 6, 9}, backend.offsets); diff != "" {
+		t.Errorf("e.Update(...): -want chunk offsets, +got chunk offsets:\n%s\n", diff)
+	}
+	if diff := cmp.Diff(items, backend.items); diff != "" {
+		t.Errorf("e.Update(...): -want items, +got items:\n%s\n", diff)
+	}
+	want = xpv1.Condition{Type: TypeUpdateComplete, Status: corev1.ConditionTrue, Reason: reasonUpdateComplete}
+	if diff := cmp.Diff(want, cr.GetCondition(TypeUpdateComplete), test.EquateConditions()); diff != "" {
+		t.Errorf("e.Update(...): -want condition, +got condition:\n%s\n", diff)
+	}
+	if requeued != 1 {
+		t.Errorf("e.Update(...): want a completed update not reconciled again, got %d requeues", requeued)
+	}
+}
@@ -0,0 +1,103 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpckind
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+
+	listServicepb "github.com/ashwinshirva/provider-grpc-server/proto"
+)
+
+// bulkChunkSize is the number of items sent per BulkUpdateItems message.
+// Updates with fewer items use UpdateListItems.
+const bulkChunkSize = 1000
+
+// bulkUpdateItemsMethod is a client-streaming RPC some backends serve in
+// addition to the generated ListService. Each message carries a chunk of the
+// new items, and the backend replaces the list's items with all chunks once
+// the stream is closed.
+const bulkUpdateItemsMethod = "/proto.ListService/BulkUpdateItems"
+
+// A bulkUpdateClient updates list items using the BulkUpdateItems RPC.
+type bulkUpdateClient interface {
+	BulkUpdateItems(ctx context.Context, opts ...grpc.CallOption) (bulkUpdateItemsStream, error)
+}
+
+// A bulkUpdateItemsStream sends chunks of items to BulkUpdateItems.
+type bulkUpdateItemsStream interface {
+	Send(*listServicepb.UpdateListItemsReq) error
+	CloseAndRecv() (*listServicepb.UpdateListItemsResp, error)
+}
+
+// newBulkUpdateClient returns a bulkUpdateClient that uses the supplied
+// connection.
+func newBulkUpdateClient(cc grpc.ClientConnInterface) bulkUpdateClient {
+	return &bulkClient{cc: cc}
+}
+
+type bulkClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func (c *bulkClient) BulkUpdateItems(ctx context.Context, opts ...grpc.CallOption) (bulkUpdateItemsStream, error) {
+	stream, err := c.cc.NewStream(ctx, &grpc.StreamDesc{StreamName: "BulkUpdateItems", ClientStreams: true}, bulkUpdateItemsMethod, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &bulkUpdateItemsClientStream{ClientStream: stream}, nil
+}
+
+type bulkUpdateItemsClientStream struct {
+	grpc.ClientStream
+}
+
+func (x *bulkUpdateItemsClientStream) Send(m *listServicepb.UpdateListItemsReq) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *bulkUpdateItemsClientStream) CloseAndRecv() (*listServicepb.UpdateListItemsResp, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(listServicepb.UpdateListItemsResp)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// bulkUpdateItems streams the supplied items to the backend in chunks of
+// bulkChunkSize items.
+func bulkUpdateItems(ctx context.Context, c bulkUpdateClient, name string, items []int32) (*listServicepb.UpdateListItemsResp, error) {
+	stream, err := c.BulkUpdateItems(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for start := 0; start < len(items); start += bulkChunkSize {
+		end := start + bulkChunkSize
+		if end > len(items) {
+			end = len(items)
+		}
+		if err := stream.Send(&listServicepb.UpdateListItemsReq{Name: name, NewItems: items[start:end]}); err != nil {
+			// The reason the stream failed is returned by CloseAndRecv.
+			break
+		}
+	}
+	return stream.CloseAndRecv()
+}
@@ -0,0 +1,148 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpckind
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/clock"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-grpc/apis/mygroup/v1alpha1"
+)
+
+// Actions and results of the reconcile outcomes posted to callbacks.
+const (
+	actionObserve = "Observe"
+	actionCreate  = "Create"
+	actionUpdate  = "Update"
+	actionDelete  = "Delete"
+
+	resultSuccess = "Success"
+	resultError   = "Error"
+)
+
+// callbackClient posts reconcile outcomes to callbacks. Outcomes that can't be
+// posted within its timeout are dropped.
+var callbackClient = &http.Client{Timeout: 10 * time.Second}
+
+// A reconcileOutcome is the outcome of a call made to reconcile a managed
+// resource, as posted to a ProviderConfig's callback.
+type reconcileOutcome struct {
+	// Resource is the name of the managed resource, qualified by its
+	// namespace if it has one.
+	Resource string `json:"resource"`
+
+	// List is the name of the managed resource's list.
+	List string `json:"list"`
+
+	// Action is the call that was made: Observe, Create, Update, or Delete.
+	Action string `json:"action"`
+
+	// Result is Success if the call succeeded, or Error if it didn't, in
+	// which case Error describes why.
+	Result string `json:"result"`
+	Error  string `json:"error,omitempty"`
+
+	// Time is when the call completed.
+	Time metav1.Time `json:"time"`
+}
+
+// A callbackExternal posts the outcome of each call of the ExternalClient it
+// wraps to a callback URL. Outcomes are posted in the background on a best
+// effort basis, so that an unavailable callback never slows down or fails a
+// reconcile.
+type callbackExternal struct {
+	managed.ExternalClient
+	url    string
+	client *http.Client
+
+	// clock tells the time calls complete at. The real clock is used if it's
+	// nil.
+	clock clock.PassiveClock
+}
+
+func (e *callbackExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	o, err := e.ExternalClient.Observe(ctx, mg)
+	e.post(mg, actionObserve, err)
+	return o, err
+}
+
+func (e *callbackExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	c, err := e.ExternalClient.Create(ctx, mg)
+	e.post(mg, actionCreate, err)
+	return c, err
+}
+
+func (e *callbackExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	u, err := e.ExternalClient.Update(ctx, mg)
+	e.post(mg, actionUpdate, err)
+	return u, err
+}
+
+func (e *callbackExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	err := e.ExternalClient.Delete(ctx, mg)
+	e.post(mg, actionDelete, err)
+	return err
+}
+
+// post posts the outcome of the supplied action in the background.
+func (e *callbackExternal) post(mg resource.Managed, action string, err error) {
+	o := reconcileOutcome{
+		Resource: mg.GetName(),
+		Action:   action,
+		Result:   resultSuccess,
+		Time:     metav1.Now(),
+	}
+	if e.clock != nil {
+		o.Time = metav1.NewTime(e.clock.Now())
+	}
+	if ns := mg.GetNamespace(); ns != "" {
+		o.Resource = ns + "/" + o.Resource
+	}
+	if cr, ok := mg.(*v1alpha1.GrpcKind); ok {
+		o.List = cr.Spec.ForProvider.Name
+	}
+	if err != nil {
+		o.Result, o.Error = resultError, err.Error()
+	}
+
+	body, err := json.Marshal(o)
+	if err != nil {
+		log.Warnf("Callback::Cannot encode the outcome of %s %q: %v", action, o.Resource, err)
+		return
+	}
+	go func() {
+		resp, err := e.client.Post(e.url, "application/json", bytes.NewReader(body))
+		if err != nil {
+			log.Warnf("Callback::Cannot post the outcome of %s %q: %v", action, o.Resource, err)
+			return
+		}
+		_ = resp.Body.Close()
+		if resp.StatusCode >= http.StatusBadRequest {
+			log.Warnf("Callback::Callback rejected the outcome of %s %q: %s", action, o.Resource, resp.Status)
+		}
+	}()
+}
@@ -0,0 +1,165 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpckind
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	testingclock "k8s.io/utils/clock/testing"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+)
+
+func TestCallbackExternal(t *testing.T) {
+	errBoom := errors.New("boom")
+	now := metav1.NewTime(time.Date(2022, 6, 1, 12, 0, 0, 0, time.UTC))
+
+	cases := map[string]struct {
+		reason string
+		call   func(ctx context.Context, e managed.ExternalClient, mg resource.Managed) error
+		want   reconcileOutcome
+	}{
+		"ObserveSuccess": {
+			reason: "A successful observation should be posted.",
+			call: func(ctx context.Context, e managed.ExternalClient, mg resource.Managed) error {
+				_, err := e.Observe(ctx, mg)
+				return err
+			},
+			want: reconcileOutcome{Resource: "cool-gk", List: "cool-list", Action: actionObserve, Result: resultSuccess, Time: now},
+		},
+		"CreateError": {
+			reason: "A failed creation should be posted along with its error.",
+			call: func(ctx context.Context, e managed.ExternalClient, mg resource.Managed) error {
+				_, err := e.Create(ctx, mg)
+				return err
+			},
+			want: reconcileOutcome{Resource: "cool-gk", List: "cool-list", Action: actionCreate, Result: resultError, Error: errBoom.Error(), Time: now},
+		},
+		"Update": {
+			reason: "A successful update should be posted.",
+			call: func(ctx context.Context, e managed.ExternalClient, mg resource.Managed) error {
+				_, err := e.Update(ctx, mg)
+				return err
+			},
+			want: reconcileOutcome{Resource: "cool-gk", List: "cool-list", Action: actionUpdate, Result: resultSuccess, Time: now},
+		},
+		"DeleteError": {
+			reason: "A failed deletion should be posted along with its error.",
+			call: func(ctx context.Context, e managed.ExternalClient, mg resource.Managed) error {
+				return e.Delete(ctx, mg)
+			},
+			want: reconcileOutcome{Resource: "cool-gk", List: "cool-list", Action: actionDelete, Result: resultError, Error: errBoom.Error(), Time: now},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			posted := make(chan reconcileOutcome, 1)
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodPost || r.Header.Get("Content-Type") != "application/json" {
+					t.Errorf("\n%s\ncallback: want a JSON POST, got %s %s", tc.reason, r.Method, r.Header.Get("Content-Type"))
+				}
+				o := reconcileOutcome{}
+				if err := json.NewDecoder(r.Body).Decode(&o); err != nil {
+					t.Errorf("\n%s\ncallback: cannot decode outcome: %v", tc.reason, err)
+				}
+				posted <- o
+			}))
+			defer srv.Close()
+
+			e := &callbackExternal{
+				ExternalClient: managed.ExternalClientFns{
+					ObserveFn: func(_ context.Context, _ resource.Managed) (managed.ExternalObservation, error) {
+						return managed.ExternalObservation{}, nil
+					},
+					CreateFn: func(_ context.Context, _ resource.Managed) (managed.ExternalCreation, error) {
+						return managed.ExternalCreation{}, errBoom
+					},
+					UpdateFn: func(_ context.Context, _ resource.Managed) (managed.ExternalUpdate, error) {
+						return managed.ExternalUpdate{}, nil
+					},
+					DeleteFn: func(_ context.Context, _ resource.Managed) error {
+						return errBoom
+					},
+				},
+				url:    srv.URL,
+				client: srv.Client(),
+				clock:  testingclock.NewFakePassiveClock(now.Time),
+			}
+			cr := grpcKind("cool-list")
+			cr.SetName("cool-gk")
+
+			wantErr := error(nil)
+			if tc.want.Result == resultError {
+				wantErr = errBoom
+			}
+			if err := tc.call(context.Background(), e, cr); !cmp.Equal(wantErr, err, test.EquateErrors()) {
+				t.Errorf("\n%s\ne: want the wrapped client's error %v, got %v", tc.reason, wantErr, err)
+			}
+
+			select {
+			case got := <-posted:
+				if diff := cmp.Diff(tc.want, got); diff != "" {
+					t.Errorf("\n%s\ne: -want outcome, +got outcome:\n%s\n", tc.reason, diff)
+				}
+			case <-time.After(5 * time.Second):
+				t.Errorf("\n%s\ne: want the outcome posted to the callback", tc.reason)
+			}
+		})
+	}
+}
+
+func TestCallbackExternalUnavailable(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		time.Sleep(100 * time.Millisecond)
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer srv.Close()
+
+	e := &callbackExternal{
+		ExternalClient: managed.ExternalClientFns{
+			ObserveFn: func(_ context.Context, _ resource.Managed) (managed.ExternalObservation, error) {
+				return managed.ExternalObservation{ResourceExists: true}, nil
+			},
+		},
+		url:    srv.URL,
+		client: srv.Client(),
+	}
+
+	// The callback doesn't block or fail the observation.
+	start := time.Now()
+	o, err := e.Observe(context.Background(), grpcKind("cool-list"))
+	if err != nil {
+		t.Fatalf("e.Observe(...): want no error when the callback is unavailable, got %v", err)
+	}
+	if !o.ResourceExists {
+		t.Errorf("e.Observe(...): want the wrapped client's observation")
+	}
+	if elapsed := time.Since(start); elapsed >= 100*time.Millisecond {
+		t.Errorf("e.Observe(...): want the outcome posted in the background, took %s", elapsed)
+	}
+}
@@ -0,0 +1,87 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpckind
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+
+	"google.golang.org/grpc/metadata"
+
+	"github.com/crossplane/provider-grpc/apis/mygroup/v1alpha1"
+)
+
+// checksumKey is the metadata key used to send the checksum of a list's
+// desired items with GetList, and to receive the checksum of its items with
+// GetList's response header. A backend that reports the same checksum it was
+// sent may omit the list's items from its response.
+const checksumKey = "x-list-checksum"
+
+// itemsChecksum returns the checksum of the supplied items: the hex encoded
+// SHA-256 digest of each item as a big-endian int32, in order.
+func itemsChecksum(items []int32) string {
+	h := sha256.New()
+	b := make([]byte, 4)
+	for _, i := range items {
+		binary.BigEndian.PutUint32(b, uint32(i))
+		_, _ = h.Write(b)
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// checksumComparable returns true if the GrpcKind's items may be compared by
+// checksum, i.e. only if they must exactly match the backend's items.
+func (c *external) checksumComparable(cr *v1alpha1.GrpcKind) bool {
+	return mergeStrategy(cr) != v1alpha1.MergeUnion && itemTolerance(c.pc) == 0
+}
+
+// withChecksum returns a context that sends the checksum of the GrpcKind's
+// desired items to the backend, if they may be compared by checksum.
+func (c *external) withChecksum(ctx context.Context, cr *v1alpha1.GrpcKind) context.Context {
+	if !c.checksumComparable(cr) {
+		return ctx
+	}
+	return metadata.AppendToOutgoingContext(ctx, checksumKey, itemsChecksum(cr.Spec.ForProvider.ListItems))
+}
+
+// compareChecksum records in the observed list whether its items match the
+// GrpcKind's desired items according to the checksum the backend reported, if
+// it reported one and the items may be compared by checksum. Items a backend
+// omitted because they match are observed to be the desired items.
+func (c *external) compareChecksum(cr *v1alpha1.GrpcKind, obs *observedList) {
+	got := obs.header.Get(checksumKey)
+	if len(got) == 0 || !c.checksumComparable(cr) {
+		return
+	}
+	match := got[0] == itemsChecksum(cr.Spec.ForProvider.ListItems)
+	obs.itemsMatch = &match
+	if match && len(obs.items) == 0 && len(cr.Spec.ForProvider.ListItems) > 0 {
+		obs.items = append([]int32{}, cr.Spec.ForProvider.ListItems...)
+	}
+}
+
+// itemsChanged returns true if the observed list's items differ from the
+// GrpcKind's desired items. They're compared by checksum if the backend
+// reported one, and otherwise item by item.
+func (c *external) itemsChanged(cr *v1alpha1.GrpcKind, obs *observedList) bool {
+	if obs.itemsMatch != nil {
+		return !*obs.itemsMatch
+	}
+	return itemsDrifted(cr, obs.items, itemTolerance(c.pc))
+}
@@ -0,0 +1,112 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpckind
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+
+	listServicepb "github.com/ashwinshirva/provider-grpc-server/proto"
+)
+
+func TestObserveChecksum(t *testing.T) {
+	type want struct {
+		o    managed.ExternalObservation
+		sent string
+	}
+
+	cases := map[string]struct {
+		reason   string
+		checksum string
+		items    []int32
+		want     want
+	}{
+		"ChecksumMatches": {
+			reason:   "A list whose reported checksum matches its desired items should be up to date, even if its items are omitted.",
+			checksum: itemsChecksum([]int32{1, 2}),
+			want: want{
+				o:    managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true, ConnectionDetails: managed.ConnectionDetails{}},
+				sent: itemsChecksum([]int32{1, 2}),
+			},
+		},
+		"ChecksumDiffers": {
+			reason:   "A list whose reported checksum differs from its desired items should need an update, even if its items appear equal.",
+			checksum: itemsChecksum([]int32{2, 1}),
+			items:    []int32{1, 2},
+			want: want{
+				o:    managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false, ConnectionDetails: managed.ConnectionDetails{}},
+				sent: itemsChecksum([]int32{1, 2}),
+			},
+		},
+		"NoChecksum": {
+			reason: "A list whose checksum isn't reported should be compared item by item.",
+			items:  []int32{1},
+			want: want{
+				o:    managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false, ConnectionDetails: managed.ConnectionDetails{}},
+				sent: itemsChecksum([]int32{1, 2}),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var sent string
+			e := external{service: &ListService{grpcClient: &fakeListServiceClient{
+				MockGetList: func(ctx context.Context, _ *listServicepb.GetListReq, opts ...grpc.CallOption) (*listServicepb.GetListResp, error) {
+					if md, ok := metadata.FromOutgoingContext(ctx); ok && len(md.Get(checksumKey)) > 0 {
+						sent = md.Get(checksumKey)[0]
+					}
+					for _, o := range opts {
+						if h, ok := o.(grpc.HeaderCallOption); ok && tc.checksum != "" {
+							*h.HeaderAddr = metadata.Pairs(checksumKey, tc.checksum)
+						}
+					}
+					return &listServicepb.GetListResp{Status: statusSuccess, Items: tc.items}, nil
+				},
+			}}}
+			cr := grpcKindWith("cool-list", withItems(1, 2))
+
+			o, err := e.Observe(context.Background(), cr)
+			if err != nil {
+				t.Fatalf("\n%s\ne.Observe(...): unexpected error: %v", tc.reason, err)
+			}
+			if diff := cmp.Diff(tc.want.o, o); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.sent, sent); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want sent checksum, +got sent checksum:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestItemsChecksum(t *testing.T) {
+	if itemsChecksum([]int32{1, 2}) == itemsChecksum([]int32{2, 1}) {
+		t.Errorf("itemsChecksum(...): want the checksum to depend on the order of items")
+	}
+	// The SHA-256 digest of no input.
+	want := "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855"
+	if diff := cmp.Diff(want, itemsChecksum(nil)); diff != "" {
+		t.Errorf("itemsChecksum(...): -want, +got:\n%s\n", diff)
+	}
+}
@@ -0,0 +1,148 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpckind
+
+import (
+	"context"
+	"reflect"
+	"strconv"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+
+	listServicepb "github.com/ashwinshirva/provider-grpc-server/proto"
+)
+
+// Request metadata keys sent with each chunk of a chunked UpdateListItems.
+// The backend writes the chunk's items starting at the offset, and truncates
+// the list to the total number of items.
+const (
+	chunkOffsetKey = "x-list-chunk-offset"
+	chunkTotalKey  = "x-list-chunk-total"
+)
+
+// chunkBackoff bounds how often a chunk the backend rate limited is retried
+// before the update fails. The next update resumes from that chunk.
+var chunkBackoff = wait.Backoff{
+	Duration: 500 * time.Millisecond,
+	Factor:   2,
+	Steps:    4,
+}
+
+// A chunkTracker remembers how many items of each managed resource's list
+// were applied by a chunked update that didn't complete.
+type chunkTracker struct {
+	mu       sync.Mutex
+	progress map[types.UID]chunkProgress
+}
+
+type chunkProgress struct {
+	items   []int32
+	applied int
+}
+
+// newChunkTracker returns an empty chunkTracker.
+func newChunkTracker() *chunkTracker {
+	return &chunkTracker{progress: make(map[types.UID]chunkProgress)}
+}
+
+// Resume returns how many of the supplied items were already applied to the
+// list of the managed resource with the supplied UID. Nothing was applied if
+// the items differ from those of the incomplete update. A nil chunkTracker
+// never resumes.
+func (t *chunkTracker) Resume(uid types.UID, items []int32) int {
+	if t == nil {
+		return 0
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	p, ok := t.progress[uid]
+	if !ok || !reflect.DeepEqual(p.items, items) {
+		return 0
+	}
+	return p.applied
+}
+
+// Record remembers that the first applied of the supplied items were applied
+// to the list of the managed resource with the supplied UID.
+func (t *chunkTracker) Record(uid types.UID, items []int32, applied int) {
+	if t == nil {
+		return
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.progress[uid] = chunkProgress{items: items, applied: applied}
+}
+
+// Forget forgets the progress of the managed resource with the supplied UID.
+func (t *chunkTracker) Forget(uid types.UID) {
+	if t == nil {
+		return
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	delete(t.progress, uid)
+}
+
+// updateListItemsChunked sends the items of the supplied request to
+// UpdateListItems in chunks of the supplied size, resuming after the chunks a
+// previous update already applied. Chunks the backend rate limits are retried
+// with chunkBackoff. It stops with an updateIncompleteError once the reconcile
+// ran out of time, after applying at least one chunk.
+func (c *external) updateListItemsChunked(ctx context.Context, uid types.UID, req *listServicepb.UpdateListItemsReq, size int) error {
+	items := req.NewItems
+	total := strconv.Itoa(len(items))
+	resumed := c.chunks.Resume(uid, items)
+	for start := resumed; start < len(items); {
+		if start > resumed && c.outOfTime() {
+			return &updateIncompleteError{applied: start, total: len(items)}
+		}
+		end := start + size
+		if end > len(items) {
+			end = len(items)
+		}
+		chunk := &listServicepb.UpdateListItemsReq{Name: req.Name, NewItems: items[start:end]}
+		cctx := metadata.AppendToOutgoingContext(ctx, chunkOffsetKey, strconv.Itoa(start), chunkTotalKey, total)
+
+		var err error
+		werr := wait.ExponentialBackoffWithContext(ctx, chunkBackoff, func() (bool, error) {
+			_, err = c.service.grpcClient.UpdateListItems(cctx, chunk)
+			return status.Code(err) != codes.ResourceExhausted, nil
+		})
+		if err == nil && werr != nil {
+			// The context was done before the chunk could be sent at all.
+			err = werr
+		}
+		if err != nil {
+			log.Infof("Update:: Applied %d of %d items to list \"%v\" before failing", start, len(items), logName(req.Name))
+			return err
+		}
+		start = end
+		c.chunks.Record(uid, items, start)
+	}
+	c.chunks.Forget(uid)
+	return nil
+}
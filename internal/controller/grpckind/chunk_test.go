@@ -0,0 +1,138 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpckind
+
+import (
+	"context"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	testingclock "k8s.io/utils/clock/testing"
+
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	listServicepb "github.com/ashwinshirva/provider-grpc-server/proto"
+
+	apisv1alpha1 "github.com/crossplane/provider-grpc/apis/v1alpha1"
+)
+
+// chunkedBackend applies chunked UpdateListItems calls to its items, rate
+// limiting the chunk at limitOffset the first limit times it is sent.
+type chunkedBackend struct {
+	items       []int32
+	offsets     []int
+	limitOffset int
+	limit       int
+}
+
+func (b *chunkedBackend) UpdateListItems(ctx context.Context, in *listServicepb.UpdateListItemsReq, _ ...grpc.CallOption) (*listServicepb.UpdateListItemsResp, error) {
+	md, _ := metadata.FromOutgoingContext(ctx)
+	offset, _ := strconv.Atoi(md.Get(chunkOffsetKey)[0])
+	total, _ := strconv.Atoi(md.Get(chunkTotalKey)[0])
+	b.offsets = append(b.offsets, offset)
+
+	if offset == b.limitOffset && b.limit > 0 {
+		b.limit--
+		return nil, status.Error(codes.ResourceExhausted, "slow down")
+	}
+
+	items := make([]int32, total)
+	copy(items, b.items)
+	copy(items[offset:], in.NewItems)
+	b.items = items
+	return &listServicepb.UpdateListItemsResp{Status: "UPDATED"}, nil
+}
+
+func TestUpdateChunked(t *testing.T) {
+	chunkBackoff.Duration = time.Millisecond
+	defer func() { chunkBackoff.Duration = 500 * time.Millisecond }()
+
+	items := []int32{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}
+	rateLimited := status.Error(codes.ResourceExhausted, "slow down")
+
+	type want struct {
+		errs    []error
+		offsets []int
+		items   []int32
+	}
+
+	cases := map[string]struct {
+		reason  string
+		backend *chunkedBackend
+		updates int
+		want    want
+	}{
+		"RetriedWithinUpdate": {
+			reason:  "A chunk rate limited fewer times than the backoff allows should be retried until the update completes.",
+			backend: &chunkedBackend{limitOffset: 6, limit: 2},
+			updates: 1,
+			want: want{
+				errs:    []error{nil},
+				offsets: []int{0, 3, 6, 6, 6, 9},
+				items:   items,
+			},
+		},
+		"ResumedByNextUpdate": {
+			reason:  "An update that fails mid-batch should be resumed from the first unapplied chunk by the next update.",
+			backend: &chunkedBackend{limitOffset: 6, limit: 5},
+			updates: 2,
+			want: want{
+				errs:    []error{rateLimited, nil},
+				offsets: []int{0, 3, 6, 6, 6, 6, 6, 6, 9},
+				items:   items,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			clk := testingclock.NewFakeClock(time.Now())
+			e := external{
+				clock:  clk,
+				pc:     apisv1alpha1.ProviderConfigSpec{UpdateChunkSize: int32Ptr(3)},
+				chunks: newChunkTracker(),
+				service: &ListService{grpcClient: &fakeListServiceClient{
+					MockUpdateListItems: tc.backend.UpdateListItems,
+				}},
+			}
+			cr := grpcKindWith("cool-list", withItems(items...))
+			cr.SetUID("cool-uid")
+
+			errs := make([]error, 0, tc.updates)
+			for i := 0; i < tc.updates; i++ {
+				_, err := e.Update(context.Background(), cr)
+				errs = append(errs, err)
+				clk.Step(retryMaxDelay)
+			}
+			if diff := cmp.Diff(tc.want.errs, errs, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\ne.Update(...): -want errors, +got errors:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.offsets, tc.backend.offsets); diff != "" {
+				t.Errorf("\n%s\ne.Update(...): -want chunk offsets, +got chunk offsets:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.items, tc.backend.items); diff != "" {
+				t.Errorf("\n%s\ne.Update(...): -want backend items, +got backend items:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
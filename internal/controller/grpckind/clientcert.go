@@ -0,0 +1,144 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpckind
+
+import (
+	"context"
+	"crypto/tls"
+	"sync"
+
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	apisv1alpha1 "github.com/crossplane/provider-grpc/apis/v1alpha1"
+)
+
+const errNoClientCert = "credentials no longer supply a TLS client certificate"
+
+// A clientCertLoader loads the PEM encoded client certificate and key supplied
+// by a ProviderConfig's credentials.
+type clientCertLoader func(ctx context.Context) (cert, key string, err error)
+
+// credentialsCertLoader returns a clientCertLoader that loads the client
+// certificate from the credentials of the supplied ProviderConfig spec.
+func credentialsCertLoader(kube client.Client, spec apisv1alpha1.ProviderConfigSpec) clientCertLoader {
+	return func(ctx context.Context) (string, string, error) {
+		data, err := extractCredentials(ctx, kube, spec)
+		if err != nil {
+			return "", "", errors.Wrap(err, errGetCreds)
+		}
+		bc, err := parseCredentials(data)
+		if err != nil {
+			return "", "", errors.Wrap(err, errGetCreds)
+		}
+		if bc == nil || bc.ClientCert == "" {
+			return "", "", errors.New(errNoClientCert)
+		}
+		return bc.ClientCert, bc.ClientKey, nil
+	}
+}
+
+// A clientCertificate authenticates connections to a backend using the client
+// certificate supplied by a ProviderConfig's credentials. The certificate is
+// loaded again each time a connection is established, so that new connections
+// use a rotated certificate without restarting the provider. The last
+// certificate that could be loaded is used if loading fails.
+type clientCertificate struct {
+	mu   sync.Mutex
+	load clientCertLoader
+	pem  string
+	cert *tls.Certificate
+}
+
+// GetClientCertificate returns the current client certificate. It's called
+// during each TLS handshake.
+func (c *clientCertificate) GetClientCertificate(cri *tls.CertificateRequestInfo) (*tls.Certificate, error) {
+	c.mu.Lock()
+	load := c.load
+	c.mu.Unlock()
+
+	ctx := context.Background()
+	if cri != nil {
+		ctx = cri.Context()
+	}
+	cert, key, err := load(ctx)
+	if err == nil {
+		err = c.set(cert, key)
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if err != nil {
+		if c.cert == nil {
+			return nil, err
+		}
+		log.Infof("Connect::Cannot reload TLS client certificate, using the previous one: %v", err)
+	}
+	return c.cert, nil
+}
+
+// set makes the supplied PEM encoded certificate and key the current client
+// certificate, unless they can't be parsed.
+func (c *clientCertificate) set(cert, key string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.cert != nil && c.pem == cert+key {
+		return nil
+	}
+	pair, err := tls.X509KeyPair([]byte(cert), []byte(key))
+	if err != nil {
+		return errors.Wrap(err, errParseCert)
+	}
+	c.pem, c.cert = cert+key, &pair
+	return nil
+}
+
+// clientCertificates share a clientCertificate between all connections to the
+// backend of each ProviderConfig, so that connections using it can be cached
+// while its certificate rotates.
+type clientCertificates struct {
+	mu    sync.Mutex
+	certs map[string]*clientCertificate
+}
+
+// newClientCertificates returns empty clientCertificates.
+func newClientCertificates() *clientCertificates {
+	return &clientCertificates{certs: make(map[string]*clientCertificate)}
+}
+
+// Get returns the clientCertificate of the named ProviderConfig, which loads
+// its certificate using the supplied loader. The certificate and key the
+// ProviderConfig's credentials currently supply become its current
+// certificate.
+func (s *clientCertificates) Get(name string, load clientCertLoader, cert, key string) (*clientCertificate, error) {
+	s.mu.Lock()
+	c, ok := s.certs[name]
+	if !ok {
+		c = &clientCertificate{}
+		s.certs[name] = c
+	}
+	s.mu.Unlock()
+
+	if err := c.set(cert, key); err != nil {
+		return nil, err
+	}
+	c.mu.Lock()
+	c.load = load
+	c.mu.Unlock()
+	return c, nil
+}
@@ -0,0 +1,103 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpckind
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"encoding/pem"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	apisv1alpha1 "github.com/crossplane/provider-grpc/apis/v1alpha1"
+)
+
+func TestClientCertificateRotation(t *testing.T) {
+	oldCert, oldKey := selfSignedCert(t)
+	newCert, newKey := selfSignedCert(t)
+
+	var secretErr error
+	creds, _ := json.Marshal(backendCredentials{ClientCert: oldCert, ClientKey: oldKey})
+	kube := &test.MockClient{
+		MockGet: func(_ context.Context, _ client.ObjectKey, obj client.Object) error {
+			if secretErr != nil {
+				return secretErr
+			}
+			obj.(*corev1.Secret).Data = map[string][]byte{"creds": creds}
+			return nil
+		},
+	}
+	spec := apisv1alpha1.ProviderConfigSpec{Credentials: apisv1alpha1.ProviderCredentials{
+		Source:                    xpv1.CredentialsSourceSecret,
+		CommonCredentialSelectors: xpv1.CommonCredentialSelectors{SecretRef: &xpv1.SecretKeySelector{Key: "creds"}},
+	}}
+
+	cc, err := newClientCertificates().Get("cool-pc", credentialsCertLoader(kube, spec), oldCert, oldKey)
+	if err != nil {
+		t.Fatalf("Get(...): %v", err)
+	}
+	assertCert := func(want string) {
+		t.Helper()
+		got, err := cc.GetClientCertificate(&tls.CertificateRequestInfo{})
+		if err != nil {
+			t.Fatalf("GetClientCertificate(...): %v", err)
+		}
+		b, _ := pem.Decode([]byte(want))
+		if diff := cmp.Diff(b.Bytes, got.Certificate[0]); diff != "" {
+			t.Errorf("GetClientCertificate(...): -want certificate, +got certificate:\n%s\n", diff)
+		}
+	}
+	assertCert(oldCert)
+
+	// The secret rotates. New connections use the new certificate.
+	creds, _ = json.Marshal(backendCredentials{ClientCert: newCert, ClientKey: newKey})
+	assertCert(newCert)
+
+	// The previous certificate is used if the secret can't be read.
+	secretErr = errors.New("boom")
+	assertCert(newCert)
+}
+
+func TestClientCertificatesShared(t *testing.T) {
+	cert, key := selfSignedCert(t)
+	certs := newClientCertificates()
+	load := func(_ context.Context) (string, string, error) { return cert, key, nil }
+
+	a, err := certs.Get("cool-pc", load, cert, key)
+	if err != nil {
+		t.Fatalf("Get(...): %v", err)
+	}
+	b, err := certs.Get("cool-pc", load, cert, key)
+	if err != nil {
+		t.Fatalf("Get(...): %v", err)
+	}
+	// The same clientCertificate keeps connections to the backend cached.
+	if a != b {
+		t.Errorf("Get(...): want the clientCertificate of a ProviderConfig to be shared")
+	}
+	if _, err := certs.Get("cool-pc", load, "not-a-cert", key); err == nil {
+		t.Errorf("Get(...): want an error for an unparsable certificate")
+	}
+}
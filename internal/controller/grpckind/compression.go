@@ -0,0 +1,68 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpckind
+
+import (
+	"context"
+
+	"google.golang.org/grpc/stats"
+
+	// Register the gzip compressor, so that the backend's gzip compressed
+	// responses can be decompressed and ProviderConfigs can enable it.
+	_ "google.golang.org/grpc/encoding/gzip"
+)
+
+// compressionIdentity is the name gRPC uses for uncompressed messages.
+const compressionIdentity = "identity"
+
+type compressionKey struct{}
+
+// withCompressionReport returns a context that records the compression the
+// backend used to respond to calls made with it in the supplied string.
+func withCompressionReport(ctx context.Context, compression *string) context.Context {
+	return context.WithValue(ctx, compressionKey{}, compression)
+}
+
+// A compressionHandler is a gRPC stats handler that reports the compression
+// used by response headers to calls made with a context returned by
+// withCompressionReport.
+type compressionHandler struct{}
+
+func (compressionHandler) TagRPC(ctx context.Context, _ *stats.RPCTagInfo) context.Context {
+	return ctx
+}
+
+func (compressionHandler) HandleRPC(ctx context.Context, s stats.RPCStats) {
+	h, ok := s.(*stats.InHeader)
+	if !ok || !h.Client {
+		return
+	}
+	c, ok := ctx.Value(compressionKey{}).(*string)
+	if !ok {
+		return
+	}
+	*c = h.Compression
+	if *c == "" {
+		*c = compressionIdentity
+	}
+}
+
+func (compressionHandler) TagConn(ctx context.Context, _ *stats.ConnTagInfo) context.Context {
+	return ctx
+}
+
+func (compressionHandler) HandleConn(context.Context, stats.ConnStats) {}
@@ -0,0 +1,80 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpckind
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/test/bufconn"
+
+	listServicepb "github.com/ashwinshirva/provider-grpc-server/proto"
+)
+
+func TestCompressionReport(t *testing.T) {
+	cases := map[string]struct {
+		reason    string
+		transport transport
+		want      string
+	}{
+		"Gzip": {
+			reason:    "A backend that responds with gzip compressed messages should be reported to use gzip.",
+			transport: transport{compression: "gzip"},
+			want:      "gzip",
+		},
+		"Uncompressed": {
+			reason: "A backend that responds with uncompressed messages should be reported to use identity.",
+			want:   compressionIdentity,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			lis := bufconn.Listen(1024 * 1024)
+			srv := grpc.NewServer()
+			// The server compresses responses the same way as requests.
+			listServicepb.RegisterListServiceServer(srv, reflectedListServer{})
+			go func() { _ = srv.Serve(lis) }()
+			defer srv.Stop()
+
+			opts, err := tc.transport.dialOptions()
+			if err != nil {
+				t.Fatalf("dialOptions(): %v", err)
+			}
+			opts = append(opts, grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+				return lis.DialContext(ctx)
+			}))
+			conn, err := grpc.Dial("passthrough:///bufconn", opts...)
+			if err != nil {
+				t.Fatalf("grpc.Dial(...): %v", err)
+			}
+			defer func() { _ = conn.Close() }()
+
+			e := external{service: &ListService{grpcClient: listServicepb.NewListServiceClient(conn)}}
+			cr := grpcKindWith("cool-list", withItems(1, 2, 3))
+			if _, err := e.Observe(context.Background(), cr); err != nil {
+				t.Fatalf("\n%s\ne.Observe(...): %v", tc.reason, err)
+			}
+			if diff := cmp.Diff(tc.want, cr.Status.AtProvider.Compression); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want compression, +got compression:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,121 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpckind
+
+import (
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"k8s.io/utils/clock"
+)
+
+// A connCache caches connections to gRPC backends by dial target and
+// transport. Connections that have not been used for longer than the idle
+// timeout are closed, and dialed again the next time they are needed.
+type connCache struct {
+	dial        func(target string, t transport) (*grpc.ClientConn, error)
+	idleTimeout time.Duration
+	clock       clock.PassiveClock
+
+	mu    sync.Mutex
+	conns map[connKey]*cachedConn
+}
+
+type connKey struct {
+	target    string
+	transport transport
+}
+
+type cachedConn struct {
+	conn     *grpc.ClientConn
+	lastUsed time.Time
+
+	// dialed is closed once the connection was dialed, or failed to be, in
+	// which case err is set. conn and err may only be read once it's closed.
+	dialed chan struct{}
+	err    error
+}
+
+// newConnCache returns a connCache that uses the supplied function to dial
+// backends. A zero idle timeout disables closing idle connections.
+func newConnCache(dial func(target string, t transport) (*grpc.ClientConn, error), idleTimeout time.Duration, c clock.PassiveClock) *connCache {
+	return &connCache{
+		dial:        dial,
+		idleTimeout: idleTimeout,
+		clock:       c,
+		conns:       make(map[connKey]*cachedConn),
+	}
+}
+
+// Get returns a connection to the supplied target using the supplied
+// transport, dialing it if there is no cached connection. Connections are
+// dialed without holding the lock, so that a backend that is slow to dial
+// only delays Gets of its own connection. Concurrent Gets of a connection
+// that is being dialed wait for that dial rather than dialing again.
+func (c *connCache) Get(target string, t transport) (*grpc.ClientConn, error) {
+	c.mu.Lock()
+	now := c.clock.Now()
+	c.closeIdle(now)
+
+	key := connKey{target: target, transport: t}
+	if cc, ok := c.conns[key]; ok {
+		cc.lastUsed = now
+		c.mu.Unlock()
+		<-cc.dialed
+		return cc.conn, cc.err
+	}
+	cc := &cachedConn{lastUsed: now, dialed: make(chan struct{})}
+	c.conns[key] = cc
+	c.mu.Unlock()
+
+	cc.conn, cc.err = c.dial(target, t)
+	if cc.err != nil {
+		// Forget the failed dial, so that the next Get dials again.
+		c.mu.Lock()
+		if c.conns[key] == cc {
+			delete(c.conns, key)
+		}
+		c.mu.Unlock()
+	}
+	close(cc.dialed)
+	return cc.conn, cc.err
+}
+
+// closeIdle closes and forgets connections that have been idle for longer
+// than the idle timeout. The caller must hold the lock.
+func (c *connCache) closeIdle(now time.Time) {
+	if c.idleTimeout == 0 {
+		return
+	}
+	for key, cc := range c.conns {
+		if now.Sub(cc.lastUsed) <= c.idleTimeout {
+			continue
+		}
+		select {
+		case <-cc.dialed:
+		default:
+			// The connection is still being dialed.
+			continue
+		}
+		if err := cc.conn.Close(); err != nil {
+			log.Infof("Closing idle connection to %q: %v", key.target, err)
+		}
+		delete(c.conns, key)
+	}
+}
@@ -0,0 +1,128 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpckind
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/connectivity"
+	"google.golang.org/grpc/credentials/insecure"
+	testingclock "k8s.io/utils/clock/testing"
+)
+
+func TestConnCacheIdleTimeout(t *testing.T) {
+	clk := testingclock.NewFakeClock(time.Now())
+	dials := 0
+	c := newConnCache(func(target string, _ transport) (*grpc.ClientConn, error) {
+		dials++
+		return grpc.Dial(target, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	}, time.Minute, clk)
+
+	first, err := c.Get("passthrough:///cool", transport{})
+	if err != nil {
+		t.Fatalf("c.Get(...): %v", err)
+	}
+
+	// A connection used within the idle timeout is reused.
+	clk.Step(30 * time.Second)
+	if got, _ := c.Get("passthrough:///cool", transport{}); got != first || dials != 1 {
+		t.Errorf("c.Get(...): want cached connection to be reused, got %d dials", dials)
+	}
+
+	// Using another connection after the timeout closes the idle one.
+	clk.Step(2 * time.Minute)
+	if _, err := c.Get("passthrough:///other", transport{}); err != nil {
+		t.Fatalf("c.Get(...): %v", err)
+	}
+	if got := first.GetState(); got != connectivity.Shutdown {
+		t.Errorf("c.Get(...): want idle connection to be closed, got state %s", got)
+	}
+
+	// The closed connection is re-established the next time it is used.
+	second, err := c.Get("passthrough:///cool", transport{})
+	if err != nil {
+		t.Fatalf("c.Get(...): %v", err)
+	}
+	if second == first || dials != 3 {
+		t.Errorf("c.Get(...): want closed connection to be redialed, got %d dials", dials)
+	}
+}
+
+func TestConnCacheHangingDial(t *testing.T) {
+	release := make(chan struct{})
+	dialing := make(chan struct{})
+	c := newConnCache(func(target string, _ transport) (*grpc.ClientConn, error) {
+		if target == "passthrough:///hanging" {
+			close(dialing)
+			<-release
+			return nil, errors.New("unreachable")
+		}
+		return grpc.Dial(target, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	}, time.Minute, testingclock.NewFakeClock(time.Now()))
+
+	hung := make(chan error)
+	go func() {
+		_, err := c.Get("passthrough:///hanging", transport{})
+		hung <- err
+	}()
+	<-dialing
+
+	// Another target gets its connection while the first is still dialing.
+	got := make(chan error)
+	go func() {
+		_, err := c.Get("passthrough:///other", transport{})
+		got <- err
+	}()
+	select {
+	case err := <-got:
+		if err != nil {
+			t.Fatalf("c.Get(...): %v", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("c.Get(...): want a connection to another target while one target hangs")
+	}
+
+	close(release)
+	if err := <-hung; err == nil {
+		t.Error("c.Get(...): want the hanging target's dial error")
+	}
+}
+
+func TestDialListServiceTimeout(t *testing.T) {
+	defer func(d time.Duration) { dialTimeout = d }(dialTimeout)
+	dialTimeout = 100 * time.Millisecond
+
+	// Nothing listens on the socket, so the blocking dial never succeeds.
+	path := filepath.Join(t.TempDir(), "nothing.sock")
+	done := make(chan error)
+	go func() {
+		_, err := dialListService("unix://"+path, transport{})
+		done <- err
+	}()
+	select {
+	case err := <-done:
+		if err == nil {
+			t.Error("dialListService(...): want an error dialing an unreachable backend")
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("dialListService(...): want the dial bounded by the dial timeout")
+	}
+}
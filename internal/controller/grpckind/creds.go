@@ -0,0 +1,109 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpckind
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	errParseCreds        = "cannot parse credentials"
+	errClientCertKeyPair = "credentials must supply both or neither of clientCert and clientKey"
+	errTokenRequiresTLS  = "credentials that supply a token require a TLS connection"
+)
+
+// backendCredentials are structured credentials for a backend. They are
+// supplied as a JSON object, which may be base64 encoded.
+type backendCredentials struct {
+	// Token is sent as a bearer token with each call.
+	Token string `json:"token,omitempty"`
+
+	// CACert is the PEM encoded certificate authority used to verify the
+	// backend. Supplying it makes the connection use TLS.
+	CACert string `json:"caCert,omitempty"`
+
+	// ClientCert and ClientKey are the PEM encoded certificate and key used
+	// to authenticate to the backend. Supplying them makes the connection use
+	// TLS.
+	ClientCert string `json:"clientCert,omitempty"`
+	ClientKey  string `json:"clientKey,omitempty"`
+}
+
+// parseCredentials parses the supplied credentials. Credentials that aren't a
+// JSON object, plain or base64 encoded, predate structured credentials and
+// are not used to connect; nil is returned for them.
+func parseCredentials(data []byte) (*backendCredentials, error) {
+	data = bytes.TrimSpace(data)
+	if !bytes.HasPrefix(data, []byte("{")) {
+		decoded, err := base64.StdEncoding.DecodeString(string(data))
+		if err != nil || !bytes.HasPrefix(bytes.TrimSpace(decoded), []byte("{")) {
+			return nil, nil
+		}
+		data = decoded
+	}
+
+	bc := &backendCredentials{}
+	if err := json.Unmarshal(data, bc); err != nil {
+		return nil, errors.Wrap(err, errParseCreds)
+	}
+	return bc, nil
+}
+
+// apply returns a copy of the supplied transport that uses the credentials.
+func (bc *backendCredentials) apply(t transport) (transport, error) {
+	if bc == nil {
+		return t, nil
+	}
+	if (bc.ClientCert == "") != (bc.ClientKey == "") {
+		return transport{}, errors.New(errClientCertKeyPair)
+	}
+
+	if bc.CACert != "" {
+		t.tls = true
+		t.ca = bc.CACert
+	}
+	if bc.ClientCert != "" {
+		t.tls = true
+		t.clientCert, t.clientKey = bc.ClientCert, bc.ClientKey
+	}
+	if bc.Token != "" {
+		if !t.tls {
+			return transport{}, errors.New(errTokenRequiresTLS)
+		}
+		t.token = bc.Token
+	}
+	return t, nil
+}
+
+// A bearerToken authenticates each call to a backend using a token.
+type bearerToken string
+
+// GetRequestMetadata returns the authorization header for a call.
+func (t bearerToken) GetRequestMetadata(_ context.Context, _ ...string) (map[string]string, error) {
+	return map[string]string{"authorization": "Bearer " + string(t)}, nil
+}
+
+// RequireTransportSecurity returns true, because a token must never be sent
+// over an insecure connection.
+func (t bearerToken) RequireTransportSecurity() bool {
+	return true
+}
@@ -0,0 +1,169 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpckind
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+)
+
+// selfSignedCert returns a PEM encoded self-signed certificate and its key.
+func selfSignedCert(t *testing.T) (string, string) {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("ecdsa.GenerateKey(...): %v", err)
+	}
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "provider-grpc"},
+		NotBefore:    time.Now(),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	if err != nil {
+		t.Fatalf("x509.CreateCertificate(...): %v", err)
+	}
+	kder, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatalf("x509.MarshalECPrivateKey(...): %v", err)
+	}
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})),
+		string(pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: kder}))
+}
+
+func TestCredentials(t *testing.T) {
+	cert, key := selfSignedCert(t)
+	mtls, _ := json.Marshal(backendCredentials{Token: "cool-token", CACert: cert, ClientCert: cert, ClientKey: key})
+	errJSON := json.Unmarshal([]byte(`{"token":`), &backendCredentials{})
+
+	type want struct {
+		transport transport
+		opts      int
+		err       error
+	}
+
+	cases := map[string]struct {
+		reason    string
+		data      []byte
+		transport transport
+		want      want
+	}{
+		"Opaque": {
+			reason: "Credentials that aren't JSON should not be used to connect.",
+			data:   []byte("U2FtcGxlIGJhc2U2NCBlbmNvZGVkIHN0cmluZw=="),
+			want:   want{opts: 2},
+		},
+		"MutualTLS": {
+			reason: "A token, certificate authority, and client certificate should be used to connect.",
+			data:   mtls,
+			want: want{
+				transport: transport{tls: true, ca: cert, clientCert: cert, clientKey: key, token: "cool-token"},
+				opts:      3,
+			},
+		},
+		"Base64": {
+			reason: "Base64 encoded JSON credentials should be decoded.",
+			data:   []byte(base64.StdEncoding.EncodeToString(mtls)),
+			want: want{
+				transport: transport{tls: true, ca: cert, clientCert: cert, clientKey: key, token: "cool-token"},
+				opts:      3,
+			},
+		},
+		"TokenWithProviderConfigTLS": {
+			reason:    "A token should be sent over TLS configured by the ProviderConfig.",
+			data:      []byte(`{"token": "cool-token"}`),
+			transport: transport{tls: true},
+			want: want{
+				transport: transport{tls: true, token: "cool-token"},
+				opts:      3,
+			},
+		},
+		"TokenWithoutTLS": {
+			reason: "A token should never be sent over an insecure connection.",
+			data:   []byte(`{"token": "cool-token"}`),
+			want: want{
+				err: errors.New(errTokenRequiresTLS),
+			},
+		},
+		"ClientCertWithoutKey": {
+			reason: "A client certificate can't be used without its key.",
+			data:   []byte(`{"clientCert": "cool-cert"}`),
+			want: want{
+				err: errors.New(errClientCertKeyPair),
+			},
+		},
+		"InvalidJSON": {
+			reason: "Credentials that look like JSON but aren't should be rejected.",
+			data:   []byte(`{"token":`),
+			want: want{
+				err: errors.Wrap(errJSON, errParseCreds),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			bc, err := parseCredentials(tc.data)
+			var got transport
+			if err == nil {
+				got, err = bc.apply(tc.transport)
+			}
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Fatalf("\n%s\nparseCredentials(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if err != nil {
+				return
+			}
+			if diff := cmp.Diff(tc.want.transport, got, cmp.AllowUnexported(transport{})); diff != "" {
+				t.Errorf("\n%s\nbc.apply(...): -want transport, +got transport:\n%s\n", tc.reason, diff)
+			}
+			opts, err := got.dialOptions()
+			if err != nil {
+				t.Fatalf("\n%s\ndialOptions(): %v", tc.reason, err)
+			}
+			if len(opts) != tc.want.opts {
+				t.Errorf("\n%s\ndialOptions(): want %d options, got %d", tc.reason, tc.want.opts, len(opts))
+			}
+		})
+	}
+}
+
+func TestBearerToken(t *testing.T) {
+	md, err := bearerToken("cool-token").GetRequestMetadata(context.Background())
+	if err != nil {
+		t.Fatalf("GetRequestMetadata(...): %v", err)
+	}
+	if diff := cmp.Diff(map[string]string{"authorization": "Bearer cool-token"}, md); diff != "" {
+		t.Errorf("GetRequestMetadata(...): -want, +got:\n%s", diff)
+	}
+}
@@ -0,0 +1,107 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpckind
+
+import (
+	"sync"
+	"time"
+
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/clock"
+
+	"github.com/crossplane/provider-grpc/apis/mygroup/v1alpha1"
+)
+
+// UpdateDebounce is how long the spec of a GrpcKind must go unchanged before
+// its list is updated, so that rapid changes are applied as one update. Zero
+// disables debouncing.
+var UpdateDebounce time.Duration
+
+// A debouncer delays updates to a list until the spec of its GrpcKind has
+// stopped changing for a quiet period. When the quiet period ends it enqueues
+// the GrpcKind, so that it is reconciled and its list updated.
+type debouncer struct {
+	quiet   time.Duration
+	clock   clock.WithDelayedExecution
+	enqueue func(cr *v1alpha1.GrpcKind)
+
+	mu      sync.Mutex
+	changes map[types.UID]*specChange
+}
+
+type specChange struct {
+	generation int64
+	at         time.Time
+	timer      clock.Timer
+}
+
+// newDebouncer returns a debouncer that waits for the supplied quiet period
+// before calling enqueue.
+func newDebouncer(quiet time.Duration, c clock.WithDelayedExecution, enqueue func(cr *v1alpha1.GrpcKind)) *debouncer {
+	return &debouncer{
+		quiet:   quiet,
+		clock:   c,
+		enqueue: enqueue,
+		changes: make(map[types.UID]*specChange),
+	}
+}
+
+// Settled returns true if the list of the supplied GrpcKind may be updated,
+// because its spec hasn't changed for the quiet period. A GrpcKind seen for
+// the first time is considered settled. A nil debouncer, or one with no quiet
+// period, considers every GrpcKind settled.
+func (d *debouncer) Settled(cr *v1alpha1.GrpcKind) bool {
+	if d == nil || d.quiet == 0 {
+		return true
+	}
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	now := d.clock.Now()
+	sc, ok := d.changes[cr.GetUID()]
+	if !ok {
+		d.changes[cr.GetUID()] = &specChange{generation: cr.GetGeneration()}
+		return true
+	}
+	if sc.generation == cr.GetGeneration() && now.Sub(sc.at) >= d.quiet {
+		return true
+	}
+
+	if sc.generation != cr.GetGeneration() {
+		sc.generation, sc.at = cr.GetGeneration(), now
+	}
+	if sc.timer != nil {
+		sc.timer.Stop()
+	}
+	enqueue := cr.DeepCopy()
+	sc.timer = d.clock.AfterFunc(sc.at.Add(d.quiet).Sub(now), func() { d.enqueue(enqueue) })
+	return false
+}
+
+// Forget stops tracking changes to the supplied GrpcKind.
+func (d *debouncer) Forget(cr *v1alpha1.GrpcKind) {
+	if d == nil {
+		return
+	}
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if sc, ok := d.changes[cr.GetUID()]; ok && sc.timer != nil {
+		sc.timer.Stop()
+	}
+	delete(d.changes, cr.GetUID())
+}
@@ -0,0 +1,83 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpckind
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	testingclock "k8s.io/utils/clock/testing"
+
+	listServicepb "github.com/ashwinshirva/provider-grpc-server/proto"
+	"github.com/crossplane/provider-grpc/apis/mygroup/v1alpha1"
+)
+
+func TestUpdateDebounce(t *testing.T) {
+	clk := testingclock.NewFakeClock(time.Now())
+	var enqueued []*v1alpha1.GrpcKind
+	var updated [][]int32
+	e := external{
+		debouncer: newDebouncer(10*time.Second, clk, func(cr *v1alpha1.GrpcKind) { enqueued = append(enqueued, cr) }),
+		service: &ListService{grpcClient: &fakeListServiceClient{
+			MockUpdateListItems: func(_ context.Context, req *listServicepb.UpdateListItemsReq, _ ...grpc.CallOption) (*listServicepb.UpdateListItemsResp, error) {
+				updated = append(updated, req.GetNewItems())
+				return &listServicepb.UpdateListItemsResp{Status: "UPDATED"}, nil
+			},
+		}},
+	}
+	update := func(generation int64, items ...int32) {
+		t.Helper()
+		cr := grpcKindWith("cool-list", withItems(items...))
+		cr.SetGeneration(generation)
+		if _, err := e.Update(context.Background(), cr); err != nil {
+			t.Fatalf("e.Update(...): %v", err)
+		}
+	}
+
+	// A GrpcKind seen for the first time is updated right away.
+	update(1, 1)
+	if len(updated) != 1 {
+		t.Fatalf("e.Update(...): want the first update to be applied, got %d updates", len(updated))
+	}
+
+	// Two rapid changes are not applied while the spec is changing.
+	update(2, 1, 2)
+	clk.Step(5 * time.Second)
+	update(3, 1, 2, 3)
+	if len(updated) != 1 {
+		t.Errorf("e.Update(...): want rapid changes to be debounced, got %d updates", len(updated))
+	}
+
+	// The GrpcKind is enqueued once its spec stopped changing for the quiet
+	// period, which is measured from the last change.
+	clk.Step(5 * time.Second)
+	if len(enqueued) != 0 {
+		t.Errorf("e.Update(...): want nothing enqueued before the quiet period passed, got %d", len(enqueued))
+	}
+	clk.Step(5 * time.Second)
+	if len(enqueued) != 1 || enqueued[0].GetGeneration() != 3 {
+		t.Fatalf("e.Update(...): want the latest spec enqueued once, got %d enqueued", len(enqueued))
+	}
+
+	// The two rapid changes are applied as one update.
+	update(3, 1, 2, 3)
+	if len(updated) != 2 || len(updated[1]) != 3 {
+		t.Errorf("e.Update(...): want the rapid changes applied as one update, got updates %v", updated)
+	}
+}
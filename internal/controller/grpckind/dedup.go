@@ -0,0 +1,97 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpckind
+
+import (
+	"reflect"
+	"sync"
+	"time"
+
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/clock"
+
+	"github.com/crossplane/provider-grpc/apis/mygroup/v1alpha1"
+)
+
+// updateDedupWindow is how long an update applied to a list suppresses
+// identical updates to the same list. It absorbs Observe flapping between up
+// to date and drifted while the backend is eventually consistent.
+var updateDedupWindow = 30 * time.Second
+
+// An appliedCache remembers the parameters most recently applied to each
+// managed resource's list for a short window.
+type appliedCache struct {
+	window time.Duration
+	clock  clock.PassiveClock
+
+	mu      sync.Mutex
+	applied map[types.UID]appliedParameters
+}
+
+type appliedParameters struct {
+	params v1alpha1.GrpcKindParameters
+	at     time.Time
+}
+
+// newAppliedCache returns an appliedCache that remembers applied parameters
+// for the supplied window.
+func newAppliedCache(window time.Duration, c clock.PassiveClock) *appliedCache {
+	return &appliedCache{
+		window:  window,
+		clock:   c,
+		applied: make(map[types.UID]appliedParameters),
+	}
+}
+
+// Recent returns true if the supplied parameters were applied to the list of
+// the managed resource with the supplied UID within the window. A nil
+// appliedCache never remembers anything.
+func (c *appliedCache) Recent(uid types.UID, p v1alpha1.GrpcKindParameters) bool {
+	if c == nil {
+		return false
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.forgetExpired(c.clock.Now())
+	a, ok := c.applied[uid]
+	return ok && reflect.DeepEqual(a.params, p)
+}
+
+// Record remembers that the supplied parameters were applied to the list of
+// the managed resource with the supplied UID.
+func (c *appliedCache) Record(uid types.UID, p v1alpha1.GrpcKindParameters) {
+	if c == nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := c.clock.Now()
+	c.forgetExpired(now)
+	c.applied[uid] = appliedParameters{params: *p.DeepCopy(), at: now}
+}
+
+// forgetExpired forgets parameters applied longer ago than the window. The
+// caller must hold the lock.
+func (c *appliedCache) forgetExpired(now time.Time) {
+	for uid, a := range c.applied {
+		if now.Sub(a.at) > c.window {
+			delete(c.applied, uid)
+		}
+	}
+}
@@ -0,0 +1,73 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpckind
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	testingclock "k8s.io/utils/clock/testing"
+
+	listServicepb "github.com/ashwinshirva/provider-grpc-server/proto"
+)
+
+func TestUpdateDedup(t *testing.T) {
+	clk := testingclock.NewFakeClock(time.Now())
+	updates := 0
+	e := external{
+		applied: newAppliedCache(time.Minute, clk),
+		service: &ListService{grpcClient: &fakeListServiceClient{
+			MockUpdateListItems: func(_ context.Context, _ *listServicepb.UpdateListItemsReq, _ ...grpc.CallOption) (*listServicepb.UpdateListItemsResp, error) {
+				updates++
+				return &listServicepb.UpdateListItemsResp{Status: "UPDATED"}, nil
+			},
+		}},
+	}
+	update := func(items ...int32) {
+		t.Helper()
+		if _, err := e.Update(context.Background(), grpcKindWith("cool-list", withItems(items...))); err != nil {
+			t.Fatalf("e.Update(...): %v", err)
+		}
+	}
+
+	update(1, 2)
+	if updates != 1 {
+		t.Fatalf("e.Update(...): want 1 update, got %d", updates)
+	}
+
+	// An identical update within the window is skipped.
+	clk.Step(30 * time.Second)
+	update(1, 2)
+	if updates != 1 {
+		t.Errorf("e.Update(...): want identical update to be skipped, got %d updates", updates)
+	}
+
+	// A different update is applied.
+	update(1, 2, 3)
+	if updates != 2 {
+		t.Errorf("e.Update(...): want changed update to be applied, got %d updates", updates)
+	}
+
+	// An identical update is applied once the window has passed.
+	clk.Step(2 * time.Minute)
+	update(1, 2, 3)
+	if updates != 3 {
+		t.Errorf("e.Update(...): want update to be applied after the window, got %d updates", updates)
+	}
+}
@@ -0,0 +1,110 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpckind
+
+import (
+	"context"
+	"io"
+
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+
+	listServicepb "github.com/ashwinshirva/provider-grpc-server/proto"
+)
+
+const errDeleteIncompleteFmt = "deletion of list %q ended before it was complete"
+
+// deleteListStreamMethod is a server-streaming RPC some backends serve in
+// addition to the generated ListService. It deletes a list like DeleteList,
+// but streams the progress of the deletion until it completes.
+const deleteListStreamMethod = "/proto.ListService/DeleteListStream"
+
+// A deleteListStreamClient deletes lists using the DeleteListStream RPC.
+type deleteListStreamClient interface {
+	DeleteListStream(ctx context.Context, in *listServicepb.DeleteListReq, opts ...grpc.CallOption) (deleteListStream, error)
+}
+
+// A deleteListStream receives the progress of a deletion.
+type deleteListStream interface {
+	Recv() (*listServicepb.DeleteListResp, error)
+}
+
+// newDeleteListStreamClient returns a deleteListStreamClient that uses the
+// supplied connection.
+func newDeleteListStreamClient(cc grpc.ClientConnInterface) deleteListStreamClient {
+	return &deleteStreamClient{cc: cc}
+}
+
+type deleteStreamClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func (c *deleteStreamClient) DeleteListStream(ctx context.Context, in *listServicepb.DeleteListReq, opts ...grpc.CallOption) (deleteListStream, error) {
+	stream, err := c.cc.NewStream(ctx, &grpc.StreamDesc{StreamName: "DeleteListStream", ServerStreams: true}, deleteListStreamMethod, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &deleteListClientStream{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type deleteListClientStream struct {
+	grpc.ClientStream
+}
+
+func (x *deleteListClientStream) Recv() (*listServicepb.DeleteListResp, error) {
+	m := new(listServicepb.DeleteListResp)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// streamDeleteList deletes the named list and waits for the backend to report
+// that the deletion is complete. An error is returned if the stream ends
+// before the backend reports the list was deleted.
+func streamDeleteList(ctx context.Context, c deleteListStreamClient, name string) (*listServicepb.DeleteListResp, error) {
+	stream, err := c.DeleteListStream(ctx, &listServicepb.DeleteListReq{Name: name})
+	if err != nil {
+		return nil, err
+	}
+
+	var last *listServicepb.DeleteListResp
+	for {
+		resp, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		log.Infof("Delete:: Deletion of list \"%v\" is %v\n", logName(name), resp.GetStatus())
+		last = resp
+	}
+
+	if last.GetStatus() != statusDeleted {
+		return last, errors.Errorf(errDeleteIncompleteFmt, logName(name))
+	}
+	return last, nil
+}
@@ -0,0 +1,88 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpckind
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	apisv1alpha1 "github.com/crossplane/provider-grpc/apis/v1alpha1"
+)
+
+const errUpdateDeletionPolicy = "cannot apply the ProviderConfig's default deletion policy"
+
+// A deletionPolicyDefaulter is a managed.Initializer that applies the default
+// deletion policy of a managed resource's ProviderConfig, unless the managed
+// resource specifies its own.
+type deletionPolicyDefaulter struct {
+	kube client.Client
+}
+
+// Initialize applies the default deletion policy of the supplied managed
+// resource's ProviderConfig, if it has one and the managed resource doesn't
+// specify its own policy. Managed resources that are being deleted are left
+// alone.
+func (d *deletionPolicyDefaulter) Initialize(ctx context.Context, mg resource.Managed) error {
+	if meta.WasDeleted(mg) || deletionPolicySet(mg) {
+		return nil
+	}
+	ref := mg.GetProviderConfigReference()
+	if ref == nil {
+		return nil
+	}
+	pc := &apisv1alpha1.ProviderConfig{}
+	if err := d.kube.Get(ctx, types.NamespacedName{Name: ref.Name}, pc); err != nil {
+		return errors.Wrap(err, errGetPC)
+	}
+	p := pc.Spec.DefaultDeletionPolicy
+	if p == nil || *p == mg.GetDeletionPolicy() {
+		return nil
+	}
+	mg.SetDeletionPolicy(*p)
+	return errors.Wrap(d.kube.Update(ctx, mg), errUpdateDeletionPolicy)
+}
+
+// deletionPolicySet returns true if the supplied managed resource specifies
+// its deletion policy. The CRD defaults an unset policy to Delete, so a Delete
+// policy is only specified if a field manager, e.g. kubectl, set it. A policy
+// this initializer applied is thereafter specified too.
+func deletionPolicySet(mg resource.Managed) bool {
+	if mg.GetDeletionPolicy() != xpv1.DeletionDelete {
+		return mg.GetDeletionPolicy() != ""
+	}
+	for _, mf := range mg.GetManagedFields() {
+		if mf.FieldsV1 == nil {
+			continue
+		}
+		fields := map[string]map[string]interface{}{}
+		if err := json.Unmarshal(mf.FieldsV1.Raw, &fields); err != nil {
+			continue
+		}
+		if _, ok := fields["f:spec"]["f:deletionPolicy"]; ok {
+			return true
+		}
+	}
+	return false
+}
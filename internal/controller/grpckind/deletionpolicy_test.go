@@ -0,0 +1,140 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpckind
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-grpc/apis/mygroup/v1alpha1"
+	apisv1alpha1 "github.com/crossplane/provider-grpc/apis/v1alpha1"
+)
+
+// withDeletionPolicyManagedBy makes the supplied field manager the manager of
+// a GrpcKind's deletion policy, as if it set the policy.
+func withDeletionPolicyManagedBy(manager string) grpcKindModifier {
+	return func(cr *v1alpha1.GrpcKind) {
+		cr.SetManagedFields([]metav1.ManagedFieldsEntry{{
+			Manager:    manager,
+			Operation:  metav1.ManagedFieldsOperationUpdate,
+			FieldsType: "FieldsV1",
+			FieldsV1:   &metav1.FieldsV1{Raw: []byte(`{"f:spec":{"f:deletionPolicy":{},"f:forProvider":{"f:name":{}}}}`)},
+		}})
+	}
+}
+
+func TestDefaultDeletionPolicy(t *testing.T) {
+	errBoom := errors.New("boom")
+	orphan, del := xpv1.DeletionOrphan, xpv1.DeletionDelete
+
+	type want struct {
+		policy  xpv1.DeletionPolicy
+		updated bool
+		err     error
+	}
+
+	cases := map[string]struct {
+		reason string
+		pc     *xpv1.DeletionPolicy
+		policy xpv1.DeletionPolicy
+		mg     grpcKindModifier
+		getErr error
+		want   want
+	}{
+		"DefaultApplied": {
+			reason: "The ProviderConfig's default deletion policy should be applied to a GrpcKind whose policy was defaulted by its CRD.",
+			pc:     &orphan,
+			policy: xpv1.DeletionDelete,
+			want:   want{policy: xpv1.DeletionOrphan, updated: true},
+		},
+		"ExplicitDelete": {
+			reason: "A Delete policy a field manager set should win over the ProviderConfig's default.",
+			pc:     &orphan,
+			policy: xpv1.DeletionDelete,
+			mg:     withDeletionPolicyManagedBy("kubectl-client-side-apply"),
+			want:   want{policy: xpv1.DeletionDelete},
+		},
+		"ExplicitOrphan": {
+			reason: "An Orphan policy should win over the ProviderConfig's default, since the CRD never defaults to it.",
+			pc:     &del,
+			policy: xpv1.DeletionOrphan,
+			want:   want{policy: xpv1.DeletionOrphan},
+		},
+		"NoDefault": {
+			reason: "A GrpcKind whose ProviderConfig has no default deletion policy should be left alone.",
+			policy: xpv1.DeletionDelete,
+			want:   want{policy: xpv1.DeletionDelete},
+		},
+		"Deleted": {
+			reason: "The deletion policy of a GrpcKind that is being deleted should be left alone.",
+			pc:     &orphan,
+			policy: xpv1.DeletionDelete,
+			mg: func(cr *v1alpha1.GrpcKind) {
+				now := metav1.Now()
+				cr.SetDeletionTimestamp(&now)
+			},
+			want: want{policy: xpv1.DeletionDelete},
+		},
+		"GetProviderConfigError": {
+			reason: "Errors getting the ProviderConfig should be returned.",
+			policy: xpv1.DeletionDelete,
+			getErr: errBoom,
+			want:   want{policy: xpv1.DeletionDelete, err: errors.Wrap(errBoom, errGetPC)},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			updated := false
+			d := &deletionPolicyDefaulter{kube: &test.MockClient{
+				MockGet: test.NewMockGetFn(tc.getErr, func(obj client.Object) error {
+					obj.(*apisv1alpha1.ProviderConfig).Spec.DefaultDeletionPolicy = tc.pc
+					return nil
+				}),
+				MockUpdate: func(_ context.Context, _ client.Object, _ ...client.UpdateOption) error {
+					updated = true
+					return nil
+				},
+			}}
+			cr := grpcKindWith("cool-list", func(cr *v1alpha1.GrpcKind) {
+				cr.SetDeletionPolicy(tc.policy)
+				if tc.mg != nil {
+					tc.mg(cr)
+				}
+			})
+
+			err := d.Initialize(context.Background(), cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nd.Initialize(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.policy, cr.GetDeletionPolicy()); diff != "" {
+				t.Errorf("\n%s\nd.Initialize(...): -want policy, +got policy:\n%s\n", tc.reason, diff)
+			}
+			if updated != tc.want.updated {
+				t.Errorf("\n%s\nd.Initialize(...): want updated %t, got %t", tc.reason, tc.want.updated, updated)
+			}
+		})
+	}
+}
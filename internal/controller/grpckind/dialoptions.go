@@ -0,0 +1,48 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpckind
+
+import (
+	"sync"
+
+	"google.golang.org/grpc"
+)
+
+var (
+	injectedMu          sync.Mutex
+	injectedDialOptions []grpc.DialOption
+)
+
+// WithDialOptions appends the supplied DialOptions to those used to connect to
+// every backend, e.g. so that a provider built from this one may add its own
+// interceptors without changing the controller. The injected DialOptions
+// follow those the ProviderConfig and SetupOptions specify, in the order they
+// were injected, so they take precedence over any they conflict with. They
+// apply to connections dialed after WithDialOptions is called, so it should
+// typically be called before the controller is set up.
+func WithDialOptions(opts ...grpc.DialOption) {
+	injectedMu.Lock()
+	defer injectedMu.Unlock()
+	injectedDialOptions = append(injectedDialOptions, opts...)
+}
+
+// injected returns the DialOptions injected using WithDialOptions.
+func injected() []grpc.DialOption {
+	injectedMu.Lock()
+	defer injectedMu.Unlock()
+	return append([]grpc.DialOption{}, injectedDialOptions...)
+}
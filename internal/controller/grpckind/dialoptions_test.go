@@ -0,0 +1,70 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpckind
+
+import (
+	"context"
+	"net"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/grpc"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+func TestWithDialOptions(t *testing.T) {
+	defer func(opts []grpc.DialOption) { injectedDialOptions = opts }(injectedDialOptions)
+	injectedDialOptions = nil
+
+	path := filepath.Join(t.TempDir(), "lists.sock")
+	lis, err := net.Listen("unix", path)
+	if err != nil {
+		t.Fatalf("net.Listen(...): %v", err)
+	}
+	srv := grpc.NewServer()
+	healthpb.RegisterHealthServer(srv, &authorityHealthServer{})
+	go func() { _ = srv.Serve(lis) }()
+	defer srv.Stop()
+
+	var called []string
+	intercept := func(name string) grpc.DialOption {
+		return grpc.WithChainUnaryInterceptor(func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+			called = append(called, name)
+			return invoker(ctx, method, req, reply, cc, opts...)
+		})
+	}
+	WithDialOptions(intercept("first"))
+	WithDialOptions(intercept("second"), intercept("third"))
+
+	conn, err := dialListService("unix://"+path, transport{}, intercept("setup"))
+	if err != nil {
+		t.Fatalf("dialListService(...): %v", err)
+	}
+	defer func() { _ = conn.Close() }()
+
+	if _, err := healthpb.NewHealthClient(conn).Check(context.Background(), &healthpb.HealthCheckRequest{}); err != nil {
+		t.Fatalf("Check(...): %v", err)
+	}
+
+	// Injected options follow those Setup specifies, in the order they were
+	// injected.
+	want := []string{"setup", "first", "second", "third"}
+	if diff := cmp.Diff(want, called); diff != "" {
+		t.Errorf("dialListService(...): -want interceptors called, +got interceptors called:\n%s\n", diff)
+	}
+}
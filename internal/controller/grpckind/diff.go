@@ -0,0 +1,216 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpckind
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	"google.golang.org/grpc/metadata"
+
+	"github.com/crossplane/provider-grpc/apis/mygroup/v1alpha1"
+)
+
+// maxLoggedItems bounds how many added or removed items are logged when a
+// list has drifted, so that drift in large lists doesn't flood the logs.
+const maxLoggedItems = 10
+
+// itemsDiff returns the items that want has but got doesn't, and the items
+// that got has but want doesn't. Items that appear more than once are
+// compared by how many times they appear.
+func itemsDiff(want, got []int32) (added, removed []int32) {
+	counts := make(map[int32]int, len(got))
+	for _, i := range got {
+		counts[i]++
+	}
+	for _, i := range want {
+		if counts[i] > 0 {
+			counts[i]--
+			continue
+		}
+		added = append(added, i)
+	}
+	for _, i := range got {
+		if counts[i] > 0 {
+			counts[i]--
+			removed = append(removed, i)
+		}
+	}
+	return added, removed
+}
+
+// itemsMissing returns the items that want has but got has no item within the
+// supplied tolerance of. Each item of got is matched to at most one item of
+// want.
+func itemsMissing(want, got []int32, tolerance int32) []int32 {
+	if tolerance <= 0 {
+		added, _ := itemsDiff(want, got)
+		return added
+	}
+	var missing []int32
+	matched := make([]bool, len(got))
+	for _, w := range want {
+		found := false
+		for j, g := range got {
+			if !matched[j] && withinTolerance(w, g, tolerance) {
+				matched[j], found = true, true
+				break
+			}
+		}
+		if !found {
+			missing = append(missing, w)
+		}
+	}
+	return missing
+}
+
+// itemsEqual returns true if got has the same items as want, in the same
+// order, with each item within the supplied tolerance of the desired item.
+// Nil and empty items are equal.
+func itemsEqual(want, got []int32, tolerance int32) bool {
+	if len(want) != len(got) {
+		return false
+	}
+	if tolerance <= 0 {
+		return len(want) == 0 || reflect.DeepEqual(want, got)
+	}
+	for i := range want {
+		if !withinTolerance(want[i], got[i], tolerance) {
+			return false
+		}
+	}
+	return true
+}
+
+// withinTolerance returns true if a and b differ by at most the supplied
+// tolerance.
+func withinTolerance(a, b, tolerance int32) bool {
+	d := int64(a) - int64(b)
+	if d < 0 {
+		d = -d
+	}
+	return d <= int64(tolerance)
+}
+
+// formatItems formats at most maxLoggedItems of the supplied items.
+func formatItems(items []int32) string {
+	if len(items) <= maxLoggedItems {
+		return fmt.Sprint(items)
+	}
+	return fmt.Sprintf("%v and %d more", items[:maxLoggedItems], len(items)-maxLoggedItems)
+}
+
+// driftSummary describes how the desired state of the supplied GrpcKind
+// differs from the items and header the backend returned for its list.
+func (c *external) driftSummary(cr *v1alpha1.GrpcKind, items []int32, header metadata.MD) string {
+	var parts []string
+
+	added, removed := itemsDiff(cr.Spec.ForProvider.ListItems, items)
+	if len(added) > 0 {
+		parts = append(parts, "items added "+formatItems(added))
+	}
+	// Under the Union merge strategy items other clients added, and the
+	// order of items, aren't drift.
+	if mergeStrategy(cr) != v1alpha1.MergeUnion {
+		if len(removed) > 0 {
+			parts = append(parts, "items removed "+formatItems(removed))
+		}
+		if len(added) == 0 && len(removed) == 0 && len(items) > 0 && !reflect.DeepEqual(cr.Spec.ForProvider.ListItems, items) {
+			parts = append(parts, "items reordered")
+		}
+	}
+
+	if c.descriptionDrifted(cr) {
+		before := "<unknown>"
+		if d := cr.Status.AtProvider.Description; d != nil {
+			before = logDescription(*d)
+		}
+		parts = append(parts, fmt.Sprintf("description %s -> %s", before, logDescription(*cr.Spec.ForProvider.Description)))
+	}
+
+	if accessDrifted(cr, header) {
+		parts = append(parts, fmt.Sprintf("access %s -> %s", header.Get(accessKey)[0], *cr.Spec.ForProvider.Access))
+	}
+
+	if ownerDrifted(cr, header) {
+		parts = append(parts, fmt.Sprintf("owner %q -> %q", header.Get(ownerKey)[0], *cr.Spec.ForProvider.Owner))
+	}
+
+	if itemMetadataDrifted(cr, header) {
+		parts = append(parts, "item metadata changed")
+	}
+
+	if structuredItemsDrifted(cr, header) {
+		parts = append(parts, "structured items changed")
+	}
+
+	if ttlDrifted(cr, header) {
+		parts = append(parts, fmt.Sprintf("ttl %s -> %s", header.Get(ttlKey)[0], cr.Spec.ForProvider.TTL.Duration))
+	}
+
+	return strings.Join(parts, ", ")
+}
+
+// A Diff describes how applying a new GrpcKind spec would change its list,
+// e.g. to preview the effect of a change before it's reconciled.
+type Diff struct {
+	// AddedItems are the items the new spec has but the old one doesn't.
+	AddedItems []int32
+
+	// RemovedItems are the items the old spec has but the new one doesn't.
+	RemovedItems []int32
+
+	// Reordered is true if both specs have the same items, in a different
+	// order.
+	Reordered bool
+
+	// DescriptionChanged is true if the new spec changes the description. An
+	// unset new description leaves it unchanged.
+	DescriptionChanged bool
+
+	// OldDescription and NewDescription are the descriptions of the old and
+	// new spec, if the description changed.
+	OldDescription *string
+	NewDescription *string
+}
+
+// Changed returns true if the Diff describes any change.
+func (d Diff) Changed() bool {
+	return len(d.AddedItems) > 0 || len(d.RemovedItems) > 0 || d.Reordered || d.DescriptionChanged
+}
+
+// DiffSpec returns how applying the new parameters of a GrpcKind would change
+// its list, compared to the old parameters, without calling its backend. Items
+// and descriptions are compared the same way as when observing the list.
+func DiffSpec(oldSpec, newSpec v1alpha1.GrpcKindParameters) Diff {
+	d := Diff{}
+	d.AddedItems, d.RemovedItems = itemsDiff(newSpec.ListItems, oldSpec.ListItems)
+	if len(d.AddedItems) == 0 && len(d.RemovedItems) == 0 {
+		d.Reordered = !itemsEqual(newSpec.ListItems, oldSpec.ListItems, 0)
+	}
+
+	// The old description is the one that was last applied.
+	cr := &v1alpha1.GrpcKind{}
+	cr.Spec.ForProvider = newSpec
+	cr.Status.AtProvider.Description = oldSpec.Description
+	if descriptionChanged(cr) {
+		d.DescriptionChanged = true
+		d.OldDescription, d.NewDescription = oldSpec.Description, newSpec.Description
+	}
+	return d
+}
@@ -0,0 +1,242 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpckind
+
+import (
+	"context"
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	logtest "github.com/sirupsen/logrus/hooks/test"
+	"google.golang.org/grpc"
+
+	listServicepb "github.com/ashwinshirva/provider-grpc-server/proto"
+	"github.com/crossplane/provider-grpc/apis/mygroup/v1alpha1"
+	apisv1alpha1 "github.com/crossplane/provider-grpc/apis/v1alpha1"
+)
+
+func TestObserveDriftLog(t *testing.T) {
+	cases := map[string]struct {
+		reason string
+		want   []int32
+		got    []int32
+		logs   []string
+	}{
+		"AddedAndRemoved": {
+			reason: "The drift log should include the items that will be added and removed.",
+			want:   []int32{1, 2, 4, 4},
+			got:    []int32{1, 2, 3, 4},
+			logs:   []string{"items added [4]", "items removed [3]"},
+		},
+		"Reordered": {
+			reason: "The drift log should say when items were only reordered.",
+			want:   []int32{1, 2},
+			got:    []int32{2, 1},
+			logs:   []string{"items reordered"},
+		},
+		"Bounded": {
+			reason: "The drift log should include a bounded number of items.",
+			want:   []int32{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12},
+			logs:   []string{"items added [1 2 3 4 5 6 7 8 9 10] and 2 more"},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			hook := logtest.NewGlobal()
+			defer hook.Reset()
+
+			e := external{service: &ListService{grpcClient: &fakeListServiceClient{
+				MockGetList: func(_ context.Context, _ *listServicepb.GetListReq, _ ...grpc.CallOption) (*listServicepb.GetListResp, error) {
+					return &listServicepb.GetListResp{Status: "SUCCESS", Items: tc.got}, nil
+				},
+			}}}
+			if _, err := e.Observe(context.Background(), grpcKindWith("cool-list", withItems(tc.want...))); err != nil {
+				t.Fatalf("\n%s\ne.Observe(...): %v", tc.reason, err)
+			}
+
+			var logs []string
+			for _, entry := range hook.AllEntries() {
+				logs = append(logs, entry.Message)
+			}
+			all := strings.Join(logs, "\n")
+			for _, want := range tc.logs {
+				if !strings.Contains(all, want) {
+					t.Errorf("\n%s\ne.Observe(...): want log to contain %q, got:\n%s", tc.reason, want, all)
+				}
+			}
+		})
+	}
+}
+
+func TestItemTolerance(t *testing.T) {
+	union := func(cr *v1alpha1.GrpcKind) { s := v1alpha1.MergeUnion; cr.Spec.ForProvider.MergeStrategy = &s }
+
+	cases := map[string]struct {
+		reason    string
+		tolerance *int32
+		want      []int32
+		got       []int32
+		union     bool
+		upToDate  bool
+	}{
+		"ExactEqual": {
+			reason:   "Equal items should not drift by default.",
+			want:     []int32{10, 20},
+			got:      []int32{10, 20},
+			upToDate: true,
+		},
+		"ExactDiffers": {
+			reason: "Items that differ at all should drift by default.",
+			want:   []int32{10, 20},
+			got:    []int32{10, 21},
+		},
+		"ZeroTolerance": {
+			reason:    "Items that differ at all should drift with a tolerance of 0.",
+			tolerance: int32Ptr(0),
+			want:      []int32{10, 20},
+			got:       []int32{10, 21},
+		},
+		"WithinTolerance": {
+			reason:    "Items within the tolerance of the desired items should not drift.",
+			tolerance: int32Ptr(2),
+			want:      []int32{10, 20},
+			got:       []int32{12, 18},
+			upToDate:  true,
+		},
+		"BeyondTolerance": {
+			reason:    "Items beyond the tolerance of the desired items should drift.",
+			tolerance: int32Ptr(2),
+			want:      []int32{10, 20},
+			got:       []int32{10, 23},
+		},
+		"MissingItems": {
+			reason:    "Missing items should drift regardless of the tolerance.",
+			tolerance: int32Ptr(2),
+			want:      []int32{10, 20},
+			got:       []int32{10},
+		},
+		"Extremes": {
+			reason:    "Comparing the most distant items should not overflow.",
+			tolerance: int32Ptr(1),
+			want:      []int32{math.MaxInt32},
+			got:       []int32{math.MinInt32},
+		},
+		"UnionWithinTolerance": {
+			reason:    "Items within the tolerance of the desired items should satisfy the Union merge strategy.",
+			tolerance: int32Ptr(2),
+			want:      []int32{10, 20},
+			got:       []int32{5, 19, 11},
+			union:     true,
+			upToDate:  true,
+		},
+		"UnionBeyondTolerance": {
+			reason:    "Each backend item should match at most one desired item under the Union merge strategy.",
+			tolerance: int32Ptr(2),
+			want:      []int32{10, 11},
+			got:       []int32{10},
+			union:     true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := external{pc: apisv1alpha1.ProviderConfigSpec{ItemTolerance: tc.tolerance}, service: &ListService{grpcClient: &fakeListServiceClient{
+				MockGetList: func(_ context.Context, _ *listServicepb.GetListReq, _ ...grpc.CallOption) (*listServicepb.GetListResp, error) {
+					return &listServicepb.GetListResp{Status: "SUCCESS", Items: tc.got}, nil
+				},
+			}}}
+			cr := grpcKindWith("cool-list", withItems(tc.want...))
+			if tc.union {
+				union(cr)
+			}
+			o, err := e.Observe(context.Background(), cr)
+			if err != nil {
+				t.Fatalf("\n%s\ne.Observe(...): %v", tc.reason, err)
+			}
+			if o.ResourceUpToDate != tc.upToDate {
+				t.Errorf("\n%s\ne.Observe(...): want ResourceUpToDate %t, got %t", tc.reason, tc.upToDate, o.ResourceUpToDate)
+			}
+		})
+	}
+}
+
+func TestDiffSpec(t *testing.T) {
+	cases := map[string]struct {
+		reason   string
+		old, new v1alpha1.GrpcKindParameters
+		want     Diff
+	}{
+		"Unchanged": {
+			reason: "Identical specs should not differ.",
+			old:    v1alpha1.GrpcKindParameters{ListItems: []int32{1, 2}, Description: strPtr("cool")},
+			new:    v1alpha1.GrpcKindParameters{ListItems: []int32{1, 2}, Description: strPtr("cool")},
+			want:   Diff{},
+		},
+		"AddedAndRemoved": {
+			reason: "Items only the new spec has should be added, and items only the old spec has removed.",
+			old:    v1alpha1.GrpcKindParameters{ListItems: []int32{1, 2, 2}},
+			new:    v1alpha1.GrpcKindParameters{ListItems: []int32{2, 3}},
+			want:   Diff{AddedItems: []int32{3}, RemovedItems: []int32{1, 2}},
+		},
+		"Reordered": {
+			reason: "The same items in a different order should be reordered.",
+			old:    v1alpha1.GrpcKindParameters{ListItems: []int32{1, 2}},
+			new:    v1alpha1.GrpcKindParameters{ListItems: []int32{2, 1}},
+			want:   Diff{Reordered: true},
+		},
+		"NilAndEmptyItems": {
+			reason: "Nil and empty items should not differ.",
+			old:    v1alpha1.GrpcKindParameters{},
+			new:    v1alpha1.GrpcKindParameters{ListItems: []int32{}},
+			want:   Diff{},
+		},
+		"DescriptionChanged": {
+			reason: "A new description should be a change.",
+			old:    v1alpha1.GrpcKindParameters{Description: strPtr("cool")},
+			new:    v1alpha1.GrpcKindParameters{Description: strPtr("cooler")},
+			want:   Diff{DescriptionChanged: true, OldDescription: strPtr("cool"), NewDescription: strPtr("cooler")},
+		},
+		"DescriptionCleared": {
+			reason: "An empty description should clear the old description.",
+			old:    v1alpha1.GrpcKindParameters{Description: strPtr("cool")},
+			new:    v1alpha1.GrpcKindParameters{Description: strPtr("")},
+			want:   Diff{DescriptionChanged: true, OldDescription: strPtr("cool"), NewDescription: strPtr("")},
+		},
+		"DescriptionUnset": {
+			reason: "An unset description should leave the old description alone.",
+			old:    v1alpha1.GrpcKindParameters{Description: strPtr("cool")},
+			new:    v1alpha1.GrpcKindParameters{},
+			want:   Diff{},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := DiffSpec(tc.old, tc.new)
+			if diff := cmp.Diff(tc.want, got, cmpopts.EquateEmpty()); diff != "" {
+				t.Errorf("\n%s\nDiffSpec(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+			if got.Changed() != tc.want.Changed() {
+				t.Errorf("\n%s\nDiffSpec(...).Changed(): want %t, got %t", tc.reason, tc.want.Changed(), got.Changed())
+			}
+		})
+	}
+}
@@ -0,0 +1,47 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpckind
+
+import (
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/crossplane/provider-grpc/apis/mygroup/v1alpha1"
+	apisv1alpha1 "github.com/crossplane/provider-grpc/apis/v1alpha1"
+)
+
+const errEmptyName = "spec.forProvider.name must not be empty"
+
+// generatedNamePrefix prefixes the names generated for lists that have none.
+const generatedNamePrefix = "list-"
+
+// defaultName names the list of the supplied GrpcKind if it has no name and
+// the ProviderConfig's empty name policy is Generate. The name is derived from
+// the GrpcKind's UID, so it's the same each time it's generated. It returns
+// true if the GrpcKind was named and must be persisted, or an error if the
+// list has no name and the policy is Reject.
+func (c *external) defaultName(cr *v1alpha1.GrpcKind) (bool, error) {
+	if cr.Spec.ForProvider.Name != "" {
+		return false, nil
+	}
+	if p := c.pc.EmptyName; p == nil || *p != apisv1alpha1.EmptyNameGenerate || cr.GetUID() == "" {
+		return false, errors.New(errEmptyName)
+	}
+	cr.Spec.ForProvider.Name = generatedNamePrefix + string(cr.GetUID())
+	log.Infof("Observe::Generated name \"%v\" for the list of \"%v\"", logName(cr.Spec.ForProvider.Name), cr.GetName())
+	return true, nil
+}
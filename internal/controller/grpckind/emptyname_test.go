@@ -0,0 +1,122 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpckind
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	listServicepb "github.com/ashwinshirva/provider-grpc-server/proto"
+	"github.com/crossplane/provider-grpc/apis/mygroup/v1alpha1"
+	apisv1alpha1 "github.com/crossplane/provider-grpc/apis/v1alpha1"
+)
+
+func TestObserveEmptyName(t *testing.T) {
+	generate := apisv1alpha1.EmptyNameGenerate
+	reject := apisv1alpha1.EmptyNameReject
+
+	type want struct {
+		o    managed.ExternalObservation
+		err  error
+		name string
+		got  []string
+	}
+
+	cases := map[string]struct {
+		reason  string
+		policy  *apisv1alpha1.EmptyNamePolicy
+		deleted bool
+		want    want
+	}{
+		"DefaultReject": {
+			reason: "A list without a name should be rejected rather than got by default.",
+			want:   want{err: errors.New(errEmptyName)},
+		},
+		"Reject": {
+			reason: "A list without a name should be rejected rather than got under the Reject policy.",
+			policy: &reject,
+			want:   want{err: errors.New(errEmptyName)},
+		},
+		"RejectDeleted": {
+			reason:  "A GrpcKind without a name that is being deleted should be reported not to exist, since it never had a list.",
+			policy:  &reject,
+			deleted: true,
+			want: want{
+				o: managed.ExternalObservation{ResourceExists: false, ResourceUpToDate: true, ConnectionDetails: managed.ConnectionDetails{}},
+			},
+		},
+		"Generate": {
+			reason: "A list without a name should be named after the GrpcKind's UID under the Generate policy, and the name persisted.",
+			policy: &generate,
+			want: want{
+				o: managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceUpToDate:        true,
+					ResourceLateInitialized: true,
+					ConnectionDetails:       managed.ConnectionDetails{},
+				},
+				name: "list-cool-uid",
+				got:  []string{"list-cool-uid"},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var got []string
+			e := external{
+				pc: apisv1alpha1.ProviderConfigSpec{EmptyName: tc.policy},
+				service: &ListService{grpcClient: &fakeListServiceClient{
+					MockGetList: func(_ context.Context, req *listServicepb.GetListReq, _ ...grpc.CallOption) (*listServicepb.GetListResp, error) {
+						got = append(got, req.GetName())
+						return &listServicepb.GetListResp{Status: statusSuccess}, nil
+					},
+				}},
+			}
+			cr := grpcKindWith("", func(cr *v1alpha1.GrpcKind) {
+				cr.SetUID(types.UID("cool-uid"))
+				if tc.deleted {
+					now := metav1.Now()
+					cr.SetDeletionTimestamp(&now)
+				}
+			})
+
+			o, err := e.Observe(context.Background(), cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.o, o); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.name, cr.Spec.ForProvider.Name); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want name, +got name:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.got, got); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want lists got, +got lists got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,56 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpckind
+
+import (
+	"net"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/resolver"
+)
+
+const (
+	errInvalidEndpointFmt = "invalid endpoint %q: must be a host:port or a URI whose scheme has a registered resolver, e.g. dns:///lists.example.org:443"
+	errInvalidPortFmt     = "invalid endpoint %q: port %q must be a number between 1 and 65535"
+)
+
+// validateEndpoint returns an error unless the supplied endpoint is one gRPC
+// can dial, i.e. a host and port such as lists.example.org:443, or a URI whose
+// scheme has a registered resolver, such as unix:///var/run/lists.sock. The
+// host may be empty, which dials the local host. This catches malformed
+// endpoints before they fail at dial time with a less helpful error.
+func validateEndpoint(ep string, extra []resolver.Builder) error {
+	// Like gRPC, treat the endpoint as a URI if its scheme has a resolver.
+	if u, err := url.Parse(ep); err == nil && u.Scheme != "" && resolverRegistered(u.Scheme, extra) {
+		if u.Host == "" && u.Path == "" && u.Opaque == "" {
+			return errors.Errorf(errInvalidEndpointFmt, ep)
+		}
+		return nil
+	}
+
+	host, port, err := net.SplitHostPort(ep)
+	if err != nil || strings.ContainsAny(host, "/\\ \t") {
+		return errors.Errorf(errInvalidEndpointFmt, ep)
+	}
+	if p, err := strconv.ParseUint(port, 10, 16); err != nil || p == 0 {
+		return errors.Errorf(errInvalidPortFmt, ep, port)
+	}
+	return nil
+}
@@ -0,0 +1,129 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpckind
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/resolver"
+	"google.golang.org/grpc/resolver/manual"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	apisv1alpha1 "github.com/crossplane/provider-grpc/apis/v1alpha1"
+)
+
+func TestValidateEndpoint(t *testing.T) {
+	cases := map[string]struct {
+		reason   string
+		endpoint string
+		want     error
+	}{
+		"HostPort": {
+			reason:   "A host and port should be valid.",
+			endpoint: "lists.example.org:443",
+		},
+		"IPv6": {
+			reason:   "An IPv6 address and port should be valid.",
+			endpoint: "[2001:db8::1]:50051",
+		},
+		"LocalHost": {
+			reason:   "A port without a host should be valid, since it dials the local host.",
+			endpoint: ":50050",
+		},
+		"DNS": {
+			reason:   "A URI whose scheme has a registered resolver should be valid.",
+			endpoint: "dns:///lists.example.org:443",
+		},
+		"Unix": {
+			reason:   "A unix socket URI should be valid.",
+			endpoint: "unix:///var/run/lists.sock",
+		},
+		"CustomResolver": {
+			reason:   "A URI whose scheme is one of the supplied resolvers should be valid.",
+			endpoint: "coolmesh:///lists",
+		},
+		"MissingPort": {
+			reason:   "A host without a port should be invalid.",
+			endpoint: "lists.example.org",
+			want:     errors.Errorf(errInvalidEndpointFmt, "lists.example.org"),
+		},
+		"BadPort": {
+			reason:   "A port that isn't a number should be invalid.",
+			endpoint: "lists.example.org:https",
+			want:     errors.Errorf(errInvalidPortFmt, "lists.example.org:https", "https"),
+		},
+		"PortOutOfRange": {
+			reason:   "A port greater than 65535 should be invalid.",
+			endpoint: "lists.example.org:70000",
+			want:     errors.Errorf(errInvalidPortFmt, "lists.example.org:70000", "70000"),
+		},
+		"UnknownScheme": {
+			reason:   "A URI whose scheme has no resolver, e.g. a typo, should be invalid.",
+			endpoint: "dsn:///lists.example.org:443",
+			want:     errors.Errorf(errInvalidEndpointFmt, "dsn:///lists.example.org:443"),
+		},
+		"EmptyURI": {
+			reason:   "A URI with nothing to resolve should be invalid.",
+			endpoint: "dns:",
+			want:     errors.Errorf(errInvalidEndpointFmt, "dns:"),
+		},
+		"Whitespace": {
+			reason:   "A host containing whitespace should be invalid.",
+			endpoint: "lists example.org:443",
+			want:     errors.Errorf(errInvalidEndpointFmt, "lists example.org:443"),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := validateEndpoint(tc.endpoint, []resolver.Builder{manual.NewBuilderWithScheme("coolmesh")})
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nvalidateEndpoint(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestConnectInvalidEndpoint(t *testing.T) {
+	c := connector{
+		kube: &test.MockClient{
+			MockGet: test.NewMockGetFn(nil, func(obj client.Object) error {
+				pc := obj.(*apisv1alpha1.ProviderConfig)
+				pc.Spec.Credentials.Source = xpv1.CredentialsSourceNone
+				pc.Spec.Endpoint = strPtr("lists.example.org")
+				return nil
+			}),
+		},
+		usage: resource.TrackerFn(func(_ context.Context, _ resource.Managed) error { return nil }),
+		newServiceFn: func(_ string, _ []byte, _ transport) (*ListService, error) {
+			t.Error("c.Connect(...): want a malformed endpoint not to be dialed")
+			return nil, nil
+		},
+	}
+
+	_, err := c.Connect(context.Background(), grpcKind("cool-list"))
+	if diff := cmp.Diff(errors.Errorf(errInvalidEndpointFmt, "lists.example.org"), err, test.EquateErrors()); diff != "" {
+		t.Errorf("c.Connect(...): -want error, +got error:\n%s\n", diff)
+	}
+}
@@ -0,0 +1,91 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpckind
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/crossplane/provider-grpc/apis/mygroup/v1alpha1"
+)
+
+const (
+	errGetExportConfigMap    = "cannot get ConfigMap to export list items to"
+	errCreateExportConfigMap = "cannot create ConfigMap to export list items to"
+	errUpdateExportConfigMap = "cannot update ConfigMap to export list items to"
+	errMarshalItems          = "cannot marshal list items"
+)
+
+// Keys of the ConfigMap a list's items are exported to. The items are a JSON
+// array.
+const (
+	exportNameKey  = "name"
+	exportItemsKey = "items"
+)
+
+// exportItems writes the supplied observed items of the GrpcKind's list to the
+// ConfigMap it references, if any. The ConfigMap is created if it doesn't
+// exist, and only updated if the items changed. Other data in the ConfigMap
+// is left alone.
+func (c *external) exportItems(ctx context.Context, cr *v1alpha1.GrpcKind, items []int32) error {
+	ref := cr.Spec.ForProvider.ExportConfigMapRef
+	if ref == nil {
+		return nil
+	}
+
+	if items == nil {
+		items = []int32{}
+	}
+	b, err := json.Marshal(items)
+	if err != nil {
+		return errors.Wrap(err, errMarshalItems)
+	}
+	data := map[string]string{
+		exportNameKey:  cr.Spec.ForProvider.Name,
+		exportItemsKey: string(b),
+	}
+
+	cm := &corev1.ConfigMap{}
+	err = c.kube.Get(ctx, types.NamespacedName{Namespace: ref.Namespace, Name: ref.Name}, cm)
+	if kerrors.IsNotFound(err) {
+		cm = &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Namespace: ref.Namespace, Name: ref.Name},
+			Data:       data,
+		}
+		return errors.Wrap(c.kube.Create(ctx, cm), errCreateExportConfigMap)
+	}
+	if err != nil {
+		return errors.Wrap(err, errGetExportConfigMap)
+	}
+
+	if cm.Data[exportNameKey] == data[exportNameKey] && cm.Data[exportItemsKey] == data[exportItemsKey] {
+		return nil
+	}
+	if cm.Data == nil {
+		cm.Data = make(map[string]string, len(data))
+	}
+	for k, v := range data {
+		cm.Data[k] = v
+	}
+	return errors.Wrap(c.kube.Update(ctx, cm), errUpdateExportConfigMap)
+}
@@ -0,0 +1,148 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpckind
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	listServicepb "github.com/ashwinshirva/provider-grpc-server/proto"
+	"github.com/crossplane/provider-grpc/apis/mygroup/v1alpha1"
+)
+
+func TestExportItems(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	type want struct {
+		created *corev1.ConfigMap
+		updated *corev1.ConfigMap
+		err     error
+	}
+
+	cases := map[string]struct {
+		reason   string
+		existing *corev1.ConfigMap
+		getErr   error
+		items    []int32
+		want     want
+	}{
+		"Created": {
+			reason: "A ConfigMap containing the observed items should be created if it doesn't exist.",
+			getErr: kerrors.NewNotFound(schema.GroupResource{Resource: "configmaps"}, "cool-export"),
+			items:  []int32{1, 2, 3},
+			want: want{
+				created: &corev1.ConfigMap{
+					ObjectMeta: metav1.ObjectMeta{Namespace: "cool-ns", Name: "cool-export"},
+					Data:       map[string]string{"name": "cool-list", "items": "[1,2,3]"},
+				},
+			},
+		},
+		"CreatedEmpty": {
+			reason: "A list without items should be exported as an empty array.",
+			getErr: kerrors.NewNotFound(schema.GroupResource{Resource: "configmaps"}, "cool-export"),
+			want: want{
+				created: &corev1.ConfigMap{
+					ObjectMeta: metav1.ObjectMeta{Namespace: "cool-ns", Name: "cool-export"},
+					Data:       map[string]string{"name": "cool-list", "items": "[]"},
+				},
+			},
+		},
+		"Drifted": {
+			reason: "A ConfigMap containing stale items should be updated, keeping its other data.",
+			existing: &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "cool-ns", Name: "cool-export"},
+				Data:       map[string]string{"name": "cool-list", "items": "[1]", "other": "data"},
+			},
+			items: []int32{1, 2},
+			want: want{
+				updated: &corev1.ConfigMap{
+					ObjectMeta: metav1.ObjectMeta{Namespace: "cool-ns", Name: "cool-export"},
+					Data:       map[string]string{"name": "cool-list", "items": "[1,2]", "other": "data"},
+				},
+			},
+		},
+		"InSync": {
+			reason: "A ConfigMap that already contains the observed items should not be updated.",
+			existing: &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "cool-ns", Name: "cool-export"},
+				Data:       map[string]string{"name": "cool-list", "items": "[1,2]"},
+			},
+			items: []int32{1, 2},
+		},
+		"GetFailed": {
+			reason: "Errors getting the ConfigMap should be returned.",
+			getErr: errBoom,
+			items:  []int32{1},
+			want: want{
+				err: errors.Wrap(errBoom, errGetExportConfigMap),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var created, updated *corev1.ConfigMap
+			kube := &test.MockClient{
+				MockGet: func(_ context.Context, _ client.ObjectKey, obj client.Object) error {
+					if tc.getErr != nil {
+						return tc.getErr
+					}
+					tc.existing.DeepCopyInto(obj.(*corev1.ConfigMap))
+					return nil
+				},
+				MockCreate: func(_ context.Context, obj client.Object, _ ...client.CreateOption) error {
+					created = obj.(*corev1.ConfigMap)
+					return nil
+				},
+				MockUpdate: func(_ context.Context, obj client.Object, _ ...client.UpdateOption) error {
+					updated = obj.(*corev1.ConfigMap)
+					return nil
+				},
+			}
+			e := external{kube: kube, service: &ListService{grpcClient: &fakeListServiceClient{
+				MockGetList: func(_ context.Context, _ *listServicepb.GetListReq, _ ...grpc.CallOption) (*listServicepb.GetListResp, error) {
+					return &listServicepb.GetListResp{Status: "SUCCESS", Items: tc.items}, nil
+				},
+			}}}
+			cr := grpcKindWith("cool-list", withItems(tc.items...), func(cr *v1alpha1.GrpcKind) {
+				cr.Spec.ForProvider.ExportConfigMapRef = &v1alpha1.ConfigMapReference{Namespace: "cool-ns", Name: "cool-export"}
+			})
+
+			_, err := e.Observe(context.Background(), cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.created, created); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want created ConfigMap, +got created ConfigMap:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.updated, updated); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want updated ConfigMap, +got updated ConfigMap:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
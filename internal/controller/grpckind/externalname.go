@@ -0,0 +1,52 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpckind
+
+import (
+	log "github.com/sirupsen/logrus"
+
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+
+	"github.com/crossplane/provider-grpc/apis/mygroup/v1alpha1"
+)
+
+// externalNameSettled returns true if the supplied GrpcKind's external name
+// identifies the list it manages, because it created the list, or adopted it
+// when it was first observed. A settled external name must never change, so
+// that the GrpcKind keeps managing the same list.
+func externalNameSettled(cr *v1alpha1.GrpcKind) bool {
+	if meta.GetExternalName(cr) == "" {
+		return false
+	}
+	return !meta.GetExternalCreateSucceeded(cr).IsZero() || cr.Status.AtProvider.Status != ""
+}
+
+// guardExternalName returns a function that restores the supplied GrpcKind's
+// external name if it was settled and has since changed. Observe and Update
+// defer it so that they can never make a GrpcKind manage a different list.
+func guardExternalName(cr *v1alpha1.GrpcKind) func() {
+	if !externalNameSettled(cr) {
+		return func() {}
+	}
+	en := meta.GetExternalName(cr)
+	return func() {
+		if got := meta.GetExternalName(cr); got != en {
+			log.Warnf("ExternalName::External name of \"%v\" changed from %q to %q. Keeping %q", cr.GetName(), logName(en), logName(got), logName(en))
+			meta.SetExternalName(cr, en)
+		}
+	}
+}
@@ -0,0 +1,133 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpckind
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/grpc"
+
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+
+	listServicepb "github.com/ashwinshirva/provider-grpc-server/proto"
+	"github.com/crossplane/provider-grpc/apis/mygroup/v1alpha1"
+)
+
+func TestExternalNameStable(t *testing.T) {
+	cases := map[string]struct {
+		reason string
+		mg     func(cr *v1alpha1.GrpcKind)
+		want   string
+	}{
+		"Created": {
+			reason: "The external name of a GrpcKind that created its list should never change.",
+			mg: func(cr *v1alpha1.GrpcKind) {
+				meta.SetExternalName(cr, "cool-grpckind")
+				meta.SetExternalCreateSucceeded(cr, time.Now())
+				cr.Status.AtProvider.Status = statusSuccess
+			},
+			want: "cool-grpckind",
+		},
+		"Adopted": {
+			reason: "The external name of a GrpcKind that adopted its list should never change.",
+			mg: func(cr *v1alpha1.GrpcKind) {
+				cr.Status.AtProvider.Status = statusSuccess
+			},
+			want: "cool-list",
+		},
+		"AdoptedThenRenamed": {
+			reason: "A GrpcKind whose name changed after it adopted its list should keep its external name rather than adopt another list.",
+			mg: func(cr *v1alpha1.GrpcKind) {
+				cr.Status.AtProvider.Status = statusSuccess
+				cr.Spec.ForProvider.Name = "cooler-list"
+			},
+			want: "cool-list",
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := external{service: &ListService{grpcClient: &fakeListServiceClient{
+				MockGetList: func(_ context.Context, _ *listServicepb.GetListReq, _ ...grpc.CallOption) (*listServicepb.GetListResp, error) {
+					return &listServicepb.GetListResp{Status: statusSuccess, Items: []int32{1}}, nil
+				},
+				MockUpdateListItems: func(_ context.Context, _ *listServicepb.UpdateListItemsReq, _ ...grpc.CallOption) (*listServicepb.UpdateListItemsResp, error) {
+					return &listServicepb.UpdateListItemsResp{Status: statusSuccess}, nil
+				},
+			}}}
+			cr := grpcKindWith("cool-list", withItems(1, 2), tc.mg)
+
+			// Reconcile a few times, as the managed reconciler would.
+			for i := 0; i < 3; i++ {
+				o, err := e.Observe(context.Background(), cr)
+				if err != nil {
+					t.Fatalf("\n%s\ne.Observe(...): %v", tc.reason, err)
+				}
+				if o.ResourceLateInitialized {
+					t.Errorf("\n%s\ne.Observe(...): want the list not to be adopted", tc.reason)
+				}
+				if diff := cmp.Diff(tc.want, meta.GetExternalName(cr)); diff != "" {
+					t.Errorf("\n%s\ne.Observe(...): -want external name, +got external name:\n%s\n", tc.reason, diff)
+				}
+				if _, err := e.Update(context.Background(), cr); err != nil {
+					t.Fatalf("\n%s\ne.Update(...): %v", tc.reason, err)
+				}
+				if diff := cmp.Diff(tc.want, meta.GetExternalName(cr)); diff != "" {
+					t.Errorf("\n%s\ne.Update(...): -want external name, +got external name:\n%s\n", tc.reason, diff)
+				}
+			}
+		})
+	}
+}
+
+func TestGuardExternalName(t *testing.T) {
+	cases := map[string]struct {
+		reason  string
+		settled bool
+		want    string
+	}{
+		"Settled": {
+			reason:  "A settled external name that was changed should be restored.",
+			settled: true,
+			want:    "cool-list",
+		},
+		"NotSettled": {
+			reason: "An external name that wasn't settled may change, e.g. when a list is adopted.",
+			want:   "cooler-list",
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			cr := grpcKind("cool-list")
+			if tc.settled {
+				meta.SetExternalCreateSucceeded(cr, time.Now())
+			}
+
+			restore := guardExternalName(cr)
+			meta.SetExternalName(cr, "cooler-list")
+			restore()
+
+			if diff := cmp.Diff(tc.want, meta.GetExternalName(cr)); diff != "" {
+				t.Errorf("\n%s\nguardExternalName(...): -want external name, +got external name:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
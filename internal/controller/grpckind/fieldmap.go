@@ -0,0 +1,49 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpckind
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+
+	listServicepb "github.com/ashwinshirva/provider-grpc-server/proto"
+	apisv1alpha1 "github.com/crossplane/provider-grpc/apis/v1alpha1"
+)
+
+// A fieldMappingListService is a ListServiceClient that remaps the fields of
+// each request it sends using the ListServiceClient it wraps.
+type fieldMappingListService struct {
+	listServicepb.ListServiceClient
+	mapping *apisv1alpha1.FieldMapping
+}
+
+func (c *fieldMappingListService) CreateList(ctx context.Context, in *listServicepb.CreateListReq, opts ...grpc.CallOption) (*listServicepb.CreateListResp, error) {
+	return c.ListServiceClient.CreateList(ctx, remapFields(in, c.mapping), opts...)
+}
+
+func (c *fieldMappingListService) GetList(ctx context.Context, in *listServicepb.GetListReq, opts ...grpc.CallOption) (*listServicepb.GetListResp, error) {
+	return c.ListServiceClient.GetList(ctx, remapFields(in, c.mapping), opts...)
+}
+
+func (c *fieldMappingListService) UpdateListItems(ctx context.Context, in *listServicepb.UpdateListItemsReq, opts ...grpc.CallOption) (*listServicepb.UpdateListItemsResp, error) {
+	return c.ListServiceClient.UpdateListItems(ctx, remapFields(in, c.mapping), opts...)
+}
+
+func (c *fieldMappingListService) DeleteList(ctx context.Context, in *listServicepb.DeleteListReq, opts ...grpc.CallOption) (*listServicepb.DeleteListResp, error) {
+	return c.ListServiceClient.DeleteList(ctx, remapFields(in, c.mapping), opts...)
+}
@@ -0,0 +1,154 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpckind
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+
+	listServicepb "github.com/ashwinshirva/provider-grpc-server/proto"
+	apisv1alpha1 "github.com/crossplane/provider-grpc/apis/v1alpha1"
+)
+
+// wireFields returns the encoded values of the fields of the supplied message,
+// by field number.
+func wireFields(t *testing.T, m proto.Message) map[protowire.Number][]byte {
+	t.Helper()
+	b, err := proto.Marshal(m)
+	if err != nil {
+		t.Fatalf("proto.Marshal(...): %v", err)
+	}
+	fields := map[protowire.Number][]byte{}
+	for len(b) > 0 {
+		num, typ, n := protowire.ConsumeTag(b)
+		if n < 0 {
+			t.Fatalf("protowire.ConsumeTag(...): %v", protowire.ParseError(n))
+		}
+		b = b[n:]
+		n = protowire.ConsumeFieldValue(num, typ, b)
+		if n < 0 {
+			t.Fatalf("protowire.ConsumeFieldValue(...): %v", protowire.ParseError(n))
+		}
+		fields[num] = b[:n]
+		b = b[n:]
+	}
+	return fields
+}
+
+// wireString returns the encoding of the supplied string field value.
+func wireString(s string) []byte {
+	return protowire.AppendString(nil, s)
+}
+
+func TestFieldMapping(t *testing.T) {
+	var sent proto.Message
+	c := &fieldMappingListService{
+		ListServiceClient: &fakeListServiceClient{
+			MockCreateList: func(_ context.Context, in *listServicepb.CreateListReq, _ ...grpc.CallOption) (*listServicepb.CreateListResp, error) {
+				sent = in
+				return &listServicepb.CreateListResp{}, nil
+			},
+			MockGetList: func(_ context.Context, in *listServicepb.GetListReq, _ ...grpc.CallOption) (*listServicepb.GetListResp, error) {
+				sent = in
+				return &listServicepb.GetListResp{}, nil
+			},
+			MockUpdateListItems: func(_ context.Context, in *listServicepb.UpdateListItemsReq, _ ...grpc.CallOption) (*listServicepb.UpdateListItemsResp, error) {
+				sent = in
+				return &listServicepb.UpdateListItemsResp{}, nil
+			},
+			MockDeleteList: func(_ context.Context, in *listServicepb.DeleteListReq, _ ...grpc.CallOption) (*listServicepb.DeleteListResp, error) {
+				sent = in
+				return &listServicepb.DeleteListResp{}, nil
+			},
+		},
+		mapping: &apisv1alpha1.FieldMapping{Name: int32Ptr(7), Description: int32Ptr(8), ListItems: int32Ptr(9)},
+	}
+	packed := protowire.AppendBytes(nil, []byte{1, 2})
+
+	cases := map[string]struct {
+		reason string
+		call   func() error
+		want   map[protowire.Number][]byte
+	}{
+		"CreateList": {
+			reason: "The name and description of a list should be sent as their mapped fields.",
+			call: func() error {
+				_, err := c.CreateList(context.Background(), &listServicepb.CreateListReq{Name: "cool-list", Description: "cool"})
+				return err
+			},
+			want: map[protowire.Number][]byte{7: wireString("cool-list"), 8: wireString("cool")},
+		},
+		"GetList": {
+			reason: "The name of a list should be sent as its mapped field.",
+			call: func() error {
+				_, err := c.GetList(context.Background(), &listServicepb.GetListReq{Name: "cool-list"})
+				return err
+			},
+			want: map[protowire.Number][]byte{7: wireString("cool-list")},
+		},
+		"UpdateListItems": {
+			reason: "The name and items of a list should be sent as their mapped fields.",
+			call: func() error {
+				_, err := c.UpdateListItems(context.Background(), &listServicepb.UpdateListItemsReq{Name: "cool-list", NewItems: []int32{1, 2}})
+				return err
+			},
+			want: map[protowire.Number][]byte{7: wireString("cool-list"), 9: packed},
+		},
+		"DeleteList": {
+			reason: "The name of a list should be sent as its mapped field.",
+			call: func() error {
+				_, err := c.DeleteList(context.Background(), &listServicepb.DeleteListReq{Name: "cool-list"})
+				return err
+			},
+			want: map[protowire.Number][]byte{7: wireString("cool-list")},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if err := tc.call(); err != nil {
+				t.Fatalf("\n%s\n%s(...): %v", tc.reason, name, err)
+			}
+			if diff := cmp.Diff(tc.want, wireFields(t, sent)); diff != "" {
+				t.Errorf("\n%s\n%s(...): -want fields, +got fields:\n%s\n", tc.reason, name, diff)
+			}
+		})
+	}
+}
+
+func TestRemapFieldsCopies(t *testing.T) {
+	req := &listServicepb.UpdateListItemsReq{Name: "cool-list", NewItems: []int32{-1}}
+	got := remapFields(req, &apisv1alpha1.FieldMapping{ListItems: int32Ptr(3)})
+
+	if req.GetNewItems() == nil {
+		t.Errorf("remapFields(...): want the supplied request left unchanged")
+	}
+	// A negative int32 is encoded as a ten byte varint.
+	minusOne := int64(-1)
+	want := map[protowire.Number][]byte{
+		1: wireString("cool-list"),
+		3: protowire.AppendBytes(nil, protowire.AppendVarint(nil, uint64(minusOne))),
+	}
+	if diff := cmp.Diff(want, wireFields(t, got)); diff != "" {
+		t.Errorf("remapFields(...): -want fields, +got fields:\n%s\n", diff)
+	}
+}
@@ -0,0 +1,109 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpckind
+
+import (
+	"sync"
+	"time"
+
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/clock"
+
+	"github.com/crossplane/provider-grpc/apis/mygroup/v1alpha1"
+)
+
+// ObserveFreshness is how long a GrpcKind whose list was observed ready and up
+// to date is considered up to date without getting its list again, as long as
+// its spec hasn't changed. This reduces the load stable GrpcKinds put on their
+// backend, at the cost of noticing drift later. Zero disables the fast path.
+var ObserveFreshness time.Duration
+
+// A freshObservations remembers when each GrpcKind's list was last observed
+// ready and up to date, and the generation of the spec and the checksum of the
+// desired items it was observed against. The items may change without the
+// spec changing if they're read from a ConfigMap.
+type freshObservations struct {
+	window time.Duration
+	clock  clock.PassiveClock
+
+	mu           sync.Mutex
+	observations map[types.UID]freshObservation
+}
+
+type freshObservation struct {
+	name       string
+	generation int64
+	checksum   string
+	at         time.Time
+}
+
+// newFreshObservations returns a freshObservations that considers observations
+// fresh for the supplied window.
+func newFreshObservations(window time.Duration, c clock.PassiveClock) *freshObservations {
+	return &freshObservations{
+		window:       window,
+		clock:        c,
+		observations: make(map[types.UID]freshObservation),
+	}
+}
+
+// Fresh returns true if the supplied GrpcKind's list was observed up to date
+// within the freshness window, and neither its spec nor its desired items have
+// changed since. A nil
+// freshObservations, or one with no window, considers no observation fresh.
+func (f *freshObservations) Fresh(cr *v1alpha1.GrpcKind) bool {
+	if f == nil || f.window == 0 {
+		return false
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	o, ok := f.observations[cr.GetUID()]
+	if !ok || o.name != cr.Spec.ForProvider.Name || o.generation != cr.GetGeneration() || o.checksum != itemsChecksum(cr.Spec.ForProvider.ListItems) {
+		return false
+	}
+	return f.clock.Since(o.at) < f.window
+}
+
+// Observed records that the supplied GrpcKind's list was observed ready and up
+// to date.
+func (f *freshObservations) Observed(cr *v1alpha1.GrpcKind) {
+	if f == nil || f.window == 0 {
+		return
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.observations[cr.GetUID()] = freshObservation{
+		name:       cr.Spec.ForProvider.Name,
+		generation: cr.GetGeneration(),
+		checksum:   itemsChecksum(cr.Spec.ForProvider.ListItems),
+		at:         f.clock.Now(),
+	}
+}
+
+// Forget forgets the last observation of the supplied GrpcKind's list, e.g.
+// because the list was written to, so that it's got when next observed.
+func (f *freshObservations) Forget(cr *v1alpha1.GrpcKind) {
+	if f == nil {
+		return
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	delete(f.observations, cr.GetUID())
+}
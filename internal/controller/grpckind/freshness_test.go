@@ -0,0 +1,114 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpckind
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/grpc"
+	"k8s.io/apimachinery/pkg/types"
+	testingclock "k8s.io/utils/clock/testing"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+
+	listServicepb "github.com/ashwinshirva/provider-grpc-server/proto"
+)
+
+func TestObserveFresh(t *testing.T) {
+	gets := 0
+	clk := testingclock.NewFakeClock(time.Now())
+	e := external{fresh: newFreshObservations(time.Minute, clk), service: &ListService{
+		grpcClient: &fakeListServiceClient{
+			MockGetList: func(_ context.Context, _ *listServicepb.GetListReq, _ ...grpc.CallOption) (*listServicepb.GetListResp, error) {
+				gets++
+				return &listServicepb.GetListResp{Status: statusSuccess, Items: []int32{1, 2}}, nil
+			},
+			MockUpdateListItems: func(_ context.Context, _ *listServicepb.UpdateListItemsReq, _ ...grpc.CallOption) (*listServicepb.UpdateListItemsResp, error) {
+				return &listServicepb.UpdateListItemsResp{Status: statusSuccess}, nil
+			},
+		},
+	}}
+	cr := grpcKindWith("cool-list", withItems(1, 2))
+	cr.SetUID(types.UID("cool-uid"))
+	cr.SetGeneration(1)
+
+	observe := func(step string, wantGets int) {
+		t.Helper()
+		o, err := e.Observe(context.Background(), cr)
+		if err != nil {
+			t.Fatalf("%s: e.Observe(...): %v", step, err)
+		}
+		want := managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true, ConnectionDetails: managed.ConnectionDetails{}}
+		if diff := cmp.Diff(want, o); diff != "" {
+			t.Errorf("%s: e.Observe(...): -want, +got:\n%s\n", step, diff)
+		}
+		if gets != wantGets {
+			t.Errorf("%s: e.Observe(...): want %d GetList calls, got %d", step, wantGets, gets)
+		}
+	}
+
+	// The list is got the first time it's observed.
+	observe("First", 1)
+
+	// Within the freshness window the list isn't got again.
+	clk.Step(30 * time.Second)
+	observe("Fresh", 1)
+
+	// Once the window passed the list is got again, which starts a new
+	// window.
+	clk.Step(time.Minute)
+	observe("Stale", 2)
+	observe("FreshAgain", 2)
+
+	// A change to the spec invalidates the last observation.
+	cr.SetGeneration(2)
+	observe("SpecChanged", 3)
+
+	// As does writing to the list. Each reconcile uses a new external client,
+	// which hasn't observed the list.
+	e.observed = nil
+	if _, err := e.Update(context.Background(), cr); err != nil {
+		t.Fatalf("e.Update(...): %v", err)
+	}
+	observe("Updated", 4)
+}
+
+func TestObserveFreshDisabled(t *testing.T) {
+	gets := 0
+	e := external{fresh: newFreshObservations(0, testingclock.NewFakeClock(time.Now())), service: &ListService{
+		grpcClient: &fakeListServiceClient{
+			MockGetList: func(_ context.Context, _ *listServicepb.GetListReq, _ ...grpc.CallOption) (*listServicepb.GetListResp, error) {
+				gets++
+				return &listServicepb.GetListResp{Status: statusSuccess, Items: []int32{1}}, nil
+			},
+		},
+	}}
+	cr := grpcKindWith("cool-list", withItems(1))
+	cr.SetUID(types.UID("cool-uid"))
+
+	for i := 0; i < 2; i++ {
+		if _, err := e.Observe(context.Background(), cr); err != nil {
+			t.Fatalf("e.Observe(...): %v", err)
+		}
+	}
+	if gets != 2 {
+		t.Errorf("e.Observe(...): want the list got every reconcile without a freshness window, got %d GetList calls", gets)
+	}
+}
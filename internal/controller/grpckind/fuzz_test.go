@@ -0,0 +1,108 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpckind
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	listServicepb "github.com/ashwinshirva/provider-grpc-server/proto"
+	apisv1alpha1 "github.com/crossplane/provider-grpc/apis/v1alpha1"
+)
+
+// FuzzStatusHandling feeds arbitrary errors and list statuses reported by the
+// backend to Observe and Create, to ensure they never panic and classify lists
+// sanely. Seeds are found in testdata/fuzz/FuzzStatusHandling.
+//
+// A code of 0 (OK) means the backend returned no error, and a code over 16
+// means it returned an error that isn't a gRPC status error.
+func FuzzStatusHandling(f *testing.F) {
+	f.Add(uint32(codes.OK), "", "SUCCESS", true, false)
+	f.Add(uint32(codes.NotFound), "gone", "", false, false)
+	f.Add(uint32(codes.Unknown), "list does not exist", "FAILED", true, false)
+	f.Add(uint32(codes.Unavailable), "backend unavailable", "", false, true)
+
+	f.Fuzz(func(t *testing.T, code uint32, msg, s string, withResp, emptyIsSuccess bool) {
+		var err error
+		switch {
+		case code > uint32(codes.Unauthenticated):
+			err = errors.New(msg)
+		case code != uint32(codes.OK):
+			err = status.Error(codes.Code(code), msg)
+		}
+		var resp *listServicepb.GetListResp
+		if withResp {
+			resp = &listServicepb.GetListResp{Status: s}
+		}
+
+		e := external{pc: apisv1alpha1.ProviderConfigSpec{EmptyStatusIsSuccess: emptyIsSuccess}, service: &ListService{grpcClient: &fakeListServiceClient{
+			MockGetList: func(_ context.Context, _ *listServicepb.GetListReq, _ ...grpc.CallOption) (*listServicepb.GetListResp, error) {
+				return resp, err
+			},
+			MockCreateList: func(_ context.Context, _ *listServicepb.CreateListReq, _ ...grpc.CallOption) (*listServicepb.CreateListResp, error) {
+				if !withResp {
+					return nil, err
+				}
+				return &listServicepb.CreateListResp{Status: s}, err
+			},
+		}}}
+
+		if got := e.listStatus(s); got == "" {
+			t.Errorf("e.listStatus(%q): want a non-empty status", s)
+		}
+		if c := statusCondition(s, metav1.Now()); c.Type != xpv1.TypeReady || (c.Status == corev1.ConditionTrue) != (s == statusSuccess) {
+			t.Errorf("statusCondition(%q): want Ready condition that's only true for %s, got %s %s", s, statusSuccess, c.Type, c.Status)
+		}
+
+		cr := grpcKind("cool-list")
+		o, oerr := e.Observe(context.Background(), cr)
+		switch {
+		case listNotFound(err):
+			if oerr != nil || o.ResourceExists {
+				t.Errorf("e.Observe(...): want a list that's not found to not exist, got exists %t and error %v", o.ResourceExists, oerr)
+			}
+		case err != nil:
+			if oerr == nil {
+				t.Errorf("e.Observe(...): want error %v to be returned", err)
+			}
+		default:
+			if oerr != nil || !o.ResourceExists {
+				t.Errorf("e.Observe(...): want a list that's got to exist, got exists %t and error %v", o.ResourceExists, oerr)
+			}
+		}
+
+		// Lists that already exist are adopted, while lists the backend
+		// reports it failed to create weren't created.
+		failed := (err != nil && status.Code(err) != codes.AlreadyExists) || (withResp && s == statusFailed && code == uint32(codes.OK))
+		cr = grpcKind("cool-list")
+		if _, cerr := e.Create(context.Background(), cr); (cerr != nil) != failed {
+			t.Errorf("e.Create(...): want error %t, got %v", failed, cerr)
+		}
+		if failed && cr.GetCondition(xpv1.TypeSynced).Status == corev1.ConditionTrue {
+			t.Errorf("e.Create(...): want a failed create to not be reported as synced")
+		}
+	})
+}
@@ -17,20 +17,37 @@ limitations under the License.
 package grpckind
 
 import (
+	"bytes"
 	"context"
-	"reflect"
+	"fmt"
 	"strings"
+	"time"
 
 	"github.com/pkg/errors"
 	log "github.com/sirupsen/logrus"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/resolver"
+	"google.golang.org/grpc/status"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/utils/clock"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	ctrlevent "sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+	"sigs.k8s.io/controller-runtime/pkg/source"
 
 	"github.com/crossplane/crossplane-runtime/pkg/connection"
 	"github.com/crossplane/crossplane-runtime/pkg/controller"
 	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
 	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
@@ -43,60 +60,352 @@ import (
 )
 
 const (
-	errNotGrpcKind  = "managed resource is not a GrpcKind custom resource"
-	errTrackPCUsage = "cannot track ProviderConfig usage"
-	errGetPC        = "cannot get ProviderConfig"
-	errGetCreds     = "cannot get credentials"
+	errTrackPCUsage  = "cannot track ProviderConfig usage"
+	errGetPC         = "cannot get ProviderConfig"
+	errGetCreds      = "cannot get credentials"
+	errEmptyCredsFmt = "credentials from source %q are empty"
 
-	errNewClient = "cannot create new Service"
+	errNewClient          = "cannot create new Service"
+	errNewReadClient      = "cannot create new Service for the read endpoint"
+	errNotReady           = "backend is not ready"
+	errNotServingFmt      = "list service health status is %s"
+	errTooManyItemsFmt    = "list has %d items but the ProviderConfig allows at most %d"
+	errCreateFailedFmt    = "backend failed to create list %q"
+	errCreatePendingFmt   = "list %q was still being created after %s"
+	errRegisterMetrics    = "cannot register metrics"
+	errNewClientset       = "cannot create Kubernetes clientset"
+	errAddOrphanCollector = "cannot add orphaned list collector"
 )
 
+// descriptionKey is the request metadata key used to send a list description
+// along with UpdateListItems, whose request has no description field. The key
+// is only present when the description should change; an empty value clears
+// the description on the backend. Backends may also use it to report a list's
+// description with GetList's response header.
+const descriptionKey = "x-list-description"
+
+// idempotencyKey is the request metadata key used to send a key that is stable
+// across retries of the same CreateList, allowing the backend to recognise a
+// list it already created for a managed resource.
+const idempotencyKey = "x-idempotency-key"
+
+// accessKey is the metadata key used to send a list's access level with
+// CreateList and UpdateListItems, and to receive it with GetList's response
+// header. None of the ListService messages have a field for it.
+const accessKey = "x-list-access"
+
+// ownerKey is the metadata key used to send a list's owner with CreateList and
+// UpdateListItems, and to receive it with GetList's response header.
+const ownerKey = "x-list-owner"
+
+// ttlKey is the metadata key used to send how long a list should live, as a Go
+// duration string, with CreateList and UpdateListItems, and to receive it with
+// GetList's response header.
+const ttlKey = "x-list-ttl"
+
+// Response header metadata keys a backend may use to report when a list was
+// created and last updated, as RFC 3339 timestamps. GetListResp has no fields
+// for them.
+const (
+	createdAtKey = "x-list-created-at"
+	updatedAtKey = "x-list-updated-at"
+)
+
+// List statuses reported by the backend.
+const (
+	statusSuccess  = "SUCCESS"
+	statusDeleted  = "DELETED"
+	statusDeleting = "DELETING"
+
+	// statusPending and statusFailed are reported by CreateList for lists
+	// the backend is still creating, and failed to create.
+	statusPending = "PENDING"
+	statusFailed  = "FAILED"
+
+	// statusUnknown is recorded for lists the backend reports without a
+	// status.
+	statusUnknown = "UNKNOWN"
+)
+
+// Condition reasons used by the GrpcKind controller.
+const (
+	// reasonUpdating indicates a list's items are being updated on the
+	// backend.
+	reasonUpdating xpv1.ConditionReason = "Updating"
+
+	// reasonUnknownStatus indicates the backend reported a list status the
+	// controller doesn't know how to interpret.
+	reasonUnknownStatus xpv1.ConditionReason = "UnknownStatus"
+
+	// reasonCreateFailed indicates the backend reported it failed to create
+	// a list.
+	reasonCreateFailed xpv1.ConditionReason = "CreateFailed"
+
+	// reasonNotReadyStatus indicates the backend reported a list status the
+	// ProviderConfig doesn't consider ready.
+	reasonNotReadyStatus xpv1.ConditionReason = "NotReadyStatus"
+)
+
+// ErrNotGrpcKind is returned when a managed resource passed to the GrpcKind
+// controller is not a GrpcKind.
+var ErrNotGrpcKind = errors.New("managed resource is not a GrpcKind custom resource")
+
 // A ListService does nothing.
 type ListService struct {
-	grpcClient listServicepb.ListServiceClient
+	grpcClient   listServicepb.ListServiceClient
+	healthClient healthpb.HealthClient
+	bulkClient   bulkUpdateClient
+	batchClient  batchUpdateClient
+	deleteClient deleteListStreamClient
+	listsClient  listListsClient
+	watchClient  watchListClient
+
+	// conn is the connection the clients use.
+	conn grpc.ClientConnInterface
+
+	// readClient is used to get lists while observing them, if set.
+	readClient listServicepb.ListServiceClient
+}
+
+// reader returns the client used to get lists while observing them.
+func (s *ListService) reader() listServicepb.ListServiceClient {
+	if s.readClient != nil {
+		return s.readClient
+	}
+	return s.grpcClient
+}
+
+// checkReady returns an error unless the backend's health service reports the
+// list service as serving.
+func (s *ListService) checkReady(ctx context.Context) error {
+	resp, err := s.healthClient.Check(ctx, &healthpb.HealthCheckRequest{
+		Service: listServicepb.ListService_ServiceDesc.ServiceName,
+	})
+	if err != nil {
+		return err
+	}
+	if resp.GetStatus() != healthpb.HealthCheckResponse_SERVING {
+		return errors.Errorf(errNotServingFmt, resp.GetStatus())
+	}
+	return nil
 }
 
+// Address is the address of the backend used for ProviderConfigs that don't
+// describe one.
 var Address = ":50050"
 
-var (
-	newListService = func(creds []byte) (*ListService, error) {
-		conn, err := grpc.Dial(Address, grpc.WithInsecure(), grpc.WithBlock())
+// SetDefaultAddress sets the address of the backend used for ProviderConfigs
+// that don't describe one. An empty address leaves the default unchanged.
+func SetDefaultAddress(addr string) {
+	if addr != "" {
+		Address = addr
+	}
+}
 
+// IdleTimeout is how long a connection to a backend may go unused before it is
+// closed. A zero timeout keeps connections open indefinitely.
+var IdleTimeout = 10 * time.Minute
+
+// createWaitInterval is how often Create polls the backend while waiting for
+// a pending list to be created, if the ProviderConfig has a CreateWaitTimeout.
+var createWaitInterval = time.Second
+
+// RequireTLS makes Connect fail for ProviderConfigs that don't configure TLS,
+// rather than connecting to their backend insecurely.
+var RequireTLS = false
+
+// dialTimeout bounds how long dialing a backend may block, so that an
+// unreachable backend fails its reconciles rather than hanging them.
+var dialTimeout = 30 * time.Second
+
+var (
+	dialListService = func(target string, t transport, extra ...grpc.DialOption) (*grpc.ClientConn, error) {
+		opts, err := t.dialOptions()
 		if err != nil {
-			log.Fatalf("did not connect : %v", err)
+			return nil, err
 		}
+		opts = append(opts, extra...)
+		opts = append(opts, injected()...)
+		ctx, cancel := context.WithTimeout(context.Background(), dialTimeout)
+		defer cancel()
+		return grpc.DialContext(ctx, target, append(opts, grpc.WithBlock())...)
+	}
+)
 
-		//defer conn.Close()
+// newListService returns a function that creates a ListService using a cached
+// connection to the backend.
+func newListService(conns *connCache) func(target string, creds []byte, t transport) (*ListService, error) {
+	return func(target string, creds []byte, t transport) (*ListService, error) {
+		conn, err := conns.Get(target, t)
+		if err != nil {
+			return nil, err
+		}
+		return NewListService(conn), nil
+	}
+}
 
-		c := listServicepb.NewListServiceClient(conn)
-		return &ListService{grpcClient: c}, nil
+// NewListService returns a ListService that calls the backend using the
+// supplied connection.
+func NewListService(conn grpc.ClientConnInterface) *ListService {
+	return &ListService{
+		grpcClient:   listServicepb.NewListServiceClient(conn),
+		healthClient: healthpb.NewHealthClient(conn),
+		bulkClient:   newBulkUpdateClient(conn),
+		batchClient:  newBatchUpdateClient(conn),
+		deleteClient: newDeleteListStreamClient(conn),
+		listsClient:  newListListsClient(conn),
+		watchClient:  newWatchListClient(conn),
+		conn:         conn,
 	}
-)
+}
 
 // Setup adds a controller that reconciles GrpcKind managed resources.
 func Setup(mgr ctrl.Manager, o controller.Options) error {
-	name := managed.ControllerName(v1alpha1.GrpcKindGroupKind)
+	return SetupWithOptions(mgr, o, SetupOptions{})
+}
+
+// SetupWithOptions adds a controller that reconciles GrpcKind managed
+// resources, customized by the supplied SetupOptions.
+func SetupWithOptions(mgr ctrl.Manager, o controller.Options, so SetupOptions) error {
+	// The controller reconciles either cluster scoped GrpcKinds or namespace
+	// scoped NamespacedGrpcKinds, which are reconciled as if they were
+	// GrpcKinds.
+	gk, gvk, obj := v1alpha1.GrpcKindGroupKind, v1alpha1.GrpcKindGroupVersionKind, client.Object(&v1alpha1.GrpcKind{})
+	if Namespaced {
+		gk, gvk, obj = v1alpha1.NamespacedGrpcKindGroupKind, v1alpha1.NamespacedGrpcKindGroupVersionKind, &v1alpha1.NamespacedGrpcKind{}
+	}
+	name := managed.ControllerName(gk)
+
+	if err := registerMetrics(metrics.Registry); err != nil {
+		return errors.Wrap(err, errRegisterMetrics)
+	}
 
 	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
 	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
 		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), apisv1alpha1.StoreConfigGroupVersionKind))
 	}
 
+	cs, err := kubernetes.NewForConfig(mgr.GetConfig())
+	if err != nil {
+		return errors.Wrap(err, errNewClientset)
+	}
+
+	clk := clock.RealClock{}
+
+	// Slow down all GrpcKind reconciles while the backend reports that its
+	// resources are exhausted.
+	bp := newBackpressureLimiter(o.GlobalRateLimiter, clk)
+
+	// Stop calling backend endpoints that repeatedly fail.
+	cb := newCircuitBreakers(BreakerThreshold, BreakerCooldown, clk)
+
+	// Reconcile GrpcKinds whose spec stopped changing, so that their debounced
+	// updates are applied.
+	recorder := event.NewAPIRecorder(mgr.GetEventRecorderFor(name))
+
+	// Record each step of reconciles as events, for demos and debugging.
+	var traces *traceRecorder
+	if TraceEvents {
+		traces = newTraceRecorder(recorder, TraceEventInterval, clk)
+	}
+
+	// Observe lists using the state their backend pushes, if it can.
+	var watches *listWatches
+	if WatchLists {
+		watches = newListWatches()
+	}
+
+	settled := make(chan ctrlevent.GenericEvent)
+	db := newDebouncer(UpdateDebounce, clk, func(cr *v1alpha1.GrpcKind) {
+		settled <- ctrlevent.GenericEvent{Object: cr}
+	})
+
+	// Reconcile GrpcKinds whose update ran out of time again, so that their
+	// update is resumed.
+	requeue := func(cr *v1alpha1.GrpcKind) {
+		go func() { settled <- ctrlevent.GenericEvent{Object: cr} }()
+	}
+
+	dialOpts := append([]grpc.DialOption{
+		grpc.WithChainUnaryInterceptor(bp.intercept, cb.intercept),
+		grpc.WithChainStreamInterceptor(bp.interceptStream, cb.interceptStream),
+	}, traceDialOptions()...)
+
+	conn := &connector{
+		kube:         mgr.GetClient(),
+		usage:        resource.NewProviderConfigUsageTracker(mgr.GetClient(), &apisv1alpha1.ProviderConfigUsage{}),
+		newServiceFn: so.serviceFn(clk, dialOpts...),
+		endpoint:     so.Endpoint,
+		resolvers:    so.Resolvers,
+		breakers:     cb,
+		tokens:       newServiceAccountTokens(clientsetMinter(cs), clk),
+		clientCerts:  newClientCertificates(),
+		requireTLS:   RequireTLS,
+		applied:      newAppliedCache(updateDedupWindow, clk),
+		debouncer:    db,
+		chunks:       newChunkTracker(),
+		history:      newItemHistory(),
+		failures:     newItemFailures(),
+		traces:       traces,
+		watches:      watches,
+		batcher:      newUpdateBatcher(BatchWindow, clk),
+		fresh:        newFreshObservations(ObserveFreshness, clk),
+		maxDuration:  MaxReconcileDuration,
+		requeue:      requeue,
+		clock:        clk,
+	}
+	// Dial the configured endpoint before the first GrpcKind is reconciled.
+	if WarmUpConnections {
+		warmUp(conn.newServiceFn, so.warmUpTargets()...)
+	}
+
+	var ec managed.ExternalConnecter = conn
+	if Namespaced {
+		ec = namespacedConnector{ExternalConnecter: ec}
+	}
+
+	if OrphanCleanupInterval > 0 {
+		if err := mgr.Add(&orphanCollector{
+			kube: mgr.GetClient(),
+			connect: func(ctx context.Context, pc *apisv1alpha1.ProviderConfig) (*ListService, error) {
+				svc, _, _, err := conn.connectBackend(ctx, pc)
+				return svc, err
+			},
+			interval:   OrphanCleanupInterval,
+			grace:      OrphanGracePeriod,
+			dryRun:     OrphanCleanupDryRun,
+			clock:      clk,
+			namespaced: Namespaced,
+			orphaned:   map[string]time.Time{},
+		}); err != nil {
+			return errors.Wrap(err, errAddOrphanCollector)
+		}
+	}
+
 	r := managed.NewReconciler(mgr,
-		resource.ManagedKind(v1alpha1.GrpcKindGroupVersionKind),
-		managed.WithExternalConnecter(&connector{
-			kube:         mgr.GetClient(),
-			usage:        resource.NewProviderConfigUsageTracker(mgr.GetClient(), &apisv1alpha1.ProviderConfigUsage{}),
-			newServiceFn: newListService}),
+		resource.ManagedKind(gvk),
+		managed.WithExternalConnecter(ec),
+		managed.WithCriticalAnnotationUpdater(newCriticalAnnotationUpdater(mgr.GetClient())),
+		managed.WithInitializers(managed.NewNameAsExternalName(mgr.GetClient()), &deletionPolicyDefaulter{kube: mgr.GetClient()}),
 		managed.WithLogger(o.Logger.WithValues("controller", name)),
-		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithRecorder(recorder),
 		managed.WithConnectionPublishers(cps...))
 
+	// Defer retries while too many GrpcKinds are retrying at once.
+	co := o.ForControllerRuntime()
+	co.RateLimiter = newRetryBudget(RetryBudget, RetryBudgetDelay, co.RateLimiter)
+
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
-		WithOptions(o.ForControllerRuntime()).
-		For(&v1alpha1.GrpcKind{}).
-		Complete(ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter))
+		WithOptions(co).
+		For(obj).
+		Watches(&source.Channel{Source: settled}, &handler.EnqueueRequestForObject{}).
+		Complete(ratelimiter.NewReconciler(name, &pausingReconciler{
+			Reconciler: r,
+			kube:       mgr.GetClient(),
+			newManaged: func() resource.Managed { return obj.DeepCopyObject().(resource.Managed) },
+			clock:      clk,
+		}, bp))
 }
 
 // A connector is expected to produce an ExternalClient when its Connect method
@@ -104,22 +413,45 @@ func Setup(mgr ctrl.Manager, o controller.Options) error {
 type connector struct {
 	kube         client.Client
 	usage        resource.Tracker
-	newServiceFn func(creds []byte) (*ListService, error)
+	newServiceFn func(target string, creds []byte, t transport) (*ListService, error)
+	endpoint     string
+	resolvers    []resolver.Builder
+	breakers     *circuitBreakers
+	tokens       *serviceAccountTokens
+	clientCerts  *clientCertificates
+	requireTLS   bool
+	applied      *appliedCache
+	debouncer    *debouncer
+	chunks       *chunkTracker
+	history      *itemHistory
+	failures     *itemFailures
+	traces       *traceRecorder
+	watches      *listWatches
+	batcher      *updateBatcher
+	fresh        *freshObservations
+	maxDuration  time.Duration
+	requeue      func(cr *v1alpha1.GrpcKind)
+	clock        clock.Clock
 }
 
 // Connect typically produces an ExternalClient by:
 // 1. Tracking that the managed resource is using a ProviderConfig.
 // 2. Getting the managed resource's ProviderConfig.
 // 3. Getting the credentials specified by the ProviderConfig.
-// 4. Using the credentials to form a client.
+// 4. Using the credentials and TLS settings to form a client, unless the
+// backend has been failing repeatedly.
+// 5. Optionally forming a client for a read endpoint.
+// 6. Optionally discovering the list service using server reflection.
+// 7. Bounding how long each call to the list service may take.
+// 8. Optionally checking that the backend is ready to serve requests.
 func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
 	cr, ok := mg.(*v1alpha1.GrpcKind)
 	if !ok {
-		return nil, errors.New(errNotGrpcKind)
+		return nil, ErrNotGrpcKind
 	}
 
-	if err := c.usage.Track(ctx, mg); err != nil {
-		return nil, errors.Wrap(err, errTrackPCUsage)
+	if err := trackUsage(ctx, c.usage, mg); err != nil {
+		return nil, err
 	}
 
 	pc := &apisv1alpha1.ProviderConfig{}
@@ -127,18 +459,179 @@ func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.E
 		return nil, errors.Wrap(err, errGetPC)
 	}
 
-	cd := pc.Spec.Credentials
-	data, err := resource.CommonCredentialExtractor(ctx, cd.Source, c.kube, cd.CommonCredentialSelectors)
+	svc, data, t, err := c.connectBackend(ctx, pc)
 	if err != nil {
-		return nil, errors.Wrap(err, errGetCreds)
+		return nil, err
+	}
+
+	var rsvc *ListService
+	if ep := pc.Spec.ReadEndpoint; ep != nil {
+		target, err := resolverTarget(pc.Spec, *ep, c.resolvers)
+		if err != nil {
+			return nil, err
+		}
+		if err := validateEndpoint(target, c.resolvers); err != nil {
+			return nil, err
+		}
+		if rsvc, err = c.newServiceFn(target, data, t); err != nil {
+			return nil, errors.Wrap(err, errNewReadClient)
+		}
 	}
 
-	svc, err := c.newServiceFn(data)
+	timeout, err := callTimeout(cr, pc.Spec)
 	if err != nil {
-		return nil, errors.Wrap(err, errNewClient)
+		return nil, err
+	}
+
+	attempts := &rpcAttempts{clock: c.clock}
+	warnings := &rpcWarnings{}
+	for _, s := range []*ListService{svc, rsvc} {
+		if s == nil {
+			continue
+		}
+		if pc.Spec.Reflection {
+			if err := s.useReflection(ctx); err != nil {
+				return nil, err
+			}
+		}
+		if fm := pc.Spec.FieldMapping; fm != nil {
+			s.grpcClient = &fieldMappingListService{ListServiceClient: s.grpcClient, mapping: fm}
+		}
+		if timeout > 0 {
+			s.grpcClient = &timeoutListService{ListServiceClient: s.grpcClient, timeout: timeout}
+		}
+		s.grpcClient = &warningListService{ListServiceClient: s.grpcClient, warnings: warnings}
+		s.grpcClient = &recordingListService{ListServiceClient: s.grpcClient, attempts: attempts}
+		if s.conn != nil {
+			cc := &callConn{ClientConnInterface: s.conn, mapping: pc.Spec.FieldMapping, timeout: timeout, warnings: warnings, attempts: attempts}
+			s.bulkClient = newBulkUpdateClient(cc)
+			s.deleteClient = newDeleteListStreamClient(cc)
+			// Watches outlive the reconcile that starts them, so they're
+			// neither bounded by the call timeout nor recorded.
+			s.watchClient = newWatchListClient(&callConn{ClientConnInterface: s.conn, mapping: pc.Spec.FieldMapping})
+		}
+	}
+	if rsvc != nil {
+		svc.readClient = rsvc.grpcClient
+		svc.watchClient = rsvc.watchClient
+	}
+
+	if pc.Spec.ReadinessCheck {
+		if err := svc.checkReady(ctx); err != nil {
+			return nil, errors.Wrap(err, errNotReady)
+		}
 	}
 
-	return &external{service: svc}, nil
+	e := &external{service: svc, kube: c.kube, pc: pc.Spec, applied: c.applied, debouncer: c.debouncer, chunks: c.chunks, history: c.history, failures: c.failures, traces: c.traces, watches: c.watches, batcher: c.batcher, fresh: c.fresh, attempts: attempts, warnings: warnings, timeout: timeout, requeue: c.requeue, clock: c.clock}
+	if c.maxDuration > 0 {
+		e.deadline = e.now().Add(c.maxDuration)
+	}
+	if pc.Spec.CallbackURL != nil {
+		return &callbackExternal{ExternalClient: e, url: *pc.Spec.CallbackURL, client: callbackClient, clock: c.clock}, nil
+	}
+	return e, nil
+}
+
+// connectBackend returns a ListService that calls the backend described by the
+// supplied ProviderConfig, unless the backend has been failing repeatedly. It
+// also returns the credentials and transport it used, so that other endpoints
+// may be connected to the same way.
+func (c *connector) connectBackend(ctx context.Context, pc *apisv1alpha1.ProviderConfig) (*ListService, []byte, transport, error) {
+	target, err := resolverTarget(pc.Spec, dialTarget(pc.Spec, c.endpoint), c.resolvers)
+	if err != nil {
+		return nil, nil, transport{}, err
+	}
+	if err := validateEndpoint(target, c.resolvers); err != nil {
+		return nil, nil, transport{}, err
+	}
+
+	data, err := extractCredentials(ctx, c.kube, pc.Spec)
+	if err != nil {
+		return nil, nil, transport{}, errors.Wrap(err, errGetCreds)
+	}
+
+	bc, err := parseCredentials(data)
+	if err != nil {
+		return nil, nil, transport{}, errors.Wrap(err, errGetCreds)
+	}
+
+	t, err := getTransport(ctx, c.kube, pc.Spec)
+	if err != nil {
+		return nil, nil, transport{}, err
+	}
+	if t, err = bc.apply(t); err != nil {
+		return nil, nil, transport{}, errors.Wrap(err, errGetCreds)
+	}
+	if sa := pc.Spec.ServiceAccountToken; sa != nil {
+		if !t.tls {
+			return nil, nil, transport{}, errors.New(errTokenRequiresTLS)
+		}
+		t.saToken = c.tokens.Get(sa)
+	}
+	if c.requireTLS && !t.tls {
+		return nil, nil, transport{}, errors.New(errTLSRequired)
+	}
+	if c.clientCerts != nil && t.clientCert != "" {
+		cc, err := c.clientCerts.Get(pc.GetName(), credentialsCertLoader(c.kube, pc.Spec), t.clientCert, t.clientKey)
+		if err != nil {
+			return nil, nil, transport{}, errors.Wrap(err, errNewClient)
+		}
+		// The connection is cached by the rotating certificate rather than
+		// the current one, so it isn't dialed again when it rotates.
+		t.clientCertificate, t.clientCert, t.clientKey = cc, "", ""
+	}
+
+	// Calls made using the connection probe a half-open breaker, not dialing
+	// it, which succeeds for cached connections.
+	if err := c.breakers.Check(target); err != nil {
+		return nil, nil, transport{}, err
+	}
+	svc, err := c.newServiceFn(target, data, t)
+	if err != nil {
+		c.breakers.Failed(target)
+		return nil, nil, transport{}, errors.Wrap(err, errNewClient)
+	}
+	return svc, data, t, nil
+}
+
+// dialTarget returns the address of the backend described by the supplied
+// ProviderConfig, or the supplied endpoint if it doesn't describe one. The
+// default Address is used if neither is set.
+func dialTarget(spec apisv1alpha1.ProviderConfigSpec, endpoint string) string {
+	switch {
+	case spec.ServiceRef != nil:
+		ref := spec.ServiceRef
+		return fmt.Sprintf("%s.%s.svc:%d", ref.Name, ref.Namespace, ref.Port)
+	case spec.Endpoint != nil:
+		return *spec.Endpoint
+	case endpoint != "":
+		return endpoint
+	}
+	return Address
+}
+
+// extractCredentials tries the ProviderConfig's primary credentials and then
+// each of its fallback credentials in order, returning the first that can be
+// extracted. Credentials that are empty can't be extracted, unless they have
+// no source. The error of the last attempt is returned if none succeed.
+func extractCredentials(ctx context.Context, kube client.Client, spec apisv1alpha1.ProviderConfigSpec) ([]byte, error) {
+	var err error
+	for i, cd := range append([]apisv1alpha1.ProviderCredentials{spec.Credentials}, spec.FallbackCredentials...) {
+		var data []byte
+		data, err = resource.CommonCredentialExtractor(ctx, cd.Source, kube, cd.CommonCredentialSelectors)
+		// Some sources return no data rather than an error, e.g. for a
+		// secret key that doesn't exist. Only the None source has no data.
+		if err == nil && cd.Source != xpv1.CredentialsSourceNone && len(bytes.TrimSpace(data)) == 0 {
+			err = errors.Errorf(errEmptyCredsFmt, cd.Source)
+		}
+		if err != nil {
+			log.Infof("Connect::Cannot get credentials %d from source %q: %v", i, cd.Source, err)
+			continue
+		}
+		log.Infof("Connect::Using credentials %d from source %q", i, cd.Source)
+		return data, nil
+	}
+	return nil, err
 }
 
 // An ExternalClient observes, then either creates, updates, or deletes an
@@ -147,23 +640,192 @@ type external struct {
 	// A 'client' used to connect to the external resource API. In practice this
 	// would be something like an AWS SDK client.
 	service *ListService
+
+	// kube is used to export lists to ConfigMaps.
+	kube client.Client
+
+	// pc is the spec of the ProviderConfig the managed resource uses.
+	pc apisv1alpha1.ProviderConfigSpec
+
+	// applied remembers recently applied updates, so identical updates can
+	// be skipped.
+	applied *appliedCache
+
+	// debouncer delays updates until the spec stopped changing.
+	debouncer *debouncer
+
+	// chunks remembers the progress of chunked updates that didn't complete.
+	chunks *chunkTracker
+
+	// history remembers the items each list had when it was last observed.
+	history *itemHistory
+
+	// failures remembers the items of each list the backend failed to apply.
+	failures *itemFailures
+
+	// traces records each step of the reconcile as an event, if enabled.
+	traces *traceRecorder
+
+	// watches caches the state of lists their backend pushed, if enabled.
+	watches *listWatches
+
+	// batcher applies updates of lists that share a backend together, if
+	// enabled.
+	batcher *updateBatcher
+
+	// fresh remembers lists recently observed up to date, if enabled.
+	fresh *freshObservations
+
+	// attempts records the calls made to the backend, so that they can be
+	// recorded in the managed resource's RPC history.
+	attempts *rpcAttempts
+
+	// warnings records the warnings the backend reported, so that they can
+	// be surfaced as a condition of the managed resource.
+	warnings *rpcWarnings
+
+	// versionChecked is true once the backend's version has been checked.
+	versionChecked bool
+
+	// deadline is when the reconcile runs out of time to apply chunks of an
+	// update, if it's bounded.
+	deadline time.Time
+
+	// timeout bounds how long each call to the backend may take, if it's
+	// positive. It bounds waiting for calls the clients of service don't
+	// make themselves, e.g. batched updates.
+	timeout time.Duration
+
+	// requeue reconciles the managed resource again, e.g. to resume its
+	// update.
+	requeue func(cr *v1alpha1.GrpcKind)
+
+	// clock tells the time conditions transition at. The real clock is used
+	// if it's nil.
+	clock clock.PassiveClock
+
+	// observed is the state of the list observed during this reconcile, if
+	// any. It saves Update from getting the list again.
+	observed *observedList
+}
+
+// checkListSize returns an error if the GrpcKind has more list items than the
+// supplied ProviderConfig allows.
+func checkListSize(spec apisv1alpha1.ProviderConfigSpec, cr *v1alpha1.GrpcKind) error {
+	n := len(cr.Spec.ForProvider.ListItems) + len(cr.Spec.ForProvider.StructuredListItems)
+	if max := spec.MaxListItems; max != nil && n > int(*max) {
+		return errors.Errorf(errTooManyItemsFmt, n, *max)
+	}
+	return nil
+}
+
+// itemTolerance returns how much items may differ from the desired items
+// before a list drifts, which is 0 (exact) unless the ProviderConfig
+// specifies otherwise.
+func itemTolerance(spec apisv1alpha1.ProviderConfigSpec) int32 {
+	if t := spec.ItemTolerance; t != nil {
+		return *t
+	}
+	return 0
+}
+
+// listStatus returns the status of a list reported by the backend. An empty
+// status is unknown, unless the ProviderConfig treats it as success.
+func (c *external) listStatus(s string) string {
+	switch {
+	case s != "":
+		return s
+	case c.pc.EmptyStatusIsSuccess:
+		return statusSuccess
+	default:
+		return statusUnknown
+	}
 }
 
 func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
 	// Check if the managed resource is of expected kind
 	cr, ok := mg.(*v1alpha1.GrpcKind)
 	if !ok {
-		return managed.ExternalObservation{}, errors.New(errNotGrpcKind)
+		return managed.ExternalObservation{}, ErrNotGrpcKind
 	}
+	defer guardExternalName(cr)()
+	defer c.recordRPCHistory(cr)
+	defer c.recordWarnings(cr)
+	ctx = withTenant(ctx, cr)
+	ctx, span := startSpan(ctx, "Observe")
+	defer span.End()
 
-	log.Infof("Observe::Observing: \"%+v\"...", cr.Spec.ForProvider.Name)
+	log.Infof("Observe::Observing: \"%+v\"...", logName(cr.Spec.ForProvider.Name))
+
+	// No list was created for a GrpcKind without a name, so there's none to
+	// delete.
+	named, err := c.defaultName(cr)
+	if err != nil && meta.WasDeleted(cr) {
+		return managed.ExternalObservation{
+			ResourceExists:    false,
+			ResourceUpToDate:  true,
+			ConnectionDetails: managed.ConnectionDetails{},
+		}, nil
+	}
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+
+	// There's no need to get a list that is being deleted, unless the
+	// backend was still deleting it. Delete records that the list is being
+	// deleted once the backend accepted its deletion, or that it was deleted
+	// when using the Async delete policy. Until then Delete is called, which
+	// treats a list that is already gone as deleted.
+	if meta.WasDeleted(cr) {
+		if cr.Status.AtProvider.Status == statusDeleting {
+			return c.observeDeleting(ctx, cr)
+		}
+		deleted := cr.Status.AtProvider.Status == statusDeleted
+		log.Infof("Observe::List \"%v\" is being deleted. Deleted: %t", logName(cr.Spec.ForProvider.Name), deleted)
+		return managed.ExternalObservation{
+			ResourceExists:    !deleted,
+			ResourceUpToDate:  true,
+			ConnectionDetails: managed.ConnectionDetails{},
+		}, nil
+	}
+
+	restore, err := c.resolveListItems(ctx, cr)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	defer restore()
+
+	// A list that was recently observed up to date is assumed to still be,
+	// as long as the spec hasn't changed since.
+	if !named && c.fresh.Fresh(cr) {
+		log.Infof("Observe::Resource \"%v\" was recently observed up to date. No op...", logName(cr.Spec.ForProvider.Name))
+		observeOutcomes.WithLabelValues(outcomeUpToDate).Inc()
+		return managed.ExternalObservation{
+			ResourceExists:    true,
+			ResourceUpToDate:  true,
+			ConnectionDetails: managed.ConnectionDetails{},
+		}, nil
+	}
 
 	// Check if external resource exists
 	// If managed resource exists and external resource does not exist then mark ResourceExists: false
 	// so that crossplane calls the Create() method for that resource
-	resp, getErr := c.service.grpcClient.GetList(ctx, &listServicepb.GetListReq{Name: cr.Spec.ForProvider.Name})
-	if getErr != nil && strings.Contains(getErr.Error(), "does not exist") {
+	var compression string
+	resp, header, watched := c.watches.Get(cr.GetUID(), cr.Spec.ForProvider.Name)
+	var getErr error
+	if !watched {
+		getErr = retryTransient(ctx, func() (err error) {
+			resp, err = c.service.reader().GetList(withCompressionReport(c.withChecksum(ctx, cr), &compression), &listServicepb.GetListReq{Name: cr.Spec.ForProvider.Name}, grpc.Header(&header))
+			return err
+		})
+	}
+	if getErr == nil && !watched {
+		c.watches.Start(ctx, cr.GetUID(), cr.Spec.ForProvider.Name, c.service.watchClient)
+	}
+	if listNotFound(getErr) {
 		log.Error("Observe::External resource does not exist: ", getErr)
+		observeOutcomes.WithLabelValues(outcomeNotFound).Inc()
+		c.traces.Trace(cr, reasonTraceNotFound, "List %q does not exist", logName(cr.Spec.ForProvider.Name))
 		return managed.ExternalObservation{
 			ResourceExists:    false,
 			ResourceUpToDate:  true,
@@ -171,57 +833,242 @@ func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 		}, nil
 	}
 
+	// Some backends return a partial response along with an error. The
+	// response can't be trusted, so the error is returned before the
+	// response is observed.
+	if getErr != nil {
+		log.Errorf("Observe::Cannot get list \"%v\": %v", logName(cr.Spec.ForProvider.Name), getErr)
+		return managed.ExternalObservation{
+			ResourceExists:    true,
+			ResourceUpToDate:  true,
+			ConnectionDetails: managed.ConnectionDetails{},
+		}, getErr
+	}
+
+	var obs *observedList
+	if resp != nil {
+		obs = fromGetResp(resp, header)
+		c.compareChecksum(cr, obs)
+	}
+
 	// If the Get()rpc returns status=SUCCESS it means external resource is created and is in ready state
-	// So mark the CR status as AVAILABLE
-	if resp != nil && resp.Status == "SUCCESS" {
-		cr.Status.SetConditions(xpv1.Available())
+	// So mark the CR status as AVAILABLE. Any other status is surfaced as is.
+	adopted := false
+	if obs != nil {
+		c.observed = obs
+		adopted = adopt(cr)
+		c.recordItemChanges(cr, obs.items)
+		c.checkBackendVersion(cr, header)
+		recordQuota(cr, header)
+		recordDescription(cr, header)
+		if err := c.exportItems(ctx, cr, obs.items); err != nil {
+			return managed.ExternalObservation{}, err
+		}
+		cr.Status.AtProvider.Status = c.listStatus(obs.status)
+		cr.Status.AtProvider.CreatedAt = obs.createdAt
+		cr.Status.AtProvider.UpdatedAt = obs.updatedAt
+		if compression != "" && compression != cr.Status.AtProvider.Compression {
+			log.Infof("Observe::Backend responded with list \"%v\" using %s compression", logName(cr.Spec.ForProvider.Name), compression)
+			cr.Status.AtProvider.Compression = compression
+		}
+		cr.Status.SetConditions(c.readyCondition(cr.Status.AtProvider.Status))
+		c.summarize(cr, obs)
 	}
 
-	// Check if the list has changed
-	// If the list has changed return appropriate values in ExternalObservation so that crossplane update method for this resource
-	if resp != nil && !reflect.DeepEqual(resp.Items, cr.Spec.ForProvider.ListItems) {
-		log.Infof("Observe::Resource \"%v\" outdated. Updating resource...", cr.Spec.ForProvider.Name)
+	// A list the backend is deleting can't be updated. It's reported to
+	// exist until the backend no longer reports it.
+	if obs != nil && cr.Status.AtProvider.Status == statusDeleting {
+		log.Infof("Observe::List \"%v\" is being deleted by the backend", logName(cr.Spec.ForProvider.Name))
+		observeOutcomes.WithLabelValues(outcomeDeleting).Inc()
 		return managed.ExternalObservation{
 			ResourceExists:    true,
-			ResourceUpToDate:  false,
+			ResourceUpToDate:  true,
 			ConnectionDetails: managed.ConnectionDetails{},
 		}, nil
 	}
 
-	log.Infof("Observe::Resource \"%v\" up to date. No op...", cr.Spec.ForProvider.Name)
+	// Check if the list has changed
+	// If the list has changed return appropriate values in ExternalObservation so that crossplane update method for this resource
+	itemsChanged := obs != nil && c.itemsChanged(cr, obs)
+	accessChanged := obs != nil && accessDrifted(cr, obs.header)
+	ttlChanged := obs != nil && ttlDrifted(cr, obs.header)
+	ownerChanged := obs != nil && ownerDrifted(cr, obs.header)
+	itemMetadataChanged := obs != nil && itemMetadataDrifted(cr, obs.header)
+	structuredItemsChanged := obs != nil && structuredItemsDrifted(cr, obs.header)
+	if obs != nil && c.drifted(cr, obs) {
+		log.Infof("Observe::Resource \"%v\" outdated (%s). Updating resource...", logName(cr.Spec.ForProvider.Name), c.driftSummary(cr, obs.items, obs.header))
+		outcome := outcomeDriftDescription
+		switch {
+		case itemsChanged, structuredItemsChanged:
+			outcome = outcomeDriftItems
+		case accessChanged:
+			outcome = outcomeDriftAccess
+		case ttlChanged:
+			outcome = outcomeDriftTTL
+		case ownerChanged:
+			outcome = outcomeDriftOwner
+		case itemMetadataChanged:
+			outcome = outcomeDriftItemMetadata
+		}
+		observeOutcomes.WithLabelValues(outcome).Inc()
+		c.traces.Trace(cr, reasonTraceDrift, "List %q drifted: %s", logName(cr.Spec.ForProvider.Name), c.driftSummary(cr, obs.items, obs.header))
+		return managed.ExternalObservation{
+			ResourceExists:          true,
+			ResourceUpToDate:        false,
+			ResourceLateInitialized: adopted || named,
+			ConnectionDetails:       managed.ConnectionDetails{},
+		}, nil
+	}
+
+	log.Infof("Observe::Resource \"%v\" up to date. No op...", logName(cr.Spec.ForProvider.Name))
+	observeOutcomes.WithLabelValues(outcomeUpToDate).Inc()
+	if obs != nil && readyStatus(c.pc, cr.Status.AtProvider.Status) {
+		c.fresh.Observed(cr)
+	}
+	c.traces.Trace(cr, reasonTraceUpToDate, "List %q is up to date", logName(cr.Spec.ForProvider.Name))
+
+	return managed.ExternalObservation{
+		ResourceExists:          true,
+		ResourceUpToDate:        true,
+		ResourceLateInitialized: adopted || named,
+		ConnectionDetails:       managed.ConnectionDetails{},
+	}, nil
+}
+
+// drifted returns true if the supplied observed list differs from the
+// GrpcKind's desired list in any way an update would change.
+func (c *external) drifted(cr *v1alpha1.GrpcKind, obs *observedList) bool {
+	return c.itemsChanged(cr, obs) ||
+		c.descriptionDrifted(cr) ||
+		accessDrifted(cr, obs.header) ||
+		ttlDrifted(cr, obs.header) ||
+		ownerDrifted(cr, obs.header) ||
+		itemMetadataDrifted(cr, obs.header) ||
+		structuredItemsDrifted(cr, obs.header)
+}
+
+// observeDeleting observes a list the backend was still deleting when the
+// managed resource's deletion was last observed. The list exists until the
+// backend no longer reports it.
+func (c *external) observeDeleting(ctx context.Context, cr *v1alpha1.GrpcKind) (managed.ExternalObservation, error) {
+	resp, err := c.service.reader().GetList(ctx, &listServicepb.GetListReq{Name: cr.Spec.ForProvider.Name})
+	if listNotFound(err) {
+		log.Infof("Observe::List \"%v\" was deleted", logName(cr.Spec.ForProvider.Name))
+		cr.Status.AtProvider.Status = statusDeleted
+		return managed.ExternalObservation{
+			ResourceExists:    false,
+			ResourceUpToDate:  true,
+			ConnectionDetails: managed.ConnectionDetails{},
+		}, nil
+	}
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
 
+	cr.Status.AtProvider.Status = c.listStatus(resp.GetStatus())
+	cr.Status.SetConditions(c.readyCondition(cr.Status.AtProvider.Status))
+	log.Infof("Observe::List \"%v\" is being deleted. Status: %v", logName(cr.Spec.ForProvider.Name), cr.Status.AtProvider.Status)
 	return managed.ExternalObservation{
 		ResourceExists:    true,
 		ResourceUpToDate:  true,
 		ConnectionDetails: managed.ConnectionDetails{},
-	}, getErr
+	}, nil
 }
 
 func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
 	cr, ok := mg.(*v1alpha1.GrpcKind)
 	if !ok {
-		return managed.ExternalCreation{}, errors.New(errNotGrpcKind)
+		return managed.ExternalCreation{}, ErrNotGrpcKind
+	}
+	defer c.recordRPCHistory(cr)
+	defer c.recordWarnings(cr)
+	ctx = withTenant(ctx, cr)
+	ctx, span := startSpan(ctx, "Create")
+	defer span.End()
+
+	log.Infof("Create::Creating: \"%+v\"", logName(cr.Spec.ForProvider.Name))
+
+	restore, err := c.resolveListItems(ctx, cr)
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+	defer restore()
+
+	if err := checkListSize(c.pc, cr); err != nil {
+		return managed.ExternalCreation{}, err
+	}
+
+	if err := itemsRejected(cr); err != nil {
+		return managed.ExternalCreation{}, err
+	}
+
+	if err := c.backingOff(cr); err != nil {
+		return managed.ExternalCreation{}, err
 	}
 
-	log.Infof("Create::Creating: \"%+v\"", cr.Spec.ForProvider.Name)
+	cr.Status.SetConditions(xpv1.Creating())
+	c.traces.Trace(cr, reasonTraceCreate, "Creating list %q", logName(cr.Spec.ForProvider.Name))
+	c.watches.Stop(cr.GetUID())
+	c.fresh.Forget(cr)
 
-	// Check if description is populated since is optional field
-	description := ""
-	if cr.Spec.ForProvider.Description != nil {
-		*cr.Spec.ForProvider.Description = description
+	// The UID never changes for the lifetime of the managed resource, so the
+	// backend can use it to dedupe a CreateList we retry after crashing before
+	// recording that the list was created.
+	ctx = metadata.AppendToOutgoingContext(ctx, idempotencyKey, string(cr.GetUID()))
+	ctx = withAccess(ctx, cr)
+	ctx = withOwner(ctx, cr)
+	ctx = withItemMetadata(ctx, cr)
+	ctx = withStructuredItems(ctx, cr)
+	ctx = withTTL(ctx, cr)
+
+	req := toCreateReq(cr)
+	if !sendDescription(c.pc) {
+		req.Description = ""
 	}
 
-	createResp, err := c.service.grpcClient.CreateList(context.Background(), &listServicepb.CreateListReq{
-		Name:        cr.Spec.ForProvider.Name,
-		Description: description,
+	var createResp *listServicepb.CreateListResp
+	err = retryTransient(ctx, func() (err error) {
+		createResp, err = c.service.grpcClient.CreateList(ctx, req)
+		return err
 	})
+	if status.Code(err) == codes.AlreadyExists {
+		// Another client created the list since it was observed. Adopt it;
+		// Observe will update it if it differs from the desired state.
+		log.Infof("Create::Adopting list \"%v\", which already exists", logName(cr.Spec.ForProvider.Name))
+		meta.SetExternalName(cr, cr.Spec.ForProvider.Name)
+		createResp, err = nil, nil
+	}
+	if t := c.pc.CreateWaitTimeout; t != nil && err == nil && createResp.GetStatus() == statusPending {
+		createResp.Status, err = c.waitForCreation(ctx, cr.Spec.ForProvider.Name, t.Duration)
+		if interrupted(ctx, err) {
+			return managed.ExternalCreation{}, ctx.Err()
+		}
+	}
+	if err == nil && createResp.GetStatus() == statusFailed {
+		err = errors.Errorf(errCreateFailedFmt, logName(cr.Spec.ForProvider.Name))
+	}
+	if !c.recordItemsValidity(cr, err) {
+		c.recordAttempt(cr, err)
+	}
 
 	if err != nil {
-		log.Errorf("Create::Error creating list \"%v\": %v", cr.Spec.ForProvider.Name, err)
+		log.Errorf("Create::Error creating list \"%v\": %v", logName(cr.Spec.ForProvider.Name), err)
 	}
 
-	// Set the status (Observation field)
-	cr.Status.AtProvider.Status = createResp.Status
+	// Set the status (Observation field). Backends may not send a response
+	// along with an error.
+	if createResp != nil {
+		cr.Status.AtProvider.Status = c.listStatus(createResp.GetStatus())
+		if cond, ok := createCondition(cr.Status.AtProvider.Status, readyStatus(c.pc, cr.Status.AtProvider.Status), c.now()); ok {
+			cr.Status.SetConditions(cond)
+		}
+	}
+	if err == nil {
+		if sendDescription(c.pc) {
+			cr.Status.AtProvider.Description = cr.Spec.ForProvider.DeepCopy().Description
+		}
+		cr.Status.SetConditions(xpv1.ReconcileSuccess())
+	}
 
 	return managed.ExternalCreation{
 		ConnectionDetails: managed.ConnectionDetails{},
@@ -231,45 +1078,488 @@ func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.Ext
 func (c *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
 	cr, ok := mg.(*v1alpha1.GrpcKind)
 	if !ok {
-		return managed.ExternalUpdate{}, errors.New(errNotGrpcKind)
+		return managed.ExternalUpdate{}, ErrNotGrpcKind
 	}
+	defer guardExternalName(cr)()
+	defer c.recordRPCHistory(cr)
+	defer c.recordWarnings(cr)
+	ctx = withTenant(ctx, cr)
+	ctx, span := startSpan(ctx, "Update")
+	defer span.End()
 
 	log.Infof("Update::Update method called... Updating resource: \"%+v\"", cr.GetName())
 
-	_, err := c.service.grpcClient.UpdateListItems(context.Background(), &listServicepb.UpdateListItemsReq{
-		Name:     cr.Spec.ForProvider.Name,
-		NewItems: cr.Spec.ForProvider.ListItems,
-	})
+	restore, err := c.resolveListItems(ctx, cr)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	defer restore()
+
+	if c.applied.Recent(cr.GetUID(), cr.Spec.ForProvider) {
+		log.Infof("Update:: Identical update of list \"%v\" was applied recently. Skipping...", logName(cr.Spec.ForProvider.Name))
+		return managed.ExternalUpdate{
+			ConnectionDetails: managed.ConnectionDetails{},
+		}, nil
+	}
+
+	// The managed reconciler only updates lists Observe found drifted. Guard
+	// against comparison bugs causing needless writes anyway.
+	if c.observed != nil && !c.drifted(cr, c.observed) {
+		log.Infof("Update:: List \"%v\" was observed up to date. Skipping...", logName(cr.Spec.ForProvider.Name))
+		return managed.ExternalUpdate{
+			ConnectionDetails: managed.ConnectionDetails{},
+		}, nil
+	}
+
+	if !c.debouncer.Settled(cr) {
+		log.Infof("Update:: Waiting for the spec of \"%v\" to stop changing. Skipping...", cr.GetName())
+		return managed.ExternalUpdate{
+			ConnectionDetails: managed.ConnectionDetails{},
+		}, nil
+	}
+
+	if err := checkListSize(c.pc, cr); err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+
+	if err := itemsRejected(cr); err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+
+	if err := c.backingOff(cr); err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+
+	cr.Status.SetConditions(updating(c.now()))
+	c.traces.Trace(cr, reasonTraceUpdate, "Updating list %q", logName(cr.Spec.ForProvider.Name))
+
+	// The list is watched again once it's next observed, so that the state
+	// the watch caches never predates this update.
+	c.watches.Stop(cr.GetUID())
+	c.fresh.Forget(cr)
+
+	if c.observed != nil {
+		log.Infof("Update:: Updating list \"%v\" (%s)", logName(cr.Spec.ForProvider.Name), c.driftSummary(cr, c.observed.items, c.observed.header))
+	}
+
+	// Send the description only when it changed, so that an unset (nil)
+	// description is left alone while an empty one clears it.
+	if c.descriptionDrifted(cr) {
+		ctx = metadata.AppendToOutgoingContext(ctx, descriptionKey, *cr.Spec.ForProvider.Description)
+	}
+	ctx = withAccess(ctx, cr)
+	ctx = withOwner(ctx, cr)
+	ctx = withItemMetadata(ctx, cr)
+	ctx = withStructuredItems(ctx, cr)
+	ctx = withTTL(ctx, cr)
+
+	req := toUpdateReq(cr)
+	items, err := c.mergeItems(ctx, cr)
+	if err == nil {
+		req.NewItems = items
+		err = c.updateListItems(ctx, cr.GetUID(), req)
+	}
+	if c.recordProgress(cr, err) {
+		return managed.ExternalUpdate{
+			ConnectionDetails: managed.ConnectionDetails{},
+		}, nil
+	}
+	if !c.recordItemsValidity(cr, err) {
+		c.recordAttempt(cr, err)
+	}
+	c.recordItemResults(cr, err)
 
 	if err != nil {
-		log.Infof("Update:: Error updating list \"%v\": %v", cr.Spec.ForProvider.Name, err)
+		log.Infof("Update:: Error updating list \"%v\": %v", logName(cr.Spec.ForProvider.Name), err)
 		return managed.ExternalUpdate{
 			ConnectionDetails: managed.ConnectionDetails{},
 		}, err
 	}
 
+	if sendDescription(c.pc) {
+		cr.Status.AtProvider.Description = cr.Spec.ForProvider.DeepCopy().Description
+	}
+	cr.Status.SetConditions(xpv1.ReconcileSuccess())
+	c.applied.Record(cr.GetUID(), cr.Spec.ForProvider)
+	c.observed = nil
+
 	return managed.ExternalUpdate{
 		ConnectionDetails: managed.ConnectionDetails{},
 	}, nil
 }
 
+// now returns the current time according to the external client's clock.
+func (c *external) now() metav1.Time {
+	if c.clock == nil {
+		return metav1.Now()
+	}
+	return metav1.NewTime(c.clock.Now())
+}
+
+// updating returns a condition that indicates the list is being updated on the
+// backend, as of the supplied time.
+func updating(now metav1.Time) xpv1.Condition {
+	return xpv1.Condition{
+		Type:               xpv1.TypeReady,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: now,
+		Reason:             reasonUpdating,
+	}
+}
+
+// statusCondition returns the Ready condition corresponding to the supplied
+// list status reported by the backend, as of the supplied time.
+func statusCondition(s string, now metav1.Time) xpv1.Condition {
+	switch s {
+	case statusSuccess:
+		c := xpv1.Available()
+		c.LastTransitionTime = now
+		return c
+	case statusDeleting:
+		c := xpv1.Deleting()
+		c.LastTransitionTime = now
+		return c
+	}
+	return xpv1.Condition{
+		Type:               xpv1.TypeReady,
+		Status:             corev1.ConditionUnknown,
+		LastTransitionTime: now,
+		Reason:             reasonUnknownStatus,
+		Message:            fmt.Sprintf("backend reported unknown list status %q", s),
+	}
+}
+
+// readyCondition returns the Ready condition corresponding to the supplied
+// list status reported by the backend. Lists are Available in any of the
+// ProviderConfig's ready statuses.
+func (c *external) readyCondition(s string) xpv1.Condition {
+	now := c.now()
+	switch {
+	case readyStatus(c.pc, s):
+		cond := xpv1.Available()
+		cond.LastTransitionTime = now
+		return cond
+	case s == statusSuccess:
+		return xpv1.Condition{
+			Type:               xpv1.TypeReady,
+			Status:             corev1.ConditionFalse,
+			LastTransitionTime: now,
+			Reason:             reasonNotReadyStatus,
+			Message:            fmt.Sprintf("list status %q is not one of the ProviderConfig's ready statuses", s),
+		}
+	}
+	return statusCondition(s, now)
+}
+
+// readyStatus returns true if the supplied ProviderConfig considers lists with
+// the supplied status ready. Only SUCCESS is ready unless it specifies
+// otherwise.
+func readyStatus(spec apisv1alpha1.ProviderConfigSpec, s string) bool {
+	if len(spec.ReadyStatuses) == 0 {
+		return s == statusSuccess
+	}
+	for _, r := range spec.ReadyStatuses {
+		if r == s {
+			return true
+		}
+	}
+	return false
+}
+
+// createCondition returns the Ready condition corresponding to the supplied
+// status reported by CreateList, as of the supplied time. It returns false for
+// statuses that don't change the Creating condition, e.g. PENDING; Observe
+// determines whether such lists became available. Statuses that are ready
+// make the list Available.
+func createCondition(s string, ready bool, now metav1.Time) (xpv1.Condition, bool) {
+	if ready {
+		c := xpv1.Available()
+		c.LastTransitionTime = now
+		return c, true
+	}
+	switch s {
+	case statusFailed:
+		return xpv1.Condition{
+			Type:               xpv1.TypeReady,
+			Status:             corev1.ConditionFalse,
+			LastTransitionTime: now,
+			Reason:             reasonCreateFailed,
+		}, true
+	}
+	return xpv1.Condition{}, false
+}
+
+// updateListItems replaces the items of a list. Large updates are sent in
+// chunks if the ProviderConfig configures a chunk size, or otherwise streamed
+// to the backend in chunks if it serves BulkUpdateItems. Other updates are
+// batched with those of other lists if batching is enabled and the backend
+// serves BatchUpdateLists.
+func (c *external) updateListItems(ctx context.Context, uid types.UID, req *listServicepb.UpdateListItemsReq) error {
+	if s := c.pc.UpdateChunkSize; s != nil && len(req.NewItems) > int(*s) {
+		return c.updateListItemsChunked(ctx, uid, req, int(*s))
+	}
+
+	if c.service.bulkClient != nil && len(req.NewItems) > bulkChunkSize {
+		_, err := bulkUpdateItems(ctx, c.service.bulkClient, req.Name, req.NewItems)
+		if status.Code(err) != codes.Unimplemented {
+			return err
+		}
+		log.Infof("Update:: Backend does not serve BulkUpdateItems, falling back to UpdateListItems")
+	}
+
+	// Apply the update together with those of other lists, unless only the
+	// items the backend failed to apply are retried.
+	if c.batcher != nil && c.service.batchClient != nil && c.failures.Retry(uid, req.NewItems) == nil {
+		err := c.applyBatched(ctx, req)
+		if status.Code(err) != codes.Unimplemented {
+			if err == nil {
+				c.failures.Forget(uid)
+			}
+			return err
+		}
+		log.Infof("Update:: Backend does not serve BatchUpdateLists, falling back to UpdateListItems")
+	}
+
+	// Retry only the items the backend failed to apply, if it reported
+	// which, unless the desired items changed since.
+	items := req.NewItems
+	if failed := c.failures.Retry(uid, items); failed != nil {
+		log.Infof("Update:: Retrying items %v of list \"%v\" the backend failed to apply", failed, logName(req.Name))
+		ctx = metadata.AppendToOutgoingContext(ctx, retryItemsKey, "true")
+		req = &listServicepb.UpdateListItemsReq{Name: req.Name, NewItems: failed}
+	}
+
+	var header metadata.MD
+	if err := retryTransient(ctx, func() error {
+		_, err := c.service.grpcClient.UpdateListItems(ctx, req, grpc.Header(&header))
+		return err
+	}); err != nil {
+		return err
+	}
+	if failed := failedItems(header); len(failed) > 0 {
+		c.failures.Record(uid, items, failed)
+		return &itemsFailedError{items: failed}
+	}
+	c.failures.Forget(uid)
+	return nil
+}
+
+// withAccess returns a context that sends the GrpcKind's desired access level
+// to the backend, if it has one.
+func withAccess(ctx context.Context, cr *v1alpha1.GrpcKind) context.Context {
+	if a := cr.Spec.ForProvider.Access; a != nil {
+		return metadata.AppendToOutgoingContext(ctx, accessKey, string(*a))
+	}
+	return ctx
+}
+
+// accessDrifted returns true if the backend reported an access level in the
+// supplied response header that differs from the desired one. Backends that
+// don't report access levels never drift.
+func accessDrifted(cr *v1alpha1.GrpcKind, header metadata.MD) bool {
+	want := cr.Spec.ForProvider.Access
+	got := header.Get(accessKey)
+	return want != nil && len(got) > 0 && got[0] != string(*want)
+}
+
+// withOwner returns a context that sends the GrpcKind's desired owner to the
+// backend, if it has one.
+func withOwner(ctx context.Context, cr *v1alpha1.GrpcKind) context.Context {
+	if o := cr.Spec.ForProvider.Owner; o != nil {
+		return metadata.AppendToOutgoingContext(ctx, ownerKey, *o)
+	}
+	return ctx
+}
+
+// ownerDrifted returns true if the backend reported an owner in the supplied
+// response header that differs from the desired one. Backends that don't
+// report owners never drift.
+func ownerDrifted(cr *v1alpha1.GrpcKind, header metadata.MD) bool {
+	want := cr.Spec.ForProvider.Owner
+	got := header.Get(ownerKey)
+	return want != nil && len(got) > 0 && got[0] != *want
+}
+
+// withTTL returns a context that sends the GrpcKind's desired TTL to the
+// backend, if it has one.
+func withTTL(ctx context.Context, cr *v1alpha1.GrpcKind) context.Context {
+	if ttl := cr.Spec.ForProvider.TTL; ttl != nil {
+		return metadata.AppendToOutgoingContext(ctx, ttlKey, ttl.Duration.String())
+	}
+	return ctx
+}
+
+// ttlDrifted returns true if the backend reported a TTL in the supplied
+// response header that differs from the desired one. A TTL that can't be
+// parsed is considered different. Backends that don't report TTLs never drift.
+func ttlDrifted(cr *v1alpha1.GrpcKind, header metadata.MD) bool {
+	want := cr.Spec.ForProvider.TTL
+	got := header.Get(ttlKey)
+	if want == nil || len(got) == 0 {
+		return false
+	}
+	d, err := time.ParseDuration(got[0])
+	return err != nil || d != want.Duration
+}
+
+// descriptionDrifted returns true if the desired description changed and
+// should be applied to the backend. It never should if the ProviderConfig
+// disables sending descriptions.
+func (c *external) descriptionDrifted(cr *v1alpha1.GrpcKind) bool {
+	return sendDescription(c.pc) && descriptionChanged(cr)
+}
+
+// sendDescription returns true unless the supplied ProviderConfig disables
+// sending list descriptions to the backend.
+func sendDescription(spec apisv1alpha1.ProviderConfigSpec) bool {
+	return spec.SendDescription == nil || *spec.SendDescription
+}
+
+// recordDescription records the description the backend reported in the
+// supplied GetList response header as the one applied to the GrpcKind's list,
+// so that it's compared with the list's actual description rather than only
+// with the one last sent. Backends that don't report descriptions leave the
+// recorded description unchanged.
+func recordDescription(cr *v1alpha1.GrpcKind, header metadata.MD) {
+	if got := header.Get(descriptionKey); len(got) > 0 {
+		d := got[0]
+		cr.Status.AtProvider.Description = &d
+	}
+}
+
+// descriptionChanged returns true if the desired description differs from the
+// one last applied to the backend. A nil description is unset and is never a
+// change, whereas an empty description explicitly clears it.
+func descriptionChanged(cr *v1alpha1.GrpcKind) bool {
+	want, applied := cr.Spec.ForProvider.Description, cr.Status.AtProvider.Description
+	if want == nil {
+		return false
+	}
+	return applied == nil || *want != *applied
+}
+
+// deleteList deletes the named list. If the backend serves DeleteListStream it
+// is used to wait until the deletion is complete.
+func (c *external) deleteList(ctx context.Context, name string) (*listServicepb.DeleteListResp, error) {
+	if c.service.deleteClient != nil {
+		resp, err := streamDeleteList(ctx, c.service.deleteClient, name)
+		if status.Code(err) != codes.Unimplemented {
+			return resp, err
+		}
+		log.Infof("Delete:: Backend does not serve DeleteListStream, falling back to DeleteList")
+	}
+
+	return c.service.grpcClient.DeleteList(ctx, &listServicepb.DeleteListReq{Name: name})
+}
+
 func (c *external) Delete(ctx context.Context, mg resource.Managed) error {
 	cr, ok := mg.(*v1alpha1.GrpcKind)
 	if !ok {
-		return errors.New(errNotGrpcKind)
+		return ErrNotGrpcKind
+	}
+	defer c.recordRPCHistory(cr)
+	defer c.recordWarnings(cr)
+	ctx = withTenant(ctx, cr)
+	ctx, span := startSpan(ctx, "Delete")
+	defer span.End()
+
+	// The backend already accepted deleting the list. There's no need to
+	// delete it again while Observe waits for it to be gone.
+	if cr.Status.AtProvider.Status == statusDeleting {
+		log.Infof("Delete:: List \"%v\" is already being deleted\n", logName(cr.Spec.ForProvider.Name))
+		return nil
 	}
 
 	log.Infof("Delete::Deleting: \"%+v\"\n", cr.GetName())
+	c.traces.Trace(cr, reasonTraceDelete, "Deleting list %q", logName(cr.Spec.ForProvider.Name))
+	c.watches.Stop(cr.GetUID())
+	c.fresh.Forget(cr)
 
-	deleteResp, err := c.service.grpcClient.DeleteList(context.Background(), &listServicepb.DeleteListReq{
-		Name: cr.Spec.ForProvider.Name,
+	var deleteResp *listServicepb.DeleteListResp
+	err := retryTransient(ctx, func() (err error) {
+		deleteResp, err = c.deleteList(ctx, cr.Spec.ForProvider.Name)
+		return err
 	})
 
+	// A list that is already gone has been deleted as far as we're concerned.
+	if status.Code(err) == codes.NotFound {
+		log.Infof("Delete:: List \"%v\" not found, nothing to delete\n", logName(cr.Spec.ForProvider.Name))
+		cr.Status.AtProvider.Status = statusDeleted
+		return nil
+	}
+
+	// The provider may be shutting down. Deleting the list isn't failing;
+	// it's retried when the managed resource is requeued.
+	if interrupted(ctx, err) {
+		log.Infof("Delete:: Deleting list \"%v\" interrupted: %v\n", logName(cr.Spec.ForProvider.Name), ctx.Err())
+		return ctx.Err()
+	}
+
 	if err != nil {
-		log.Errorf("Delete:: Error deleting list \"%v\": %v\n", cr.Spec.ForProvider.Name, err)
+		log.Errorf("Delete:: Error deleting list \"%v\": %v\n", logName(cr.Spec.ForProvider.Name), err)
 		return err
 	}
-	log.Infof("Delete:: Delete Status for list \"%v\": %v\n", cr.Spec.ForProvider.Name, deleteResp.Status)
+	log.Infof("Delete:: Delete Status for list \"%v\": %v\n", logName(cr.Spec.ForProvider.Name), deleteResp.GetStatus())
 
+	c.debouncer.Forget(cr)
+	c.chunks.Forget(cr.GetUID())
+	c.history.Forget(cr.GetUID())
+	c.failures.Forget(cr.GetUID())
+
+	// Under the Blocking delete policy the managed resource is kept until the
+	// backend no longer reports its list. Rather than waiting for that here,
+	// the list is recorded as being deleted, and Observe checks whether the
+	// backend finished deleting it each time the managed resource is requeued.
+	if deletePolicy(cr) == v1alpha1.DeleteBlocking {
+		cr.Status.AtProvider.Status = statusDeleting
+		return nil
+	}
+	cr.Status.AtProvider.Status = statusDeleted
 	return nil
 }
+
+// waitForCreation polls the backend until it reports the named list is no
+// longer pending, and returns its status. An error is returned along with the
+// last reported status if the list is still pending once the supplied timeout
+// expires.
+func (c *external) waitForCreation(ctx context.Context, name string, timeout time.Duration) (string, error) {
+	s := statusPending
+	err := wait.PollImmediateWithContext(ctx, createWaitInterval, timeout, func(ctx context.Context) (bool, error) {
+		resp, err := c.service.grpcClient.GetList(ctx, &listServicepb.GetListReq{Name: name})
+		if err != nil {
+			// The list may not be visible to GetList yet.
+			return false, nil
+		}
+		s = resp.GetStatus()
+		return s != statusPending, nil
+	})
+	if err != nil {
+		if ctx.Err() != nil {
+			return s, ctx.Err()
+		}
+		return s, errors.Errorf(errCreatePendingFmt, logName(name), timeout)
+	}
+	return s, nil
+}
+
+// interrupted returns true if the supplied error occurred because the supplied
+// context was cancelled or its deadline exceeded, e.g. because the provider is
+// shutting down.
+func interrupted(ctx context.Context, err error) bool {
+	return err != nil && ctx.Err() != nil
+}
+
+// listNotFound returns true if the supplied error indicates the backend has no
+// such list.
+func listNotFound(err error) bool {
+	return err != nil && (status.Code(err) == codes.NotFound || strings.Contains(err.Error(), "does not exist"))
+}
+
+// deletePolicy returns the delete policy of the supplied GrpcKind, which is
+// Blocking unless otherwise specified.
+func deletePolicy(cr *v1alpha1.GrpcKind) v1alpha1.DeletePolicy {
+	if p := cr.Spec.ForProvider.DeletePolicy; p != nil {
+		return *p
+	}
+	return v1alpha1.DeleteBlocking
+}
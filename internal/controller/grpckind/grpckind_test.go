@@ -18,13 +18,36 @@ package grpckind
 
 import (
 	"context"
+	"io"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/testing/protocmp"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	testingclock "k8s.io/utils/clock/testing"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/resource/fake"
 	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	listServicepb "github.com/ashwinshirva/provider-grpc-server/proto"
+	"github.com/crossplane/provider-grpc/apis/mygroup/v1alpha1"
+	apisv1alpha1 "github.com/crossplane/provider-grpc/apis/v1alpha1"
 )
 
 // Unlike many Kubernetes projects Crossplane does not use third party testing
@@ -35,9 +58,542 @@ import (
 // https://github.com/golang/go/wiki/TestComments
 // https://github.com/crossplane/crossplane/blob/master/CONTRIBUTING.md#contributing-code
 
+// A fakeListServiceClient is a ListServiceClient whose methods are supplied
+// by the test.
+type fakeListServiceClient struct {
+	MockCreateList      func(ctx context.Context, in *listServicepb.CreateListReq, opts ...grpc.CallOption) (*listServicepb.CreateListResp, error)
+	MockGetList         func(ctx context.Context, in *listServicepb.GetListReq, opts ...grpc.CallOption) (*listServicepb.GetListResp, error)
+	MockUpdateListItems func(ctx context.Context, in *listServicepb.UpdateListItemsReq, opts ...grpc.CallOption) (*listServicepb.UpdateListItemsResp, error)
+	MockDeleteList      func(ctx context.Context, in *listServicepb.DeleteListReq, opts ...grpc.CallOption) (*listServicepb.DeleteListResp, error)
+}
+
+func (f *fakeListServiceClient) CreateList(ctx context.Context, in *listServicepb.CreateListReq, opts ...grpc.CallOption) (*listServicepb.CreateListResp, error) {
+	return f.MockCreateList(ctx, in, opts...)
+}
+
+func (f *fakeListServiceClient) GetList(ctx context.Context, in *listServicepb.GetListReq, opts ...grpc.CallOption) (*listServicepb.GetListResp, error) {
+	return f.MockGetList(ctx, in, opts...)
+}
+
+func (f *fakeListServiceClient) UpdateListItems(ctx context.Context, in *listServicepb.UpdateListItemsReq, opts ...grpc.CallOption) (*listServicepb.UpdateListItemsResp, error) {
+	return f.MockUpdateListItems(ctx, in, opts...)
+}
+
+func (f *fakeListServiceClient) DeleteList(ctx context.Context, in *listServicepb.DeleteListReq, opts ...grpc.CallOption) (*listServicepb.DeleteListResp, error) {
+	return f.MockDeleteList(ctx, in, opts...)
+}
+
+// grpcKind returns a GrpcKind that manages the list with the supplied name.
+func grpcKind(name string) *v1alpha1.GrpcKind {
+	cr := &v1alpha1.GrpcKind{
+		Spec: v1alpha1.GrpcKindSpec{
+			ResourceSpec: xpv1.ResourceSpec{
+				ProviderConfigReference: &xpv1.Reference{Name: "default"},
+			},
+			ForProvider: v1alpha1.GrpcKindParameters{Name: name},
+		},
+	}
+	meta.SetExternalName(cr, name)
+	return cr
+}
+
+// A fakeHealthClient is a HealthClient that reports the supplied status.
+type fakeHealthClient struct {
+	healthpb.HealthClient
+
+	status healthpb.HealthCheckResponse_ServingStatus
+	err    error
+}
+
+func (f *fakeHealthClient) Check(_ context.Context, _ *healthpb.HealthCheckRequest, _ ...grpc.CallOption) (*healthpb.HealthCheckResponse, error) {
+	return &healthpb.HealthCheckResponse{Status: f.status}, f.err
+}
+
+// A fakeBulkUpdateClient records the chunks sent to BulkUpdateItems.
+type fakeBulkUpdateClient struct {
+	chunks [][]int32
+	resp   *listServicepb.UpdateListItemsResp
+	err    error
+}
+
+func (f *fakeBulkUpdateClient) BulkUpdateItems(_ context.Context, _ ...grpc.CallOption) (bulkUpdateItemsStream, error) {
+	return f, nil
+}
+
+func (f *fakeBulkUpdateClient) Send(m *listServicepb.UpdateListItemsReq) error {
+	f.chunks = append(f.chunks, m.GetNewItems())
+	return nil
+}
+
+func (f *fakeBulkUpdateClient) CloseAndRecv() (*listServicepb.UpdateListItemsResp, error) {
+	return f.resp, f.err
+}
+
+// A fakeDeleteListStream emits the supplied deletion progress, then err.
+type fakeDeleteListStream struct {
+	progress []string
+	err      error
+}
+
+func (f *fakeDeleteListStream) DeleteListStream(_ context.Context, _ *listServicepb.DeleteListReq, _ ...grpc.CallOption) (deleteListStream, error) {
+	return f, nil
+}
+
+func (f *fakeDeleteListStream) Recv() (*listServicepb.DeleteListResp, error) {
+	if len(f.progress) == 0 {
+		return nil, f.err
+	}
+	s := f.progress[0]
+	f.progress = f.progress[1:]
+	return &listServicepb.DeleteListResp{Status: s}, nil
+}
+
+type grpcKindModifier func(*v1alpha1.GrpcKind)
+
+func withDescription(d *string) grpcKindModifier {
+	return func(cr *v1alpha1.GrpcKind) { cr.Spec.ForProvider.Description = d }
+}
+
+func withAppliedDescription(d *string) grpcKindModifier {
+	return func(cr *v1alpha1.GrpcKind) { cr.Status.AtProvider.Description = d }
+}
+
+func withAccessLevel(a v1alpha1.Access) grpcKindModifier {
+	return func(cr *v1alpha1.GrpcKind) { cr.Spec.ForProvider.Access = &a }
+}
+
+func withListOwner(o string) grpcKindModifier {
+	return func(cr *v1alpha1.GrpcKind) { cr.Spec.ForProvider.Owner = &o }
+}
+
+func withListTenant(tenant string) grpcKindModifier {
+	return func(cr *v1alpha1.GrpcKind) { cr.Spec.ForProvider.Tenant = &tenant }
+}
+
+func withListItemMetadata(md ...v1alpha1.ListItemMetadata) grpcKindModifier {
+	return func(cr *v1alpha1.GrpcKind) { cr.Spec.ForProvider.ListItemMetadata = md }
+}
+
+func withListStructuredItems(items ...string) grpcKindModifier {
+	return func(cr *v1alpha1.GrpcKind) {
+		for _, it := range items {
+			cr.Spec.ForProvider.StructuredListItems = append(cr.Spec.ForProvider.StructuredListItems, runtime.RawExtension{Raw: []byte(it)})
+		}
+	}
+}
+
+func withListTTL(d time.Duration) grpcKindModifier {
+	return func(cr *v1alpha1.GrpcKind) { cr.Spec.ForProvider.TTL = &metav1.Duration{Duration: d} }
+}
+
+func withItems(items ...int32) grpcKindModifier {
+	return func(cr *v1alpha1.GrpcKind) { cr.Spec.ForProvider.ListItems = items }
+}
+
+func grpcKindWith(name string, m ...grpcKindModifier) *v1alpha1.GrpcKind {
+	cr := grpcKind(name)
+	for _, fn := range m {
+		fn(cr)
+	}
+	return cr
+}
+
+func strPtr(s string) *string { return &s }
+
+func int32Ptr(i int32) *int32 { return &i }
+
+func int64Ptr(i int64) *int64 { return &i }
+
+func boolPtr(b bool) *bool { return &b }
+
+func TestConnect(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	type fields struct {
+		kube       client.Client
+		health     healthpb.HealthClient
+		requireTLS bool
+	}
+
+	type want struct {
+		target    string
+		creds     []byte
+		transport transport
+		err       error
+	}
+
+	cases := map[string]struct {
+		reason string
+		env    map[string]string
+		fields fields
+		want   want
+	}{
+		"FallbackCredentials": {
+			reason: "Fallback credentials should be used when the primary credentials cannot be extracted.",
+			env:    map[string]string{"GRPC_CREDS": "fallback-creds"},
+			fields: fields{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, _ client.ObjectKey, obj client.Object) error {
+						switch o := obj.(type) {
+						case *apisv1alpha1.ProviderConfig:
+							o.Spec = apisv1alpha1.ProviderConfigSpec{
+								Credentials: apisv1alpha1.ProviderCredentials{
+									Source: xpv1.CredentialsSourceSecret,
+									CommonCredentialSelectors: xpv1.CommonCredentialSelectors{
+										SecretRef: &xpv1.SecretKeySelector{Key: "creds"},
+									},
+								},
+								FallbackCredentials: []apisv1alpha1.ProviderCredentials{{
+									Source: xpv1.CredentialsSourceEnvironment,
+									CommonCredentialSelectors: xpv1.CommonCredentialSelectors{
+										Env: &xpv1.EnvSelector{Name: "GRPC_CREDS"},
+									},
+								}},
+							}
+						case *corev1.Secret:
+							return errBoom
+						}
+						return nil
+					},
+				},
+			},
+			want: want{
+				target: Address,
+				creds:  []byte("fallback-creds"),
+			},
+		},
+		"AllCredentialsFail": {
+			reason: "An error should be returned when no credentials can be extracted.",
+			fields: fields{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, _ client.ObjectKey, obj client.Object) error {
+						switch o := obj.(type) {
+						case *apisv1alpha1.ProviderConfig:
+							o.Spec.Credentials = apisv1alpha1.ProviderCredentials{
+								Source: xpv1.CredentialsSourceSecret,
+								CommonCredentialSelectors: xpv1.CommonCredentialSelectors{
+									SecretRef: &xpv1.SecretKeySelector{Key: "creds"},
+								},
+							}
+						case *corev1.Secret:
+							return errBoom
+						}
+						return nil
+					},
+				},
+			},
+			want: want{
+				err: errors.Wrap(errors.Wrap(errBoom, "cannot get credentials secret"), errGetCreds),
+			},
+		},
+		"EmptyCredentials": {
+			reason: "An error should be returned when a credentials source returns no data.",
+			fields: fields{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, _ client.ObjectKey, obj client.Object) error {
+						if o, ok := obj.(*apisv1alpha1.ProviderConfig); ok {
+							o.Spec.Credentials = apisv1alpha1.ProviderCredentials{
+								Source: xpv1.CredentialsSourceSecret,
+								CommonCredentialSelectors: xpv1.CommonCredentialSelectors{
+									SecretRef: &xpv1.SecretKeySelector{Key: "creds"},
+								},
+							}
+						}
+						return nil
+					},
+				},
+			},
+			want: want{
+				err: errors.Wrap(errors.Errorf(errEmptyCredsFmt, xpv1.CredentialsSourceSecret), errGetCreds),
+			},
+		},
+		"EmptyCredentialsFallback": {
+			reason: "Fallback credentials should be used when the primary credentials are empty.",
+			env:    map[string]string{"GRPC_CREDS": "fallback-creds"},
+			fields: fields{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, _ client.ObjectKey, obj client.Object) error {
+						if o, ok := obj.(*apisv1alpha1.ProviderConfig); ok {
+							o.Spec = apisv1alpha1.ProviderConfigSpec{
+								Credentials: apisv1alpha1.ProviderCredentials{
+									Source: xpv1.CredentialsSourceSecret,
+									CommonCredentialSelectors: xpv1.CommonCredentialSelectors{
+										SecretRef: &xpv1.SecretKeySelector{Key: "creds"},
+									},
+								},
+								FallbackCredentials: []apisv1alpha1.ProviderCredentials{{
+									Source: xpv1.CredentialsSourceEnvironment,
+									CommonCredentialSelectors: xpv1.CommonCredentialSelectors{
+										Env: &xpv1.EnvSelector{Name: "GRPC_CREDS"},
+									},
+								}},
+							}
+						}
+						return nil
+					},
+				},
+			},
+			want: want{
+				target: Address,
+				creds:  []byte("fallback-creds"),
+			},
+		},
+		"BackendNotReady": {
+			reason: "Connect should fail when the readiness check finds the backend is not serving.",
+			fields: fields{
+				kube: &test.MockClient{
+					MockGet: test.NewMockGetFn(nil, func(obj client.Object) error {
+						pc := obj.(*apisv1alpha1.ProviderConfig)
+						pc.Spec.Credentials.Source = xpv1.CredentialsSourceNone
+						pc.Spec.ReadinessCheck = true
+						return nil
+					}),
+				},
+				health: &fakeHealthClient{status: healthpb.HealthCheckResponse_NOT_SERVING},
+			},
+			want: want{
+				target: Address,
+				err:    errors.Wrap(errors.Errorf(errNotServingFmt, healthpb.HealthCheckResponse_NOT_SERVING), errNotReady),
+			},
+		},
+		"BackendReady": {
+			reason: "Connect should succeed when the readiness check finds the backend is serving.",
+			fields: fields{
+				kube: &test.MockClient{
+					MockGet: test.NewMockGetFn(nil, func(obj client.Object) error {
+						pc := obj.(*apisv1alpha1.ProviderConfig)
+						pc.Spec.Credentials.Source = xpv1.CredentialsSourceNone
+						pc.Spec.ReadinessCheck = true
+						return nil
+					}),
+				},
+				health: &fakeHealthClient{status: healthpb.HealthCheckResponse_SERVING},
+			},
+			want: want{
+				target: Address,
+			},
+		},
+		"ServiceRef": {
+			reason: "A referenced Service should be dialed using its cluster-internal DNS name.",
+			fields: fields{
+				kube: &test.MockClient{
+					MockGet: test.NewMockGetFn(nil, func(obj client.Object) error {
+						pc := obj.(*apisv1alpha1.ProviderConfig)
+						pc.Spec.Credentials.Source = xpv1.CredentialsSourceNone
+						pc.Spec.Endpoint = strPtr("10.0.0.1:50050")
+						pc.Spec.ServiceRef = &apisv1alpha1.ServiceReference{Name: "lists", Namespace: "backend", Port: 50051}
+						return nil
+					}),
+				},
+			},
+			want: want{
+				target: "lists.backend.svc:50051",
+			},
+		},
+		"Endpoint": {
+			reason: "A configured endpoint should be dialed as is.",
+			fields: fields{
+				kube: &test.MockClient{
+					MockGet: test.NewMockGetFn(nil, func(obj client.Object) error {
+						pc := obj.(*apisv1alpha1.ProviderConfig)
+						pc.Spec.Credentials.Source = xpv1.CredentialsSourceNone
+						pc.Spec.Endpoint = strPtr("10.0.0.1:50050")
+						return nil
+					}),
+				},
+			},
+			want: want{
+				target: "10.0.0.1:50050",
+			},
+		},
+		"Authority": {
+			reason: "The authority of the ProviderConfig should be used when dialing the backend.",
+			fields: fields{
+				kube: &test.MockClient{
+					MockGet: test.NewMockGetFn(nil, func(obj client.Object) error {
+						pc := obj.(*apisv1alpha1.ProviderConfig)
+						pc.Spec.Credentials.Source = xpv1.CredentialsSourceNone
+						pc.Spec.Endpoint = strPtr("10.0.0.1:443")
+						pc.Spec.Authority = strPtr("lists.example.org")
+						return nil
+					}),
+				},
+			},
+			want: want{
+				target:    "10.0.0.1:443",
+				transport: transport{authority: "lists.example.org"},
+			},
+		},
+		"LoadBalancingPolicy": {
+			reason: "The load balancing policy of the ProviderConfig should be used when dialing the backend.",
+			fields: fields{
+				kube: &test.MockClient{
+					MockGet: test.NewMockGetFn(nil, func(obj client.Object) error {
+						pc := obj.(*apisv1alpha1.ProviderConfig)
+						pc.Spec.Credentials.Source = xpv1.CredentialsSourceNone
+						p := apisv1alpha1.LoadBalancingRoundRobin
+						pc.Spec.LoadBalancingPolicy = &p
+						return nil
+					}),
+				},
+			},
+			want: want{
+				target:    Address,
+				transport: transport{loadBalancingPolicy: "round_robin"},
+			},
+		},
+		"UnixSocketEndpoint": {
+			reason: "An endpoint with a scheme should be passed to gRPC as is.",
+			fields: fields{
+				kube: &test.MockClient{
+					MockGet: test.NewMockGetFn(nil, func(obj client.Object) error {
+						pc := obj.(*apisv1alpha1.ProviderConfig)
+						pc.Spec.Credentials.Source = xpv1.CredentialsSourceNone
+						pc.Spec.Endpoint = strPtr("unix:///var/run/lists.sock")
+						return nil
+					}),
+				},
+			},
+			want: want{
+				target: "unix:///var/run/lists.sock",
+			},
+		},
+		"TLSRequired": {
+			reason: "Connect should fail when TLS is required but the ProviderConfig doesn't configure it.",
+			fields: fields{
+				kube: &test.MockClient{
+					MockGet: test.NewMockGetFn(nil, func(obj client.Object) error {
+						pc := obj.(*apisv1alpha1.ProviderConfig)
+						pc.Spec.Credentials.Source = xpv1.CredentialsSourceNone
+						return nil
+					}),
+				},
+				requireTLS: true,
+			},
+			want: want{
+				err: errors.New(errTLSRequired),
+			},
+		},
+		"TLS": {
+			reason: "The backend should be dialed using the TLS settings of the ProviderConfig.",
+			fields: fields{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, _ client.ObjectKey, obj client.Object) error {
+						switch o := obj.(type) {
+						case *apisv1alpha1.ProviderConfig:
+							o.Spec.Credentials.Source = xpv1.CredentialsSourceNone
+							o.Spec.TLS = &apisv1alpha1.TLSConfig{
+								CASecretRef: &xpv1.SecretKeySelector{Key: "ca.crt"},
+								ServerName:  "lists.example.org",
+							}
+						case *corev1.Secret:
+							o.Data = map[string][]byte{"ca.crt": []byte("cool-ca")}
+						}
+						return nil
+					},
+				},
+				requireTLS: true,
+			},
+			want: want{
+				target:    Address,
+				transport: transport{tls: true, ca: "cool-ca", serverName: "lists.example.org"},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			for k, v := range tc.env {
+				t.Setenv(k, v)
+			}
+			var target string
+			var creds []byte
+			var tr transport
+			c := connector{
+				kube:  tc.fields.kube,
+				usage: resource.TrackerFn(func(_ context.Context, _ resource.Managed) error { return nil }),
+				newServiceFn: func(t string, data []byte, trans transport) (*ListService, error) {
+					target = t
+					creds = data
+					tr = trans
+					return &ListService{healthClient: tc.fields.health}, nil
+				},
+				requireTLS: tc.fields.requireTLS,
+			}
+			_, err := c.Connect(context.Background(), grpcKind("cool-list"))
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nc.Connect(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.target, target); diff != "" {
+				t.Errorf("\n%s\nc.Connect(...): -want target, +got target:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.creds, creds); diff != "" {
+				t.Errorf("\n%s\nc.Connect(...): -want creds, +got creds:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.transport, tr, cmp.AllowUnexported(transport{})); diff != "" {
+				t.Errorf("\n%s\nc.Connect(...): -want transport, +got transport:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestReadEndpoint(t *testing.T) {
+	calls := map[string][]string{}
+	fakeFor := func(target string) *fakeListServiceClient {
+		return &fakeListServiceClient{
+			MockGetList: func(_ context.Context, _ *listServicepb.GetListReq, _ ...grpc.CallOption) (*listServicepb.GetListResp, error) {
+				calls[target] = append(calls[target], "GetList")
+				return &listServicepb.GetListResp{Status: "SUCCESS", Items: []int32{1}}, nil
+			},
+			MockUpdateListItems: func(_ context.Context, _ *listServicepb.UpdateListItemsReq, _ ...grpc.CallOption) (*listServicepb.UpdateListItemsResp, error) {
+				calls[target] = append(calls[target], "UpdateListItems")
+				return &listServicepb.UpdateListItemsResp{Status: "UPDATED"}, nil
+			},
+		}
+	}
+
+	c := connector{
+		kube: &test.MockClient{
+			MockGet: func(_ context.Context, _ client.ObjectKey, obj client.Object) error {
+				if o, ok := obj.(*apisv1alpha1.ProviderConfig); ok {
+					o.Spec.Credentials.Source = xpv1.CredentialsSourceNone
+					o.Spec.Endpoint = strPtr("primary:443")
+					o.Spec.ReadEndpoint = strPtr("replica:443")
+				}
+				return nil
+			},
+		},
+		usage: resource.TrackerFn(func(_ context.Context, _ resource.Managed) error { return nil }),
+		newServiceFn: func(target string, _ []byte, _ transport) (*ListService, error) {
+			return &ListService{grpcClient: fakeFor(target)}, nil
+		},
+	}
+
+	cr := grpcKindWith("cool-list", withItems(1, 2))
+	e, err := c.Connect(context.Background(), cr)
+	if err != nil {
+		t.Fatalf("c.Connect(...): %v", err)
+	}
+	if _, err := e.Observe(context.Background(), cr); err != nil {
+		t.Fatalf("e.Observe(...): %v", err)
+	}
+	if _, err := e.Update(context.Background(), cr); err != nil {
+		t.Fatalf("e.Update(...): %v", err)
+	}
+
+	want := map[string][]string{
+		"primary:443": {"UpdateListItems"},
+		"replica:443": {"GetList"},
+	}
+	if diff := cmp.Diff(want, calls); diff != "" {
+		t.Errorf("Observe should get the list from the read endpoint, and Update should update it at the primary endpoint: -want calls, +got calls:\n%s\n", diff)
+	}
+}
+
 func TestObserve(t *testing.T) {
 	type fields struct {
 		service *ListService
+		pc      apisv1alpha1.ProviderConfigSpec
 	}
 
 	type args struct {
@@ -46,8 +602,9 @@ func TestObserve(t *testing.T) {
 	}
 
 	type want struct {
-		o   managed.ExternalObservation
-		err error
+		o       managed.ExternalObservation
+		outcome string
+		err     error
 	}
 
 	cases := map[string]struct {
@@ -56,13 +613,436 @@ func TestObserve(t *testing.T) {
 		args   args
 		want   want
 	}{
-		// TODO: Add test cases.
+		"UpToDate": {
+			reason: "A list whose items and description match should be up to date.",
+			fields: fields{
+				service: &ListService{grpcClient: &fakeListServiceClient{
+					MockGetList: func(_ context.Context, _ *listServicepb.GetListReq, _ ...grpc.CallOption) (*listServicepb.GetListResp, error) {
+						return &listServicepb.GetListResp{Status: "SUCCESS", Items: []int32{1, 2}}, nil
+					},
+				}},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg:  grpcKindWith("cool-list", withItems(1, 2), withDescription(strPtr("cool")), withAppliedDescription(strPtr("cool"))),
+			},
+			want: want{
+				o: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  true,
+					ConnectionDetails: managed.ConnectionDetails{},
+				},
+				outcome: outcomeUpToDate,
+			},
+		},
+		"NotFound": {
+			reason: "A list that does not exist should be reported as such.",
+			fields: fields{
+				service: &ListService{grpcClient: &fakeListServiceClient{
+					MockGetList: func(_ context.Context, in *listServicepb.GetListReq, _ ...grpc.CallOption) (*listServicepb.GetListResp, error) {
+						return &listServicepb.GetListResp{Status: "FAILED"}, errors.New(in.GetName() + " list does not exist")
+					},
+				}},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg:  grpcKind("cool-list"),
+			},
+			want: want{
+				o: managed.ExternalObservation{
+					ResourceExists:    false,
+					ResourceUpToDate:  true,
+					ConnectionDetails: managed.ConnectionDetails{},
+				},
+				outcome: outcomeNotFound,
+			},
+		},
+		"ErrorWithResponse": {
+			reason: "An error should be returned rather than the partial response some backends return with it being observed.",
+			fields: fields{
+				service: &ListService{grpcClient: &fakeListServiceClient{
+					MockGetList: func(_ context.Context, _ *listServicepb.GetListReq, _ ...grpc.CallOption) (*listServicepb.GetListResp, error) {
+						return &listServicepb.GetListResp{Status: "SUCCESS", Items: []int32{1}}, status.Error(codes.Unavailable, "backend unavailable")
+					},
+				}},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg:  grpcKindWith("cool-list", withItems(1, 2)),
+			},
+			want: want{
+				o: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  true,
+					ConnectionDetails: managed.ConnectionDetails{},
+				},
+				err: status.Error(codes.Unavailable, "backend unavailable"),
+			},
+		},
+		"ItemsChanged": {
+			reason: "A list whose items differ should be reported as drifted.",
+			fields: fields{
+				service: &ListService{grpcClient: &fakeListServiceClient{
+					MockGetList: func(_ context.Context, _ *listServicepb.GetListReq, _ ...grpc.CallOption) (*listServicepb.GetListResp, error) {
+						return &listServicepb.GetListResp{Status: "SUCCESS", Items: []int32{1}}, nil
+					},
+				}},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg:  grpcKindWith("cool-list", withItems(1, 2)),
+			},
+			want: want{
+				o: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  false,
+					ConnectionDetails: managed.ConnectionDetails{},
+				},
+				outcome: outcomeDriftItems,
+			},
+		},
+		"AccessChanged": {
+			reason: "A list whose reported access level differs should be reported as drifted.",
+			fields: fields{
+				service: &ListService{grpcClient: &fakeListServiceClient{
+					MockGetList: func(_ context.Context, _ *listServicepb.GetListReq, opts ...grpc.CallOption) (*listServicepb.GetListResp, error) {
+						for _, o := range opts {
+							if h, ok := o.(grpc.HeaderCallOption); ok {
+								*h.HeaderAddr = metadata.Pairs(accessKey, string(v1alpha1.AccessPrivate))
+							}
+						}
+						return &listServicepb.GetListResp{Status: "SUCCESS"}, nil
+					},
+				}},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg:  grpcKindWith("cool-list", withAccessLevel(v1alpha1.AccessPublic)),
+			},
+			want: want{
+				o: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  false,
+					ConnectionDetails: managed.ConnectionDetails{},
+				},
+				outcome: outcomeDriftAccess,
+			},
+		},
+		"OwnerChanged": {
+			reason: "A list whose reported owner differs should be reported as drifted.",
+			fields: fields{
+				service: &ListService{grpcClient: &fakeListServiceClient{
+					MockGetList: func(_ context.Context, _ *listServicepb.GetListReq, opts ...grpc.CallOption) (*listServicepb.GetListResp, error) {
+						for _, o := range opts {
+							if h, ok := o.(grpc.HeaderCallOption); ok {
+								*h.HeaderAddr = metadata.Pairs(ownerKey, "team-b")
+							}
+						}
+						return &listServicepb.GetListResp{Status: "SUCCESS"}, nil
+					},
+				}},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg:  grpcKindWith("cool-list", withListOwner("team-a")),
+			},
+			want: want{
+				o: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  false,
+					ConnectionDetails: managed.ConnectionDetails{},
+				},
+				outcome: outcomeDriftOwner,
+			},
+		},
+		"ItemMetadataChanged": {
+			reason: "A list whose reported item metadata differs should be reported as drifted.",
+			fields: fields{
+				service: &ListService{grpcClient: &fakeListServiceClient{
+					MockGetList: func(_ context.Context, _ *listServicepb.GetListReq, opts ...grpc.CallOption) (*listServicepb.GetListResp, error) {
+						for _, o := range opts {
+							if h, ok := o.(grpc.HeaderCallOption); ok {
+								*h.HeaderAddr = metadata.Pairs(itemMetadataKey, `[{"value":1,"weight":2}]`)
+							}
+						}
+						return &listServicepb.GetListResp{Status: "SUCCESS", Items: []int32{1}}, nil
+					},
+				}},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg:  grpcKindWith("cool-list", withItems(1), withListItemMetadata(v1alpha1.ListItemMetadata{Weight: int32Ptr(3)})),
+			},
+			want: want{
+				o: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  false,
+					ConnectionDetails: managed.ConnectionDetails{},
+				},
+				outcome: outcomeDriftItemMetadata,
+			},
+		},
+		"StructuredItemsChanged": {
+			reason: "A list whose reported structured items differ should be reported as drifted.",
+			fields: fields{
+				service: &ListService{grpcClient: &fakeListServiceClient{
+					MockGetList: func(_ context.Context, _ *listServicepb.GetListReq, opts ...grpc.CallOption) (*listServicepb.GetListResp, error) {
+						for _, o := range opts {
+							if h, ok := o.(grpc.HeaderCallOption); ok {
+								*h.HeaderAddr = metadata.Pairs(structuredItemsKey, `[{"value":{"sku":"a-1"}}]`)
+							}
+						}
+						return &listServicepb.GetListResp{Status: "SUCCESS"}, nil
+					},
+				}},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg:  grpcKindWith("cool-list", withListStructuredItems(`{"sku":"a-2"}`)),
+			},
+			want: want{
+				o: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  false,
+					ConnectionDetails: managed.ConnectionDetails{},
+				},
+				outcome: outcomeDriftItems,
+			},
+		},
+		"StructuredItemsUpToDate": {
+			reason: "A list whose reported structured items are equivalent to the desired ones should be up to date.",
+			fields: fields{
+				service: &ListService{grpcClient: &fakeListServiceClient{
+					MockGetList: func(_ context.Context, _ *listServicepb.GetListReq, opts ...grpc.CallOption) (*listServicepb.GetListResp, error) {
+						for _, o := range opts {
+							if h, ok := o.(grpc.HeaderCallOption); ok {
+								*h.HeaderAddr = metadata.Pairs(structuredItemsKey, `[{"value":{"qty":2,"sku":"a-1"}},{"value":7}]`)
+							}
+						}
+						return &listServicepb.GetListResp{Status: "SUCCESS"}, nil
+					},
+				}},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg:  grpcKindWith("cool-list", withListStructuredItems(`{"sku": "a-1", "qty": 2}`, "7")),
+			},
+			want: want{
+				o: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  true,
+					ConnectionDetails: managed.ConnectionDetails{},
+				},
+				outcome: outcomeUpToDate,
+			},
+		},
+		"TTLChanged": {
+			reason: "A list whose reported TTL differs should be reported as drifted.",
+			fields: fields{
+				service: &ListService{grpcClient: &fakeListServiceClient{
+					MockGetList: func(_ context.Context, _ *listServicepb.GetListReq, opts ...grpc.CallOption) (*listServicepb.GetListResp, error) {
+						for _, o := range opts {
+							if h, ok := o.(grpc.HeaderCallOption); ok {
+								*h.HeaderAddr = metadata.Pairs(ttlKey, "1h0m0s")
+							}
+						}
+						return &listServicepb.GetListResp{Status: "SUCCESS"}, nil
+					},
+				}},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg:  grpcKindWith("cool-list", withListTTL(2*time.Hour)),
+			},
+			want: want{
+				o: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  false,
+					ConnectionDetails: managed.ConnectionDetails{},
+				},
+				outcome: outcomeDriftTTL,
+			},
+		},
+		"TTLUnchanged": {
+			reason: "A list whose reported TTL is the desired one, however it is formatted, should not be reported as drifted.",
+			fields: fields{
+				service: &ListService{grpcClient: &fakeListServiceClient{
+					MockGetList: func(_ context.Context, _ *listServicepb.GetListReq, opts ...grpc.CallOption) (*listServicepb.GetListResp, error) {
+						for _, o := range opts {
+							if h, ok := o.(grpc.HeaderCallOption); ok {
+								*h.HeaderAddr = metadata.Pairs(ttlKey, "120m")
+							}
+						}
+						return &listServicepb.GetListResp{Status: "SUCCESS"}, nil
+					},
+				}},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg:  grpcKindWith("cool-list", withListTTL(2*time.Hour)),
+			},
+			want: want{
+				o: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  true,
+					ConnectionDetails: managed.ConnectionDetails{},
+				},
+				outcome: outcomeUpToDate,
+			},
+		},
+		"AccessNotReported": {
+			reason: "A backend that doesn't report access levels should not cause drift.",
+			fields: fields{
+				service: &ListService{grpcClient: &fakeListServiceClient{
+					MockGetList: func(_ context.Context, _ *listServicepb.GetListReq, _ ...grpc.CallOption) (*listServicepb.GetListResp, error) {
+						return &listServicepb.GetListResp{Status: "SUCCESS"}, nil
+					},
+				}},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg:  grpcKindWith("cool-list", withAccessLevel(v1alpha1.AccessPublic)),
+			},
+			want: want{
+				o: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  true,
+					ConnectionDetails: managed.ConnectionDetails{},
+				},
+				outcome: outcomeUpToDate,
+			},
+		},
+		"DescriptionCleared": {
+			reason: "Clearing a previously applied description should be reported as drift.",
+			fields: fields{
+				service: &ListService{grpcClient: &fakeListServiceClient{
+					MockGetList: func(_ context.Context, _ *listServicepb.GetListReq, _ ...grpc.CallOption) (*listServicepb.GetListResp, error) {
+						return &listServicepb.GetListResp{Status: "SUCCESS", Items: []int32{1, 2}}, nil
+					},
+				}},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg:  grpcKindWith("cool-list", withItems(1, 2), withDescription(strPtr("")), withAppliedDescription(strPtr("cool"))),
+			},
+			want: want{
+				o: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  false,
+					ConnectionDetails: managed.ConnectionDetails{},
+				},
+				outcome: outcomeDriftDescription,
+			},
+		},
+		"DescriptionUnset": {
+			reason: "Unsetting a previously applied description should not be reported as drift.",
+			fields: fields{
+				service: &ListService{grpcClient: &fakeListServiceClient{
+					MockGetList: func(_ context.Context, _ *listServicepb.GetListReq, _ ...grpc.CallOption) (*listServicepb.GetListResp, error) {
+						return &listServicepb.GetListResp{Status: "SUCCESS", Items: []int32{1, 2}}, nil
+					},
+				}},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg:  grpcKindWith("cool-list", withItems(1, 2), withAppliedDescription(strPtr("cool"))),
+			},
+			want: want{
+				o: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  true,
+					ConnectionDetails: managed.ConnectionDetails{},
+				},
+				outcome: outcomeUpToDate,
+			},
+		},
+		"DescriptionReported": {
+			reason: "A list whose reported description matches should be up to date, even if no description was recorded as applied.",
+			fields: fields{
+				service: &ListService{grpcClient: &fakeListServiceClient{
+					MockGetList: func(_ context.Context, _ *listServicepb.GetListReq, opts ...grpc.CallOption) (*listServicepb.GetListResp, error) {
+						for _, o := range opts {
+							if h, ok := o.(grpc.HeaderCallOption); ok {
+								*h.HeaderAddr = metadata.Pairs(descriptionKey, "cool")
+							}
+						}
+						return &listServicepb.GetListResp{Status: "SUCCESS", Items: []int32{1, 2}}, nil
+					},
+				}},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg:  grpcKindWith("cool-list", withItems(1, 2), withDescription(strPtr("cool"))),
+			},
+			want: want{
+				o: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  true,
+					ConnectionDetails: managed.ConnectionDetails{},
+				},
+				outcome: outcomeUpToDate,
+			},
+		},
+		"DescriptionReportedChanged": {
+			reason: "A list whose reported description differs should be reported as drifted, even if the desired description was recorded as applied.",
+			fields: fields{
+				service: &ListService{grpcClient: &fakeListServiceClient{
+					MockGetList: func(_ context.Context, _ *listServicepb.GetListReq, opts ...grpc.CallOption) (*listServicepb.GetListResp, error) {
+						for _, o := range opts {
+							if h, ok := o.(grpc.HeaderCallOption); ok {
+								*h.HeaderAddr = metadata.Pairs(descriptionKey, "uncool")
+							}
+						}
+						return &listServicepb.GetListResp{Status: "SUCCESS", Items: []int32{1, 2}}, nil
+					},
+				}},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg:  grpcKindWith("cool-list", withItems(1, 2), withDescription(strPtr("cool")), withAppliedDescription(strPtr("cool"))),
+			},
+			want: want{
+				o: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  false,
+					ConnectionDetails: managed.ConnectionDetails{},
+				},
+				outcome: outcomeDriftDescription,
+			},
+		},
+		"DescriptionDisabled": {
+			reason: "A changed description should not be reported as drift if the ProviderConfig disables sending descriptions.",
+			fields: fields{
+				service: &ListService{grpcClient: &fakeListServiceClient{
+					MockGetList: func(_ context.Context, _ *listServicepb.GetListReq, _ ...grpc.CallOption) (*listServicepb.GetListResp, error) {
+						return &listServicepb.GetListResp{Status: "SUCCESS", Items: []int32{1, 2}}, nil
+					},
+				}},
+				pc: apisv1alpha1.ProviderConfigSpec{SendDescription: boolPtr(false)},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg:  grpcKindWith("cool-list", withItems(1, 2), withDescription(strPtr("cool"))),
+			},
+			want: want{
+				o: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  true,
+					ConnectionDetails: managed.ConnectionDetails{},
+				},
+				outcome: outcomeUpToDate,
+			},
+		},
 	}
 
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
-			e := external{service: tc.fields.service}
+			before := testutil.ToFloat64(observeOutcomes.WithLabelValues(tc.want.outcome))
+			e := external{service: tc.fields.service, pc: tc.fields.pc}
 			got, err := e.Observe(tc.args.ctx, tc.args.mg)
+			if after := testutil.ToFloat64(observeOutcomes.WithLabelValues(tc.want.outcome)); tc.want.outcome != "" && after-before != 1 {
+				t.Errorf("\n%s\ne.Observe(...): want %q outcome counter to increase by 1, got %v", tc.reason, tc.want.outcome, after-before)
+			}
 			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
 				t.Errorf("\n%s\ne.Observe(...): -want error, +got error:\n%s\n", tc.reason, diff)
 			}
@@ -72,3 +1052,1367 @@ func TestObserve(t *testing.T) {
 		})
 	}
 }
+
+func TestObservePartialResponse(t *testing.T) {
+	e := external{service: &ListService{grpcClient: &fakeListServiceClient{
+		MockGetList: func(_ context.Context, _ *listServicepb.GetListReq, _ ...grpc.CallOption) (*listServicepb.GetListResp, error) {
+			return &listServicepb.GetListResp{Status: "FAILED", Items: []int32{1}}, status.Error(codes.Internal, "partial read")
+		},
+	}}}
+	cr := grpcKindWith("cool-list", withItems(1, 2))
+	cr.Status.AtProvider.Status = statusSuccess
+	cr.Status.SetConditions(xpv1.Available())
+
+	if _, err := e.Observe(context.Background(), cr); status.Code(err) != codes.Internal {
+		t.Fatalf("e.Observe(...): want Internal error, got %v", err)
+	}
+	if diff := cmp.Diff(statusSuccess, cr.Status.AtProvider.Status); diff != "" {
+		t.Errorf("e.Observe(...): want the partial response not to be observed: -want status, +got status:\n%s\n", diff)
+	}
+	if diff := cmp.Diff(xpv1.Available(), cr.GetCondition(xpv1.TypeReady), test.EquateConditions()); diff != "" {
+		t.Errorf("e.Observe(...): want the partial response not to be observed: -want condition, +got condition:\n%s\n", diff)
+	}
+	if e.observed != nil {
+		t.Errorf("e.Observe(...): want the partial response not to be recorded, got %+v", e.observed)
+	}
+}
+
+func TestStatusCondition(t *testing.T) {
+	cases := map[string]struct {
+		reason string
+		status string
+		want   xpv1.Condition
+	}{
+		"Success": {
+			reason: "A SUCCESS status should mark the list available.",
+			status: "SUCCESS",
+			want:   xpv1.Available(),
+		},
+		"Unknown": {
+			reason: "An unknown status should produce a condition carrying the raw status.",
+			status: "FROBNICATING",
+			want: xpv1.Condition{
+				Type:    xpv1.TypeReady,
+				Status:  corev1.ConditionUnknown,
+				Reason:  reasonUnknownStatus,
+				Message: `backend reported unknown list status "FROBNICATING"`,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := statusCondition(tc.status, metav1.Now())
+			if diff := cmp.Diff(tc.want, got, test.EquateConditions()); diff != "" {
+				t.Errorf("\n%s\nstatusCondition(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestConditionTime(t *testing.T) {
+	at := time.Date(2022, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	cases := map[string]struct {
+		reason string
+		call   func(e *external, cr *v1alpha1.GrpcKind) error
+	}{
+		"Observe": {
+			reason: "The Ready condition set by Observe should transition at the time the clock reports.",
+			call: func(e *external, cr *v1alpha1.GrpcKind) error {
+				_, err := e.Observe(context.Background(), cr)
+				return err
+			},
+		},
+		"Update": {
+			reason: "The Ready condition set by Update should transition at the time the clock reports.",
+			call: func(e *external, cr *v1alpha1.GrpcKind) error {
+				_, err := e.Update(context.Background(), cr)
+				return err
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			clk := testingclock.NewFakeClock(at)
+			e := &external{clock: clk, service: &ListService{grpcClient: &fakeListServiceClient{
+				MockGetList: func(_ context.Context, _ *listServicepb.GetListReq, _ ...grpc.CallOption) (*listServicepb.GetListResp, error) {
+					clk.Step(time.Hour)
+					return &listServicepb.GetListResp{Status: "SUCCESS"}, nil
+				},
+				MockUpdateListItems: func(_ context.Context, _ *listServicepb.UpdateListItemsReq, _ ...grpc.CallOption) (*listServicepb.UpdateListItemsResp, error) {
+					return &listServicepb.UpdateListItemsResp{Status: "UPDATED"}, nil
+				},
+			}}}
+			cr := grpcKind("cool-list")
+			if err := tc.call(e, cr); err != nil {
+				t.Fatalf("\n%s\n%s(...): %v", tc.reason, name, err)
+			}
+			want := metav1.NewTime(clk.Now())
+			if got := cr.GetCondition(xpv1.TypeReady).LastTransitionTime; !got.Equal(&want) {
+				t.Errorf("\n%s\n%s(...): want Ready condition to transition at %v, got %v", tc.reason, name, want, got)
+			}
+		})
+	}
+}
+
+func TestEmptyStatus(t *testing.T) {
+	type want struct {
+		status string
+		ready  xpv1.Condition
+	}
+
+	cases := map[string]struct {
+		reason string
+		pc     apisv1alpha1.ProviderConfigSpec
+		want   want
+	}{
+		"Unknown": {
+			reason: "A list reported without a status should have an unknown status by default.",
+			want: want{
+				status: "UNKNOWN",
+				ready:  statusCondition("UNKNOWN", metav1.Now()),
+			},
+		},
+		"Success": {
+			reason: "A list reported without a status should be successful if the ProviderConfig treats it so.",
+			pc:     apisv1alpha1.ProviderConfigSpec{EmptyStatusIsSuccess: true},
+			want: want{
+				status: "SUCCESS",
+				ready:  xpv1.Available(),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := external{pc: tc.pc, service: &ListService{grpcClient: &fakeListServiceClient{
+				MockGetList: func(_ context.Context, _ *listServicepb.GetListReq, _ ...grpc.CallOption) (*listServicepb.GetListResp, error) {
+					return &listServicepb.GetListResp{}, nil
+				},
+				MockCreateList: func(_ context.Context, _ *listServicepb.CreateListReq, _ ...grpc.CallOption) (*listServicepb.CreateListResp, error) {
+					return &listServicepb.CreateListResp{}, nil
+				},
+			}}}
+
+			observed := grpcKind("cool-list")
+			if _, err := e.Observe(context.Background(), observed); err != nil {
+				t.Fatalf("\n%s\ne.Observe(...): %v", tc.reason, err)
+			}
+			if diff := cmp.Diff(tc.want.status, observed.Status.AtProvider.Status); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want status, +got status:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.ready, observed.GetCondition(xpv1.TypeReady), test.EquateConditions()); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want ready condition, +got ready condition:\n%s\n", tc.reason, diff)
+			}
+
+			created := grpcKind("cool-list")
+			if _, err := e.Create(context.Background(), created); err != nil {
+				t.Fatalf("\n%s\ne.Create(...): %v", tc.reason, err)
+			}
+			if diff := cmp.Diff(tc.want.status, created.Status.AtProvider.Status); diff != "" {
+				t.Errorf("\n%s\ne.Create(...): -want status, +got status:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestReadyStatuses(t *testing.T) {
+	cases := map[string]struct {
+		reason string
+		pc     apisv1alpha1.ProviderConfigSpec
+		status string
+		want   xpv1.Condition
+	}{
+		"DefaultSuccess": {
+			reason: "A SUCCESS list should be Available by default.",
+			status: "SUCCESS",
+			want:   xpv1.Available(),
+		},
+		"ConfiguredReady": {
+			reason: "A list in one of the ProviderConfig's ready statuses should be Available.",
+			pc:     apisv1alpha1.ProviderConfigSpec{ReadyStatuses: []string{"SUCCESS", "ACTIVE"}},
+			status: "ACTIVE",
+			want:   xpv1.Available(),
+		},
+		"SuccessNotReady": {
+			reason: "A SUCCESS list should not be Available if SUCCESS isn't one of the ProviderConfig's ready statuses.",
+			pc:     apisv1alpha1.ProviderConfigSpec{ReadyStatuses: []string{"ACTIVE"}},
+			status: "SUCCESS",
+			want: xpv1.Condition{
+				Type:    xpv1.TypeReady,
+				Status:  corev1.ConditionFalse,
+				Reason:  reasonNotReadyStatus,
+				Message: `list status "SUCCESS" is not one of the ProviderConfig's ready statuses`,
+			},
+		},
+		"NotConfigured": {
+			reason: "A list status that isn't one of the ProviderConfig's ready statuses should be unknown.",
+			status: "ACTIVE",
+			want:   statusCondition("ACTIVE", metav1.Now()),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := external{pc: tc.pc, service: &ListService{grpcClient: &fakeListServiceClient{
+				MockGetList: func(_ context.Context, _ *listServicepb.GetListReq, _ ...grpc.CallOption) (*listServicepb.GetListResp, error) {
+					return &listServicepb.GetListResp{Status: tc.status}, nil
+				},
+				MockCreateList: func(_ context.Context, _ *listServicepb.CreateListReq, _ ...grpc.CallOption) (*listServicepb.CreateListResp, error) {
+					return &listServicepb.CreateListResp{Status: tc.status}, nil
+				},
+			}}}
+
+			observed := grpcKind("cool-list")
+			if _, err := e.Observe(context.Background(), observed); err != nil {
+				t.Fatalf("\n%s\ne.Observe(...): %v", tc.reason, err)
+			}
+			if diff := cmp.Diff(tc.want, observed.GetCondition(xpv1.TypeReady), test.EquateConditions()); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want ready condition, +got ready condition:\n%s\n", tc.reason, diff)
+			}
+
+			created := grpcKind("cool-list")
+			if _, err := e.Create(context.Background(), created); err != nil {
+				t.Fatalf("\n%s\ne.Create(...): %v", tc.reason, err)
+			}
+			if tc.want.Equal(xpv1.Available()) {
+				if diff := cmp.Diff(xpv1.Available(), created.GetCondition(xpv1.TypeReady), test.EquateConditions()); diff != "" {
+					t.Errorf("\n%s\ne.Create(...): -want ready condition, +got ready condition:\n%s\n", tc.reason, diff)
+				}
+			}
+		})
+	}
+}
+
+func TestObserveNoItems(t *testing.T) {
+	cases := map[string]struct {
+		reason string
+		spec   []int32
+		items  []int32
+	}{
+		"NilSpecNilResponse": {
+			reason: "A list without desired items reported without items should be up to date.",
+		},
+		"NilSpecEmptyResponse": {
+			reason: "A list without desired items reported with empty items should be up to date.",
+			items:  []int32{},
+		},
+		"EmptySpecNilResponse": {
+			reason: "A list with empty desired items reported without items should be up to date.",
+			spec:   []int32{},
+		},
+		"EmptySpecEmptyResponse": {
+			reason: "A list with empty desired items reported with empty items should be up to date.",
+			spec:   []int32{},
+			items:  []int32{},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := external{service: &ListService{grpcClient: &fakeListServiceClient{
+				MockGetList: func(_ context.Context, _ *listServicepb.GetListReq, _ ...grpc.CallOption) (*listServicepb.GetListResp, error) {
+					return &listServicepb.GetListResp{Status: "SUCCESS", Items: tc.items}, nil
+				},
+			}}}
+			cr := grpcKindWith("cool-list", func(cr *v1alpha1.GrpcKind) { cr.Spec.ForProvider.ListItems = tc.spec })
+			o, err := e.Observe(context.Background(), cr)
+			if err != nil {
+				t.Fatalf("\n%s\ne.Observe(...): %v", tc.reason, err)
+			}
+			if diff := cmp.Diff(true, o.ResourceUpToDate); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want up to date, +got up to date:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestObserveTimestamps(t *testing.T) {
+	created := time.Date(2022, 1, 2, 3, 4, 5, 0, time.UTC)
+	updated := time.Date(2022, 6, 7, 8, 9, 10, 0, time.UTC)
+
+	e := external{service: &ListService{grpcClient: &fakeListServiceClient{
+		MockGetList: func(_ context.Context, _ *listServicepb.GetListReq, opts ...grpc.CallOption) (*listServicepb.GetListResp, error) {
+			for _, o := range opts {
+				if h, ok := o.(grpc.HeaderCallOption); ok {
+					*h.HeaderAddr = metadata.Pairs(
+						createdAtKey, created.Format(time.RFC3339),
+						updatedAtKey, updated.Format(time.RFC3339),
+					)
+				}
+			}
+			return &listServicepb.GetListResp{Status: "SUCCESS"}, nil
+		},
+	}}}
+
+	cr := grpcKind("cool-list")
+	if _, err := e.Observe(context.Background(), cr); err != nil {
+		t.Fatalf("e.Observe(...): %v", err)
+	}
+
+	want := v1alpha1.GrpcKindObservation{
+		Status:    "SUCCESS",
+		CreatedAt: &metav1.Time{Time: created},
+		UpdatedAt: &metav1.Time{Time: updated},
+	}
+	if diff := cmp.Diff(want, cr.Status.AtProvider, cmpopts.IgnoreFields(v1alpha1.GrpcKindObservation{}, "Summary")); diff != "" {
+		t.Errorf("e.Observe(...): -want observation, +got observation:\n%s\n", diff)
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type args struct {
+		pc apisv1alpha1.ProviderConfigSpec
+		mg *v1alpha1.GrpcKind
+	}
+
+	type want struct {
+		req    *listServicepb.CreateListReq
+		access []string
+		ttl    []string
+		owner  []string
+		err    error
+	}
+
+	cases := map[string]struct {
+		reason string
+		args   args
+		want   want
+	}{
+		"UnderLimit": {
+			reason: "Lists within the ProviderConfig's item limit should be created.",
+			args: args{
+				pc: apisv1alpha1.ProviderConfigSpec{MaxListItems: int32Ptr(2)},
+				mg: grpcKindWith("cool-list", withItems(1, 2), withDescription(strPtr("cool"))),
+			},
+			want: want{
+				req: &listServicepb.CreateListReq{Name: "cool-list", Description: "cool"},
+			},
+		},
+		"WithAccess": {
+			reason: "A list's access level should be sent when it is created.",
+			args: args{
+				mg: grpcKindWith("cool-list", withAccessLevel(v1alpha1.AccessPublic)),
+			},
+			want: want{
+				req:    &listServicepb.CreateListReq{Name: "cool-list"},
+				access: []string{"Public"},
+			},
+		},
+		"DescriptionDisabled": {
+			reason: "A list's description should be omitted if the ProviderConfig disables sending descriptions.",
+			args: args{
+				pc: apisv1alpha1.ProviderConfigSpec{SendDescription: boolPtr(false)},
+				mg: grpcKindWith("cool-list", withDescription(strPtr("cool"))),
+			},
+			want: want{
+				req: &listServicepb.CreateListReq{Name: "cool-list"},
+			},
+		},
+		"WithOwner": {
+			reason: "A list's owner should be sent when it is created.",
+			args: args{
+				mg: grpcKindWith("cool-list", withListOwner("team-a")),
+			},
+			want: want{
+				req:   &listServicepb.CreateListReq{Name: "cool-list"},
+				owner: []string{"team-a"},
+			},
+		},
+		"WithTTL": {
+			reason: "A list's TTL should be sent when it is created.",
+			args: args{
+				mg: grpcKindWith("cool-list", withListTTL(90*time.Minute)),
+			},
+			want: want{
+				req: &listServicepb.CreateListReq{Name: "cool-list"},
+				ttl: []string{"1h30m0s"},
+			},
+		},
+		"OverLimit": {
+			reason: "Lists with more items than the ProviderConfig allows should not be created.",
+			args: args{
+				pc: apisv1alpha1.ProviderConfigSpec{MaxListItems: int32Ptr(2)},
+				mg: grpcKindWith("cool-list", withItems(1, 2, 3)),
+			},
+			want: want{
+				err: errors.Errorf(errTooManyItemsFmt, 3, 2),
+			},
+		},
+		"TooManyStructuredItems": {
+			reason: "Structured items should count towards the items the ProviderConfig allows.",
+			args: args{
+				pc: apisv1alpha1.ProviderConfigSpec{MaxListItems: int32Ptr(1)},
+				mg: grpcKindWith("cool-list", withListStructuredItems(`"a"`, `"b"`)),
+			},
+			want: want{
+				err: errors.Errorf(errTooManyItemsFmt, 2, 1),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var req *listServicepb.CreateListReq
+			var access, ttl, owner []string
+			e := external{pc: tc.args.pc, service: &ListService{grpcClient: &fakeListServiceClient{
+				MockCreateList: func(ctx context.Context, in *listServicepb.CreateListReq, _ ...grpc.CallOption) (*listServicepb.CreateListResp, error) {
+					md, _ := metadata.FromOutgoingContext(ctx)
+					access = md.Get(accessKey)
+					ttl = md.Get(ttlKey)
+					owner = md.Get(ownerKey)
+					req = in
+					return &listServicepb.CreateListResp{Status: "CREATED"}, nil
+				},
+			}}}
+			_, err := e.Create(context.Background(), tc.args.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\ne.Create(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.access, access); diff != "" {
+				t.Errorf("\n%s\ne.Create(...): -want access, +got access:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.ttl, ttl); diff != "" {
+				t.Errorf("\n%s\ne.Create(...): -want ttl, +got ttl:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.owner, owner); diff != "" {
+				t.Errorf("\n%s\ne.Create(...): -want owner, +got owner:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.req, req, protocmp.Transform()); diff != "" {
+				t.Errorf("\n%s\ne.Create(...): -want request, +got request:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestCreateStatus(t *testing.T) {
+	type want struct {
+		status string
+		ready  xpv1.Condition
+		err    error
+	}
+
+	cases := map[string]struct {
+		reason string
+		status string
+		want   want
+	}{
+		"Success": {
+			reason: "A list the backend created successfully should be available.",
+			status: "SUCCESS",
+			want: want{
+				status: "SUCCESS",
+				ready:  xpv1.Available(),
+			},
+		},
+		"Pending": {
+			reason: "A list the backend is still creating should still be creating.",
+			status: "PENDING",
+			want: want{
+				status: "PENDING",
+				ready:  xpv1.Creating(),
+			},
+		},
+		"Failed": {
+			reason: "A list the backend failed to create should not be recorded as created.",
+			status: "FAILED",
+			want: want{
+				status: "FAILED",
+				ready:  xpv1.Condition{Type: xpv1.TypeReady, Status: corev1.ConditionFalse, Reason: reasonCreateFailed},
+				err:    errors.Errorf(errCreateFailedFmt, "cool-list"),
+			},
+		},
+		"Other": {
+			reason: "Other statuses should be recorded, leaving Observe to determine whether the list is available.",
+			status: "CREATED",
+			want: want{
+				status: "CREATED",
+				ready:  xpv1.Creating(),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := external{service: &ListService{grpcClient: &fakeListServiceClient{
+				MockCreateList: func(_ context.Context, _ *listServicepb.CreateListReq, _ ...grpc.CallOption) (*listServicepb.CreateListResp, error) {
+					return &listServicepb.CreateListResp{Status: tc.status}, nil
+				},
+			}}}
+			cr := grpcKind("cool-list")
+			_, err := e.Create(context.Background(), cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\ne.Create(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.status, cr.Status.AtProvider.Status); diff != "" {
+				t.Errorf("\n%s\ne.Create(...): -want status, +got status:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.ready, cr.GetCondition(xpv1.TypeReady)); diff != "" {
+				t.Errorf("\n%s\ne.Create(...): -want Ready condition, +got Ready condition:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestCreateWait(t *testing.T) {
+	createWaitInterval = time.Millisecond
+	defer func() { createWaitInterval = time.Second }()
+
+	type want struct {
+		status string
+		gets   int
+		err    error
+	}
+
+	cases := map[string]struct {
+		reason   string
+		timeout  *metav1.Duration
+		statuses []string
+		want     want
+	}{
+		"NotConfigured": {
+			reason:   "Create should return while the list is pending unless the ProviderConfig has a CreateWaitTimeout.",
+			statuses: []string{"SUCCESS"},
+			want: want{
+				status: "PENDING",
+			},
+		},
+		"WaitsForSuccess": {
+			reason:   "Create should poll the backend until it reports the list was created.",
+			timeout:  &metav1.Duration{Duration: time.Minute},
+			statuses: []string{"PENDING", "PENDING", "SUCCESS"},
+			want: want{
+				status: "SUCCESS",
+				gets:   3,
+			},
+		},
+		"FailsWhileWaiting": {
+			reason:   "Create should fail if the backend fails to create the list while it waits.",
+			timeout:  &metav1.Duration{Duration: time.Minute},
+			statuses: []string{"PENDING", "FAILED"},
+			want: want{
+				status: "FAILED",
+				gets:   2,
+				err:    errors.Errorf(errCreateFailedFmt, "cool-list"),
+			},
+		},
+		"TimesOut": {
+			reason:   "Create should fail if the list is still pending when the CreateWaitTimeout expires.",
+			timeout:  &metav1.Duration{Duration: 20 * time.Millisecond},
+			statuses: []string{"PENDING"},
+			want: want{
+				status: "PENDING",
+				err:    errors.Errorf(errCreatePendingFmt, "cool-list", 20*time.Millisecond),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			gets := 0
+			e := external{pc: apisv1alpha1.ProviderConfigSpec{CreateWaitTimeout: tc.timeout}, service: &ListService{grpcClient: &fakeListServiceClient{
+				MockCreateList: func(_ context.Context, _ *listServicepb.CreateListReq, _ ...grpc.CallOption) (*listServicepb.CreateListResp, error) {
+					return &listServicepb.CreateListResp{Status: "PENDING"}, nil
+				},
+				MockGetList: func(_ context.Context, _ *listServicepb.GetListReq, _ ...grpc.CallOption) (*listServicepb.GetListResp, error) {
+					s := tc.statuses[len(tc.statuses)-1]
+					if gets < len(tc.statuses) {
+						s = tc.statuses[gets]
+					}
+					gets++
+					return &listServicepb.GetListResp{Status: s}, nil
+				},
+			}}}
+			cr := grpcKind("cool-list")
+			_, err := e.Create(context.Background(), cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\ne.Create(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.status, cr.Status.AtProvider.Status); diff != "" {
+				t.Errorf("\n%s\ne.Create(...): -want status, +got status:\n%s\n", tc.reason, diff)
+			}
+			if tc.want.gets > 0 {
+				if diff := cmp.Diff(tc.want.gets, gets); diff != "" {
+					t.Errorf("\n%s\ne.Create(...): -want GetList calls, +got GetList calls:\n%s\n", tc.reason, diff)
+				}
+			}
+		})
+	}
+}
+
+func TestCreateIdempotencyKey(t *testing.T) {
+	cr := grpcKind("cool-list")
+	cr.SetUID("cool-uid")
+
+	var keys []string
+	clk := testingclock.NewFakeClock(time.Now())
+	e := external{clock: clk, service: &ListService{grpcClient: &fakeListServiceClient{
+		MockCreateList: func(ctx context.Context, _ *listServicepb.CreateListReq, _ ...grpc.CallOption) (*listServicepb.CreateListResp, error) {
+			md, _ := metadata.FromOutgoingContext(ctx)
+			keys = append(keys, md.Get(idempotencyKey)...)
+			if len(keys) == 1 {
+				return &listServicepb.CreateListResp{Status: "FAILED"}, status.Error(codes.Internal, "boom")
+			}
+			return &listServicepb.CreateListResp{Status: "CREATED"}, nil
+		},
+	}}}
+
+	// The first attempt fails and is retried by the next reconcile once it's
+	// done backing off.
+	_, _ = e.Create(context.Background(), cr)
+	clk.Step(retryDelay(1))
+	if _, err := e.Create(context.Background(), cr); err != nil {
+		t.Fatalf("e.Create(...): %v", err)
+	}
+
+	if diff := cmp.Diff([]string{"cool-uid", "cool-uid"}, keys); diff != "" {
+		t.Errorf("e.Create(...): -want idempotency keys, +got idempotency keys:\n%s\n", diff)
+	}
+}
+
+func TestConditions(t *testing.T) {
+	type want struct {
+		during xpv1.Condition
+		after  xpv1.Condition
+	}
+
+	cases := map[string]struct {
+		reason string
+		call   func(e *external, cr *v1alpha1.GrpcKind) error
+		want   want
+	}{
+		"Create": {
+			reason: "A list should be Creating during CreateList and synced afterwards.",
+			call: func(e *external, cr *v1alpha1.GrpcKind) error {
+				_, err := e.Create(context.Background(), cr)
+				return err
+			},
+			want: want{
+				during: xpv1.Creating(),
+				after:  xpv1.ReconcileSuccess(),
+			},
+		},
+		"Update": {
+			reason: "A list should be Updating during UpdateListItems and synced afterwards.",
+			call: func(e *external, cr *v1alpha1.GrpcKind) error {
+				_, err := e.Update(context.Background(), cr)
+				return err
+			},
+			want: want{
+				during: updating(metav1.Now()),
+				after:  xpv1.ReconcileSuccess(),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			cr := grpcKind("cool-list")
+			var during xpv1.Condition
+			e := &external{service: &ListService{grpcClient: &fakeListServiceClient{
+				MockCreateList: func(_ context.Context, _ *listServicepb.CreateListReq, _ ...grpc.CallOption) (*listServicepb.CreateListResp, error) {
+					during = cr.GetCondition(xpv1.TypeReady)
+					return &listServicepb.CreateListResp{Status: "CREATED"}, nil
+				},
+				MockUpdateListItems: func(_ context.Context, _ *listServicepb.UpdateListItemsReq, _ ...grpc.CallOption) (*listServicepb.UpdateListItemsResp, error) {
+					during = cr.GetCondition(xpv1.TypeReady)
+					return &listServicepb.UpdateListItemsResp{Status: "UPDATED"}, nil
+				},
+			}}}
+			if err := tc.call(e, cr); err != nil {
+				t.Fatalf("\n%s\n%s(...): %v", tc.reason, name, err)
+			}
+			if !during.Equal(tc.want.during) {
+				t.Errorf("\n%s\n%s(...): want %v condition during RPC, got %v", tc.reason, name, tc.want.during, during)
+			}
+			if after := cr.GetCondition(xpv1.TypeSynced); !after.Equal(tc.want.after) {
+				t.Errorf("\n%s\n%s(...): want %v condition after RPC, got %v", tc.reason, name, tc.want.after, after)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type args struct {
+		pc apisv1alpha1.ProviderConfigSpec
+		mg *v1alpha1.GrpcKind
+	}
+
+	type want struct {
+		md      metadata.MD
+		applied *string
+		err     error
+	}
+
+	cases := map[string]struct {
+		reason string
+		args   args
+		want   want
+	}{
+		"SetToEmpty": {
+			reason: "Clearing the description should explicitly send an empty description.",
+			args: args{
+				mg: grpcKindWith("cool-list", withDescription(strPtr("")), withAppliedDescription(strPtr("cool"))),
+			},
+			want: want{
+				md:      metadata.Pairs(descriptionKey, ""),
+				applied: strPtr(""),
+			},
+		},
+		"SetToNil": {
+			reason: "Unsetting the description should leave the backend description alone.",
+			args: args{
+				mg: grpcKindWith("cool-list", withAppliedDescription(strPtr("cool"))),
+			},
+			want: want{
+				md: metadata.MD{},
+			},
+		},
+		"Changed": {
+			reason: "A changed description should be sent to the backend.",
+			args: args{
+				mg: grpcKindWith("cool-list", withDescription(strPtr("cooler")), withAppliedDescription(strPtr("cool"))),
+			},
+			want: want{
+				md:      metadata.Pairs(descriptionKey, "cooler"),
+				applied: strPtr("cooler"),
+			},
+		},
+		"DescriptionDisabled": {
+			reason: "A changed description should not be sent if the ProviderConfig disables sending descriptions.",
+			args: args{
+				pc: apisv1alpha1.ProviderConfigSpec{SendDescription: boolPtr(false)},
+				mg: grpcKindWith("cool-list", withDescription(strPtr("cooler")), withAppliedDescription(strPtr("cool"))),
+			},
+			want: want{
+				md:      metadata.MD{},
+				applied: strPtr("cool"),
+			},
+		},
+		"RepairOwner": {
+			reason: "The desired owner should be re-applied when the list is updated, repairing any drift.",
+			args: args{
+				mg: grpcKindWith("cool-list", withListOwner("team-a")),
+			},
+			want: want{
+				md: metadata.Pairs(ownerKey, "team-a"),
+			},
+		},
+		"RepairItemMetadata": {
+			reason: "The desired item metadata should be re-applied when the list is updated, repairing any drift.",
+			args: args{
+				mg: grpcKindWith("cool-list", withItems(1, 2), withListItemMetadata(v1alpha1.ListItemMetadata{}, v1alpha1.ListItemMetadata{Labels: map[string]string{"tier": "gold"}})),
+			},
+			want: want{
+				md: metadata.Pairs(itemMetadataKey, `[{"value":2,"labels":{"tier":"gold"}}]`),
+			},
+		},
+		"ApplyStructuredItems": {
+			reason: "Structured items and their metadata should be sent when the list is updated.",
+			args: args{
+				mg: grpcKindWith("cool-list", withListStructuredItems(`{"sku": "a-1"}`, `"b"`), withListItemMetadata(v1alpha1.ListItemMetadata{}, v1alpha1.ListItemMetadata{Weight: int32Ptr(2)})),
+			},
+			want: want{
+				md: metadata.Pairs(structuredItemsKey, `[{"value":{"sku":"a-1"}},{"value":"b","weight":2}]`),
+			},
+		},
+		"ReapplyTTL": {
+			reason: "The desired TTL should be re-applied when the list is updated.",
+			args: args{
+				mg: grpcKindWith("cool-list", withListTTL(time.Hour)),
+			},
+			want: want{
+				md: metadata.Pairs(ttlKey, "1h0m0s"),
+			},
+		},
+		"TooManyItems": {
+			reason: "Lists with more items than the ProviderConfig allows should be rejected.",
+			args: args{
+				pc: apisv1alpha1.ProviderConfigSpec{MaxListItems: int32Ptr(2)},
+				mg: grpcKindWith("cool-list", withItems(1, 2, 3)),
+			},
+			want: want{
+				md:  metadata.MD{},
+				err: errors.Errorf(errTooManyItemsFmt, 3, 2),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			md := metadata.MD{}
+			e := external{pc: tc.args.pc, service: &ListService{grpcClient: &fakeListServiceClient{
+				MockUpdateListItems: func(ctx context.Context, _ *listServicepb.UpdateListItemsReq, _ ...grpc.CallOption) (*listServicepb.UpdateListItemsResp, error) {
+					if got, ok := metadata.FromOutgoingContext(ctx); ok {
+						md = got
+					}
+					return &listServicepb.UpdateListItemsResp{Status: "UPDATED"}, nil
+				},
+			}}}
+			_, err := e.Update(context.Background(), tc.args.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\ne.Update(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.md, md); diff != "" {
+				t.Errorf("\n%s\ne.Update(...): -want metadata, +got metadata:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.applied, tc.args.mg.Status.AtProvider.Description); diff != "" {
+				t.Errorf("\n%s\ne.Update(...): -want applied description, +got applied description:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestUpdateBulk(t *testing.T) {
+	items := make([]int32, 2*bulkChunkSize+1)
+	for i := range items {
+		items[i] = int32(i)
+	}
+
+	type want struct {
+		chunks   [][]int32
+		fallback bool
+		err      error
+	}
+
+	cases := map[string]struct {
+		reason string
+		bulk   *fakeBulkUpdateClient
+		want   want
+	}{
+		"Streamed": {
+			reason: "Large updates should be streamed to BulkUpdateItems in chunks.",
+			bulk:   &fakeBulkUpdateClient{resp: &listServicepb.UpdateListItemsResp{Status: "UPDATED"}},
+			want: want{
+				chunks: [][]int32{items[:bulkChunkSize], items[bulkChunkSize : 2*bulkChunkSize], items[2*bulkChunkSize:]},
+			},
+		},
+		"StreamFailed": {
+			reason: "Errors returned by BulkUpdateItems should be returned.",
+			bulk:   &fakeBulkUpdateClient{err: status.Error(codes.Internal, "boom")},
+			want: want{
+				chunks: [][]int32{items[:bulkChunkSize], items[bulkChunkSize : 2*bulkChunkSize], items[2*bulkChunkSize:]},
+				err:    status.Error(codes.Internal, "boom"),
+			},
+		},
+		"Unimplemented": {
+			reason: "Large updates should fall back to UpdateListItems if the backend doesn't serve BulkUpdateItems.",
+			bulk:   &fakeBulkUpdateClient{err: status.Error(codes.Unimplemented, "unknown method")},
+			want: want{
+				chunks:   [][]int32{items[:bulkChunkSize], items[bulkChunkSize : 2*bulkChunkSize], items[2*bulkChunkSize:]},
+				fallback: true,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			fallback := false
+			e := external{service: &ListService{
+				bulkClient: tc.bulk,
+				grpcClient: &fakeListServiceClient{
+					MockUpdateListItems: func(_ context.Context, _ *listServicepb.UpdateListItemsReq, _ ...grpc.CallOption) (*listServicepb.UpdateListItemsResp, error) {
+						fallback = true
+						return &listServicepb.UpdateListItemsResp{Status: "UPDATED"}, nil
+					},
+				},
+			}}
+			_, err := e.Update(context.Background(), grpcKindWith("cool-list", withItems(items...)))
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\ne.Update(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.chunks, tc.bulk.chunks); diff != "" {
+				t.Errorf("\n%s\ne.Update(...): -want chunks, +got chunks:\n%s\n", tc.reason, diff)
+			}
+			if fallback != tc.want.fallback {
+				t.Errorf("\n%s\ne.Update(...): want UpdateListItems called %t, got %t", tc.reason, tc.want.fallback, fallback)
+			}
+		})
+	}
+}
+
+func TestObserveThenUpdate(t *testing.T) {
+	gets := 0
+	e := external{service: &ListService{grpcClient: &fakeListServiceClient{
+		MockGetList: func(_ context.Context, _ *listServicepb.GetListReq, _ ...grpc.CallOption) (*listServicepb.GetListResp, error) {
+			gets++
+			return &listServicepb.GetListResp{Status: "SUCCESS", Items: []int32{1}}, nil
+		},
+		MockUpdateListItems: func(_ context.Context, _ *listServicepb.UpdateListItemsReq, _ ...grpc.CallOption) (*listServicepb.UpdateListItemsResp, error) {
+			return &listServicepb.UpdateListItemsResp{Status: "UPDATED"}, nil
+		},
+	}}}
+	cr := grpcKindWith("cool-list", withItems(1, 2))
+
+	o, err := e.Observe(context.Background(), cr)
+	if err != nil {
+		t.Fatalf("e.Observe(...): %v", err)
+	}
+	if o.ResourceUpToDate {
+		t.Fatalf("e.Observe(...): want drift to be observed")
+	}
+	if _, err := e.Update(context.Background(), cr); err != nil {
+		t.Fatalf("e.Update(...): %v", err)
+	}
+	if gets != 1 {
+		t.Errorf("e.Update(...): want the list observed by Observe to be reused, got %d GetList calls", gets)
+	}
+	if e.observed != nil {
+		t.Errorf("e.Update(...): want the observed list to be forgotten once updated")
+	}
+}
+
+func TestUpdateUpToDate(t *testing.T) {
+	updates := 0
+	e := external{service: &ListService{grpcClient: &fakeListServiceClient{
+		MockGetList: func(_ context.Context, _ *listServicepb.GetListReq, _ ...grpc.CallOption) (*listServicepb.GetListResp, error) {
+			return &listServicepb.GetListResp{Status: "SUCCESS", Items: []int32{1, 2}}, nil
+		},
+		MockUpdateListItems: func(_ context.Context, _ *listServicepb.UpdateListItemsReq, _ ...grpc.CallOption) (*listServicepb.UpdateListItemsResp, error) {
+			updates++
+			return &listServicepb.UpdateListItemsResp{Status: "UPDATED"}, nil
+		},
+	}}}
+	cr := grpcKindWith("cool-list", withItems(1, 2))
+
+	o, err := e.Observe(context.Background(), cr)
+	if err != nil {
+		t.Fatalf("e.Observe(...): %v", err)
+	}
+	if !o.ResourceUpToDate {
+		t.Fatalf("e.Observe(...): want the list to be up to date")
+	}
+	if _, err := e.Update(context.Background(), cr); err != nil {
+		t.Fatalf("e.Update(...): %v", err)
+	}
+	if updates != 0 {
+		t.Errorf("e.Update(...): want no UpdateListItems call for a list observed up to date, got %d", updates)
+	}
+	if got := cr.GetCondition(xpv1.TypeReady).Reason; got == reasonUpdating {
+		t.Errorf("e.Update(...): want a list observed up to date not to be reported as updating")
+	}
+}
+
+func TestDelete(t *testing.T) {
+	errUnavailable := status.Error(codes.Unavailable, "backend unavailable")
+	errInternal := status.Error(codes.Internal, "boom")
+	deleteCtx, cancelDelete := context.WithCancel(context.Background())
+	defer cancelDelete()
+
+	type fields struct {
+		service *ListService
+	}
+
+	type args struct {
+		ctx context.Context
+		mg  resource.Managed
+	}
+
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		reason string
+		fields fields
+		args   args
+		want   want
+	}{
+		"UnavailableThenSuccess": {
+			reason: "A DeleteList call that fails with Unavailable should be retried.",
+			fields: fields{
+				service: &ListService{grpcClient: func() listServicepb.ListServiceClient {
+					calls := 0
+					return &fakeListServiceClient{
+						MockDeleteList: func(_ context.Context, _ *listServicepb.DeleteListReq, _ ...grpc.CallOption) (*listServicepb.DeleteListResp, error) {
+							calls++
+							if calls == 1 {
+								return nil, errUnavailable
+							}
+							return &listServicepb.DeleteListResp{Status: "DELETED"}, nil
+						},
+						MockGetList: listGone,
+					}
+				}()},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg:  grpcKind("cool-list"),
+			},
+			want: want{},
+		},
+		"NotFound": {
+			reason: "A list that no longer exists should be considered deleted.",
+			fields: fields{
+				service: &ListService{grpcClient: &fakeListServiceClient{
+					MockDeleteList: func(_ context.Context, _ *listServicepb.DeleteListReq, _ ...grpc.CallOption) (*listServicepb.DeleteListResp, error) {
+						return nil, status.Error(codes.NotFound, "gone")
+					},
+				}},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg:  grpcKind("cool-list"),
+			},
+			want: want{},
+		},
+		"OtherError": {
+			reason: "Errors that aren't retryable should be returned without retrying.",
+			fields: fields{
+				service: &ListService{grpcClient: func() listServicepb.ListServiceClient {
+					calls := 0
+					return &fakeListServiceClient{
+						MockDeleteList: func(_ context.Context, _ *listServicepb.DeleteListReq, _ ...grpc.CallOption) (*listServicepb.DeleteListResp, error) {
+							calls++
+							if calls > 1 {
+								return &listServicepb.DeleteListResp{Status: "DELETED"}, nil
+							}
+							return nil, errInternal
+						},
+						MockGetList: listGone,
+					}
+				}()},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg:  grpcKind("cool-list"),
+			},
+			want: want{
+				err: errInternal,
+			},
+		},
+		"Cancelled": {
+			reason: "Delete should return the context's error without calling DeleteList if its context was already cancelled.",
+			fields: fields{
+				service: &ListService{grpcClient: &fakeListServiceClient{
+					MockDeleteList: func(_ context.Context, _ *listServicepb.DeleteListReq, _ ...grpc.CallOption) (*listServicepb.DeleteListResp, error) {
+						return nil, errInternal
+					},
+				}},
+			},
+			args: args{
+				ctx: func() context.Context {
+					ctx, cancel := context.WithCancel(context.Background())
+					cancel()
+					return ctx
+				}(),
+				mg: grpcKind("cool-list"),
+			},
+			want: want{
+				err: context.Canceled,
+			},
+		},
+		"CancelledDuringCall": {
+			reason: "A DeleteList call that fails because its context was cancelled should return the context's error, so the delete is requeued.",
+			fields: fields{
+				service: &ListService{grpcClient: &fakeListServiceClient{
+					MockDeleteList: func(ctx context.Context, _ *listServicepb.DeleteListReq, _ ...grpc.CallOption) (*listServicepb.DeleteListResp, error) {
+						cancelDelete()
+						return nil, status.FromContextError(ctx.Err()).Err()
+					},
+				}},
+			},
+			args: args{
+				ctx: deleteCtx,
+				mg:  grpcKind("cool-list"),
+			},
+			want: want{
+				err: context.Canceled,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := external{service: tc.fields.service}
+			err := e.Delete(tc.args.ctx, tc.args.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\ne.Delete(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+// listGone is a MockGetList for a list that doesn't exist.
+func listGone(_ context.Context, in *listServicepb.GetListReq, _ ...grpc.CallOption) (*listServicepb.GetListResp, error) {
+	return nil, status.Errorf(codes.NotFound, "list %s does not exist", in.GetName())
+}
+
+func TestDeletePolicy(t *testing.T) {
+	type want struct {
+		deletes int
+		status  string
+	}
+
+	cases := map[string]struct {
+		reason string
+		policy *v1alpha1.DeletePolicy
+		status string
+		want   want
+	}{
+		"BlockingByDefault": {
+			reason: "Delete should record the list is being deleted, without waiting for it to be gone, if no delete policy is specified.",
+			want:   want{deletes: 1, status: "DELETING"},
+		},
+		"Blocking": {
+			reason: "Delete should record the list is being deleted, without waiting for it to be gone, using the Blocking delete policy.",
+			policy: func() *v1alpha1.DeletePolicy { p := v1alpha1.DeleteBlocking; return &p }(),
+			want:   want{deletes: 1, status: "DELETING"},
+		},
+		"AlreadyDeleting": {
+			reason: "Delete should not delete a list again while the backend is deleting it.",
+			status: "DELETING",
+			want:   want{deletes: 0, status: "DELETING"},
+		},
+		"Async": {
+			reason: "Delete should record the list was deleted as soon as the deletion was accepted using the Async delete policy.",
+			policy: func() *v1alpha1.DeletePolicy { p := v1alpha1.DeleteAsync; return &p }(),
+			want:   want{deletes: 1, status: "DELETED"},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			deletes := 0
+			// The fake has no MockGetList, so waiting for the list to be gone
+			// would panic.
+			e := external{service: &ListService{grpcClient: &fakeListServiceClient{
+				MockDeleteList: func(_ context.Context, _ *listServicepb.DeleteListReq, _ ...grpc.CallOption) (*listServicepb.DeleteListResp, error) {
+					deletes++
+					return &listServicepb.DeleteListResp{Status: "DELETED"}, nil
+				},
+			}}}
+			cr := grpcKindWith("cool-list", func(cr *v1alpha1.GrpcKind) {
+				cr.Spec.ForProvider.DeletePolicy = tc.policy
+				cr.Status.AtProvider.Status = tc.status
+			})
+			if err := e.Delete(context.Background(), cr); err != nil {
+				t.Fatalf("\n%s\ne.Delete(...): %v", tc.reason, err)
+			}
+			if diff := cmp.Diff(tc.want, want{deletes: deletes, status: cr.Status.AtProvider.Status}, cmp.AllowUnexported(want{})); diff != "" {
+				t.Errorf("\n%s\ne.Delete(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestObserveDeleted(t *testing.T) {
+	cases := map[string]struct {
+		reason string
+		status string
+		want   managed.ExternalObservation
+	}{
+		"Pending": {
+			reason: "A list that is being deleted should be reported to exist until Delete records it was deleted.",
+			want: managed.ExternalObservation{
+				ResourceExists:    true,
+				ResourceUpToDate:  true,
+				ConnectionDetails: managed.ConnectionDetails{},
+			},
+		},
+		"Deleted": {
+			reason: "A list Delete recorded as deleted should be reported not to exist.",
+			status: "DELETED",
+			want: managed.ExternalObservation{
+				ResourceUpToDate:  true,
+				ConnectionDetails: managed.ConnectionDetails{},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			cr := grpcKindWith("cool-list", func(cr *v1alpha1.GrpcKind) {
+				cr.SetDeletionTimestamp(&metav1.Time{Time: time.Now()})
+				cr.Status.AtProvider.Status = tc.status
+			})
+
+			// The fake has no MockGetList, so getting the list would panic.
+			e := external{service: &ListService{grpcClient: &fakeListServiceClient{}}}
+			o, err := e.Observe(context.Background(), cr)
+			if err != nil {
+				t.Fatalf("\n%s\ne.Observe(...): %v", tc.reason, err)
+			}
+			if diff := cmp.Diff(tc.want, o); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestObserveDeleting(t *testing.T) {
+	type observation struct {
+		o      managed.ExternalObservation
+		status string
+		reason xpv1.ConditionReason
+	}
+
+	cases := map[string]struct {
+		reason  string
+		deleted bool
+		want    []observation
+	}{
+		"DeletedByBackend": {
+			reason: "A list the backend reports as DELETING should exist and be deleting until the backend no longer reports it.",
+			want: []observation{
+				{
+					o:      managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true, ConnectionDetails: managed.ConnectionDetails{}},
+					status: "DELETING",
+					reason: xpv1.ReasonDeleting,
+				},
+				{
+					o:      managed.ExternalObservation{ResourceExists: false, ResourceUpToDate: true, ConnectionDetails: managed.ConnectionDetails{}},
+					status: "DELETING",
+					reason: xpv1.ReasonDeleting,
+				},
+			},
+		},
+		"DeletedByProvider": {
+			reason:  "A managed resource whose list was still being deleted should exist until the backend no longer reports it.",
+			deleted: true,
+			want: []observation{
+				{
+					o:      managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true, ConnectionDetails: managed.ConnectionDetails{}},
+					status: "DELETING",
+					reason: xpv1.ReasonDeleting,
+				},
+				{
+					o:      managed.ExternalObservation{ResourceExists: false, ResourceUpToDate: true, ConnectionDetails: managed.ConnectionDetails{}},
+					status: "DELETED",
+					reason: xpv1.ReasonDeleting,
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			gets := 0
+			e := external{service: &ListService{grpcClient: &fakeListServiceClient{
+				MockGetList: func(ctx context.Context, in *listServicepb.GetListReq, opts ...grpc.CallOption) (*listServicepb.GetListResp, error) {
+					gets++
+					if gets == 1 {
+						return &listServicepb.GetListResp{Status: "DELETING"}, nil
+					}
+					return listGone(ctx, in, opts...)
+				},
+			}}}
+			cr := grpcKindWith("cool-list", func(cr *v1alpha1.GrpcKind) {
+				if tc.deleted {
+					cr.SetDeletionTimestamp(&metav1.Time{Time: time.Now()})
+					cr.Status.AtProvider.Status = "DELETING"
+				}
+			})
+
+			for i, want := range tc.want {
+				o, err := e.Observe(context.Background(), cr)
+				if err != nil {
+					t.Fatalf("\n%s\ne.Observe(...) %d: %v", tc.reason, i, err)
+				}
+				if diff := cmp.Diff(want.o, o); diff != "" {
+					t.Errorf("\n%s\ne.Observe(...) %d: -want, +got:\n%s\n", tc.reason, i, diff)
+				}
+				if diff := cmp.Diff(want.status, cr.Status.AtProvider.Status); diff != "" {
+					t.Errorf("\n%s\ne.Observe(...) %d: -want status, +got status:\n%s\n", tc.reason, i, diff)
+				}
+				if got := cr.Status.GetCondition(xpv1.TypeReady).Reason; got != want.reason {
+					t.Errorf("\n%s\ne.Observe(...) %d: want Ready reason %q, got %q", tc.reason, i, want.reason, got)
+				}
+			}
+		})
+	}
+}
+
+func TestDeleteStream(t *testing.T) {
+	type want struct {
+		fallback bool
+		err      error
+	}
+
+	cases := map[string]struct {
+		reason string
+		stream *fakeDeleteListStream
+		want   want
+	}{
+		"Completed": {
+			reason: "Delete should succeed once the stream reports the list was deleted.",
+			stream: &fakeDeleteListStream{progress: []string{"DELETING", "DELETING", "DELETED"}, err: io.EOF},
+			want:   want{},
+		},
+		"Incomplete": {
+			reason: "Delete should fail if the stream ends before the list was deleted.",
+			stream: &fakeDeleteListStream{progress: []string{"DELETING"}, err: io.EOF},
+			want: want{
+				err: errors.Errorf(errDeleteIncompleteFmt, "cool-list"),
+			},
+		},
+		"Unimplemented": {
+			reason: "Delete should fall back to DeleteList if the backend doesn't serve DeleteListStream.",
+			stream: &fakeDeleteListStream{err: status.Error(codes.Unimplemented, "unknown method")},
+			want: want{
+				fallback: true,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			fallback := false
+			e := external{service: &ListService{
+				deleteClient: tc.stream,
+				grpcClient: &fakeListServiceClient{
+					MockDeleteList: func(_ context.Context, _ *listServicepb.DeleteListReq, _ ...grpc.CallOption) (*listServicepb.DeleteListResp, error) {
+						fallback = true
+						return &listServicepb.DeleteListResp{Status: "DELETED"}, nil
+					},
+					MockGetList: listGone,
+				},
+			}}
+			err := e.Delete(context.Background(), grpcKind("cool-list"))
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\ne.Delete(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if fallback != tc.want.fallback {
+				t.Errorf("\n%s\ne.Delete(...): want DeleteList called %t, got %t", tc.reason, tc.want.fallback, fallback)
+			}
+		})
+	}
+}
+
+func TestNotGrpcKind(t *testing.T) {
+	mg := &fake.Managed{}
+	e := external{service: &ListService{grpcClient: &fakeListServiceClient{}}}
+	c := connector{}
+
+	cases := map[string]func() error{
+		"Connect": func() error {
+			_, err := c.Connect(context.Background(), mg)
+			return err
+		},
+		"Observe": func() error {
+			_, err := e.Observe(context.Background(), mg)
+			return err
+		},
+		"Create": func() error {
+			_, err := e.Create(context.Background(), mg)
+			return err
+		},
+		"Update": func() error {
+			_, err := e.Update(context.Background(), mg)
+			return err
+		},
+		"Delete": func() error {
+			return e.Delete(context.Background(), mg)
+		},
+	}
+
+	for name, fn := range cases {
+		t.Run(name, func(t *testing.T) {
+			if err := fn(); !errors.Is(err, ErrNotGrpcKind) {
+				t.Errorf("%s(...): want error to be ErrNotGrpcKind, got %v", name, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,80 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpckind
+
+import (
+	"sync"
+
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/crossplane/provider-grpc/apis/mygroup/v1alpha1"
+)
+
+// An itemHistory remembers the items each managed resource's list had when it
+// was last observed.
+type itemHistory struct {
+	mu    sync.Mutex
+	items map[types.UID][]int32
+}
+
+// newItemHistory returns an empty itemHistory.
+func newItemHistory() *itemHistory {
+	return &itemHistory{items: make(map[types.UID][]int32)}
+}
+
+// Swap remembers the supplied items of the list of the managed resource with
+// the supplied UID, and returns the items it had when it was last observed.
+// ok is false if the list wasn't observed before. A nil itemHistory remembers
+// nothing.
+func (h *itemHistory) Swap(uid types.UID, items []int32) (prev []int32, ok bool) {
+	if h == nil {
+		return nil, false
+	}
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	prev, ok = h.items[uid]
+	h.items[uid] = items
+	return prev, ok
+}
+
+// Forget forgets the items of the managed resource with the supplied UID.
+func (h *itemHistory) Forget(uid types.UID) {
+	if h == nil {
+		return
+	}
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	delete(h.items, uid)
+}
+
+// recordItemChanges records the items the supplied GrpcKind's list gained and
+// lost since it was last observed, if they changed. The last changes are kept
+// until the items change again.
+func (c *external) recordItemChanges(cr *v1alpha1.GrpcKind, items []int32) {
+	prev, ok := c.history.Swap(cr.GetUID(), items)
+	if !ok {
+		return
+	}
+	added, removed := itemsDiff(items, prev)
+	if len(added) == 0 && len(removed) == 0 {
+		return
+	}
+	cr.Status.AtProvider.AddedItems = added
+	cr.Status.AtProvider.RemovedItems = removed
+}
@@ -0,0 +1,84 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpckind
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/grpc"
+
+	listServicepb "github.com/ashwinshirva/provider-grpc-server/proto"
+)
+
+func TestItemChanges(t *testing.T) {
+	type want struct {
+		added   []int32
+		removed []int32
+	}
+
+	cases := map[string]struct {
+		reason       string
+		observations [][]int32
+		want         want
+	}{
+		"FirstObservation": {
+			reason:       "No changes should be recorded the first time a list is observed.",
+			observations: [][]int32{{1, 2}},
+		},
+		"Changed": {
+			reason:       "Items the list gained and lost since it was last observed should be recorded.",
+			observations: [][]int32{{1, 2, 2}, {2, 3}},
+			want:         want{added: []int32{3}, removed: []int32{1, 2}},
+		},
+		"Unchanged": {
+			reason:       "The last changes should be kept while the list's items don't change.",
+			observations: [][]int32{{1}, {1, 2}, {1, 2}},
+			want:         want{added: []int32{2}},
+		},
+		"Reordered": {
+			reason:       "Reordering items should not record any changes.",
+			observations: [][]int32{{1, 2}, {2, 1}},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var items []int32
+			e := external{history: newItemHistory(), service: &ListService{grpcClient: &fakeListServiceClient{
+				MockGetList: func(_ context.Context, _ *listServicepb.GetListReq, _ ...grpc.CallOption) (*listServicepb.GetListResp, error) {
+					return &listServicepb.GetListResp{Status: "SUCCESS", Items: items}, nil
+				},
+			}}}
+			cr := grpcKind("cool-list")
+			cr.SetUID("cool-uid")
+
+			for _, items = range tc.observations {
+				if _, err := e.Observe(context.Background(), cr); err != nil {
+					t.Fatalf("\n%s\ne.Observe(...): %v", tc.reason, err)
+				}
+			}
+			if diff := cmp.Diff(tc.want.added, cr.Status.AtProvider.AddedItems); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want added items, +got added items:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.removed, cr.Status.AtProvider.RemovedItems); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want removed items, +got removed items:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
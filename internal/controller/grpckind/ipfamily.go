@@ -0,0 +1,87 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpckind
+
+import (
+	"context"
+	"net"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	apisv1alpha1 "github.com/crossplane/provider-grpc/apis/v1alpha1"
+)
+
+const errNoAddressesFmt = "host %q has no addresses"
+
+// A familyDialer connects to the addresses of a host, trying those of its
+// preferred IP family first. It falls back to the other family if none of
+// the preferred addresses can be connected to.
+type familyDialer struct {
+	family apisv1alpha1.IPFamily
+	lookup func(ctx context.Context, host string) ([]net.IPAddr, error)
+	dial   func(ctx context.Context, network, addr string) (net.Conn, error)
+}
+
+// newFamilyDialer returns a familyDialer that prefers the supplied IP family.
+func newFamilyDialer(f apisv1alpha1.IPFamily) *familyDialer {
+	return &familyDialer{family: f, lookup: net.DefaultResolver.LookupIPAddr, dial: (&net.Dialer{}).DialContext}
+}
+
+// DialContext connects to the supplied address, as passed by gRPC to a
+// custom dialer.
+func (d *familyDialer) DialContext(ctx context.Context, addr string) (net.Conn, error) {
+	// gRPC passes the target of unix sockets to custom dialers as is.
+	if path := strings.TrimPrefix(strings.TrimPrefix(addr, "unix:"), "//"); path != addr {
+		return d.dial(ctx, "unix", path)
+	}
+
+	host, port, err := net.SplitHostPort(addr)
+	if err != nil {
+		return nil, err
+	}
+	ips, err := d.lookup(ctx, host)
+	if err != nil {
+		return nil, err
+	}
+	if len(ips) == 0 {
+		return nil, errors.Errorf(errNoAddressesFmt, host)
+	}
+
+	// Try the preferred family first, otherwise keeping the order the
+	// addresses resolved in.
+	sort.SliceStable(ips, func(i, j int) bool {
+		return d.preferred(ips[i].IP) && !d.preferred(ips[j].IP)
+	})
+	for _, ip := range ips {
+		var conn net.Conn
+		conn, err = d.dial(ctx, "tcp", net.JoinHostPort(ip.String(), port))
+		if err == nil {
+			return conn, nil
+		}
+	}
+	return nil, err
+}
+
+// preferred returns true if the supplied IP is of the preferred family.
+func (d *familyDialer) preferred(ip net.IP) bool {
+	if d.family == apisv1alpha1.IPv6 {
+		return ip.To4() == nil
+	}
+	return ip.To4() != nil
+}
@@ -0,0 +1,139 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpckind
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	apisv1alpha1 "github.com/crossplane/provider-grpc/apis/v1alpha1"
+)
+
+func TestFamilyDialer(t *testing.T) {
+	errRefused := errors.New("connection refused")
+
+	type want struct {
+		dialed []string
+		err    error
+	}
+
+	cases := map[string]struct {
+		reason      string
+		family      apisv1alpha1.IPFamily
+		addr        string
+		unavailable map[string]bool
+		want        want
+	}{
+		"PreferIPv4": {
+			reason: "The host's IPv4 address should be dialed first if IPv4 is preferred.",
+			family: apisv1alpha1.IPv4,
+			addr:   "backend:50051",
+			want:   want{dialed: []string{"127.0.0.1:50051"}},
+		},
+		"PreferIPv6": {
+			reason: "The host's IPv6 address should be dialed first if IPv6 is preferred.",
+			family: apisv1alpha1.IPv6,
+			addr:   "backend:50051",
+			want:   want{dialed: []string{"[::1]:50051"}},
+		},
+		"FallBack": {
+			reason:      "The other family should be dialed if the preferred family can't be connected to.",
+			family:      apisv1alpha1.IPv6,
+			addr:        "backend:50051",
+			unavailable: map[string]bool{"[::1]:50051": true},
+			want:        want{dialed: []string{"[::1]:50051", "127.0.0.1:50051"}},
+		},
+		"Unavailable": {
+			reason:      "The last error should be returned if no address can be connected to.",
+			family:      apisv1alpha1.IPv4,
+			addr:        "backend:50051",
+			unavailable: map[string]bool{"[::1]:50051": true, "127.0.0.1:50051": true},
+			want:        want{dialed: []string{"127.0.0.1:50051", "[::1]:50051"}, err: errRefused},
+		},
+		"UnixSocket": {
+			reason: "Unix sockets should be dialed as is.",
+			family: apisv1alpha1.IPv6,
+			addr:   "unix:///var/run/lists.sock",
+			want:   want{dialed: []string{"unix /var/run/lists.sock"}},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var dialed []string
+			d := &familyDialer{
+				family: tc.family,
+				lookup: func(_ context.Context, _ string) ([]net.IPAddr, error) {
+					return []net.IPAddr{{IP: net.ParseIP("::1")}, {IP: net.ParseIP("127.0.0.1")}}, nil
+				},
+				dial: func(_ context.Context, network, addr string) (net.Conn, error) {
+					if network == "unix" {
+						addr = network + " " + addr
+					}
+					dialed = append(dialed, addr)
+					if tc.unavailable[addr] {
+						return nil, errRefused
+					}
+					c, _ := net.Pipe()
+					return c, nil
+				},
+			}
+
+			conn, err := d.DialContext(context.Background(), tc.addr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nd.DialContext(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.dialed, dialed); diff != "" {
+				t.Errorf("\n%s\nd.DialContext(...): -want dialed, +got dialed:\n%s\n", tc.reason, diff)
+			}
+			if conn != nil {
+				_ = conn.Close()
+			}
+		})
+	}
+}
+
+func TestDialPreferredIPFamily(t *testing.T) {
+	lis, err := net.Listen("tcp4", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("net.Listen(...): %v", err)
+	}
+	srv := grpc.NewServer()
+	healthpb.RegisterHealthServer(srv, &authorityHealthServer{})
+	go func() { _ = srv.Serve(lis) }()
+	defer srv.Stop()
+
+	// The backend only listens on IPv4, so preferring IPv6 falls back to it.
+	_, port, _ := net.SplitHostPort(lis.Addr().String())
+	conn, err := dialListService(net.JoinHostPort("localhost", port), transport{ipFamily: apisv1alpha1.IPv6})
+	if err != nil {
+		t.Fatalf("dialListService(...): %v", err)
+	}
+	defer func() { _ = conn.Close() }()
+
+	if _, err := healthpb.NewHealthClient(conn).Check(context.Background(), &healthpb.HealthCheckRequest{}); err != nil {
+		t.Errorf("Check(...): want a call to fall back to IPv4, got %v", err)
+	}
+}
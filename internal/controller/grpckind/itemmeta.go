@@ -0,0 +1,104 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpckind
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"sort"
+
+	"google.golang.org/grpc/metadata"
+
+	"github.com/crossplane/provider-grpc/apis/mygroup/v1alpha1"
+)
+
+// itemMetadataKey is the metadata key used to send the weights and labels of a
+// list's items with CreateList and UpdateListItems, and to receive them with
+// GetList's response header. The ListService items are plain int32s, so item
+// metadata is sent alongside them as a JSON array of itemMetadata.
+const itemMetadataKey = "x-list-item-metadata"
+
+// itemMetadata is the metadata of the list item with the supplied value.
+type itemMetadata struct {
+	Value  int32             `json:"value"`
+	Weight *int32            `json:"weight,omitempty"`
+	Labels map[string]string `json:"labels,omitempty"`
+}
+
+// desiredItemMetadata returns the metadata of each of the GrpcKind's items
+// that has a weight or labels, sorted by item value. It returns nil if no item
+// has metadata.
+func desiredItemMetadata(cr *v1alpha1.GrpcKind) []itemMetadata {
+	p := cr.Spec.ForProvider
+	var md []itemMetadata
+	for i, v := range p.ListItems {
+		if i >= len(p.ListItemMetadata) {
+			break
+		}
+		m := p.ListItemMetadata[i]
+		if m.Weight == nil && len(m.Labels) == 0 {
+			continue
+		}
+		md = append(md, itemMetadata{Value: v, Weight: m.Weight, Labels: m.Labels})
+	}
+	normalizeItemMetadata(md)
+	return md
+}
+
+// normalizeItemMetadata sorts the supplied item metadata by item value and
+// drops empty label maps, so that equivalent metadata compares equal.
+func normalizeItemMetadata(md []itemMetadata) {
+	for i := range md {
+		if len(md[i].Labels) == 0 {
+			md[i].Labels = nil
+		}
+	}
+	sort.SliceStable(md, func(i, j int) bool { return md[i].Value < md[j].Value })
+}
+
+// withItemMetadata returns a context that sends the GrpcKind's desired item
+// metadata to the backend, if any of its items have metadata.
+func withItemMetadata(ctx context.Context, cr *v1alpha1.GrpcKind) context.Context {
+	md := desiredItemMetadata(cr)
+	if len(md) == 0 {
+		return ctx
+	}
+	b, err := json.Marshal(md)
+	if err != nil {
+		return ctx
+	}
+	return metadata.AppendToOutgoingContext(ctx, itemMetadataKey, string(b))
+}
+
+// itemMetadataDrifted returns true if the backend reported item metadata in
+// the supplied response header that differs from the desired one. Metadata
+// that can't be parsed is considered different. Backends that don't report
+// item metadata never drift, nor do GrpcKinds whose items have no metadata.
+func itemMetadataDrifted(cr *v1alpha1.GrpcKind, header metadata.MD) bool {
+	want := desiredItemMetadata(cr)
+	got := header.Get(itemMetadataKey)
+	if len(want) == 0 || len(got) == 0 {
+		return false
+	}
+	var md []itemMetadata
+	if err := json.Unmarshal([]byte(got[0]), &md); err != nil {
+		return true
+	}
+	normalizeItemMetadata(md)
+	return !reflect.DeepEqual(want, md)
+}
@@ -0,0 +1,75 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpckind
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/crossplane/provider-grpc/apis/mygroup/v1alpha1"
+)
+
+func TestItemMetadataDrifted(t *testing.T) {
+	gold := v1alpha1.ListItemMetadata{Labels: map[string]string{"tier": "gold"}}
+
+	cases := map[string]struct {
+		reason string
+		mg     *v1alpha1.GrpcKind
+		header metadata.MD
+		want   bool
+	}{
+		"NoDesiredMetadata": {
+			reason: "Items without metadata should never drift.",
+			mg:     grpcKindWith("cool-list", withItems(1)),
+			header: metadata.Pairs(itemMetadataKey, `[{"value":1,"weight":2}]`),
+			want:   false,
+		},
+		"NotReported": {
+			reason: "Backends that don't report item metadata should never drift.",
+			mg:     grpcKindWith("cool-list", withItems(1), withListItemMetadata(gold)),
+			want:   false,
+		},
+		"Equal": {
+			reason: "Reported metadata equal to the desired metadata, regardless of order and empty labels, should not drift.",
+			mg:     grpcKindWith("cool-list", withItems(2, 1), withListItemMetadata(gold, v1alpha1.ListItemMetadata{Weight: int32Ptr(3)})),
+			header: metadata.Pairs(itemMetadataKey, `[{"value":1,"weight":3,"labels":{}},{"value":2,"labels":{"tier":"gold"}}]`),
+			want:   false,
+		},
+		"Different": {
+			reason: "Reported metadata that differs from the desired metadata should drift.",
+			mg:     grpcKindWith("cool-list", withItems(1), withListItemMetadata(gold)),
+			header: metadata.Pairs(itemMetadataKey, `[{"value":1,"labels":{"tier":"silver"}}]`),
+			want:   true,
+		},
+		"Unparseable": {
+			reason: "Reported metadata that can't be parsed should drift.",
+			mg:     grpcKindWith("cool-list", withItems(1), withListItemMetadata(gold)),
+			header: metadata.Pairs(itemMetadataKey, "gold"),
+			want:   true,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := itemMetadataDrifted(tc.mg, tc.header)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\n%s\nitemMetadataDrifted(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,169 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpckind
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/metadata"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	"github.com/crossplane/provider-grpc/apis/mygroup/v1alpha1"
+)
+
+// failedItemsKey is the UpdateListItems response header metadata key a
+// backend reports the items it failed to apply with, as a comma separated
+// list. The rest of the items were applied.
+const failedItemsKey = "x-list-failed-items"
+
+// retryItemsKey is the request metadata key that marks an UpdateListItems
+// request as carrying only the items the backend previously failed to apply.
+// The backend applies them without replacing the list's other items.
+const retryItemsKey = "x-list-retry-items"
+
+// TypeItemsApplied indicates whether the backend applied all of the items of
+// a GrpcKind's list when it was last updated.
+const TypeItemsApplied xpv1.ConditionType = "ItemsApplied"
+
+// Condition reasons used for the ItemsApplied condition.
+const (
+	reasonItemsApplied xpv1.ConditionReason = "ItemsApplied"
+	reasonItemsFailed  xpv1.ConditionReason = "ItemsFailed"
+)
+
+// An itemsFailedError is returned when the backend reports it failed to apply
+// some items of a list.
+type itemsFailedError struct {
+	items []int32
+}
+
+func (e *itemsFailedError) Error() string {
+	return fmt.Sprintf("backend failed to apply items %v", e.items)
+}
+
+// failedItems returns the items the backend reported it failed to apply in the
+// supplied response header. Values that aren't items are ignored.
+func failedItems(header metadata.MD) []int32 {
+	var items []int32
+	for _, v := range header.Get(failedItemsKey) {
+		for _, s := range strings.Split(v, ",") {
+			i, err := strconv.ParseInt(strings.TrimSpace(s), 10, 32)
+			if err != nil {
+				continue
+			}
+			items = append(items, int32(i))
+		}
+	}
+	return items
+}
+
+// An itemFailures remembers which items of each managed resource's list the
+// backend failed to apply, so that only they are retried.
+type itemFailures struct {
+	mu     sync.Mutex
+	failed map[types.UID]failedUpdate
+}
+
+type failedUpdate struct {
+	items  []int32
+	failed []int32
+}
+
+// newItemFailures returns an empty itemFailures.
+func newItemFailures() *itemFailures {
+	return &itemFailures{failed: make(map[types.UID]failedUpdate)}
+}
+
+// Retry returns the items of the list of the managed resource with the
+// supplied UID that the backend failed to apply when it was last updated with
+// the supplied items. It returns nil if the items differ from those of the
+// failed update. A nil itemFailures never retries.
+func (f *itemFailures) Retry(uid types.UID, items []int32) []int32 {
+	if f == nil {
+		return nil
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	u, ok := f.failed[uid]
+	if !ok || !reflect.DeepEqual(u.items, items) {
+		return nil
+	}
+	return u.failed
+}
+
+// Record remembers that the backend failed to apply the supplied failed items
+// when the list of the managed resource with the supplied UID was updated with
+// the supplied items.
+func (f *itemFailures) Record(uid types.UID, items, failed []int32) {
+	if f == nil {
+		return
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.failed[uid] = failedUpdate{items: items, failed: failed}
+}
+
+// Forget forgets the failed items of the managed resource with the supplied
+// UID.
+func (f *itemFailures) Forget(uid types.UID) {
+	if f == nil {
+		return
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	delete(f.failed, uid)
+}
+
+// recordItemResults records in the GrpcKind's status which items the backend
+// failed to apply when its list was last updated, which returned the supplied
+// error.
+func (c *external) recordItemResults(cr *v1alpha1.GrpcKind, err error) {
+	var fe *itemsFailedError
+	if errors.As(err, &fe) {
+		log.Infof("Backend failed to apply items %v of list \"%v\"", fe.items, logName(cr.Spec.ForProvider.Name))
+		cr.Status.AtProvider.FailedItems = fe.items
+		cr.Status.SetConditions(xpv1.Condition{
+			Type:               TypeItemsApplied,
+			Status:             corev1.ConditionFalse,
+			LastTransitionTime: c.now(),
+			Reason:             reasonItemsFailed,
+			Message:            fe.Error(),
+		})
+		return
+	}
+	if err == nil && cr.Status.AtProvider.FailedItems != nil {
+		cr.Status.AtProvider.FailedItems = nil
+		cr.Status.SetConditions(xpv1.Condition{
+			Type:               TypeItemsApplied,
+			Status:             corev1.ConditionTrue,
+			LastTransitionTime: c.now(),
+			Reason:             reasonItemsApplied,
+		})
+	}
+}
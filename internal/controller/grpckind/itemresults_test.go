@@ -0,0 +1,116 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpckind
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	corev1 "k8s.io/api/core/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	listServicepb "github.com/ashwinshirva/provider-grpc-server/proto"
+)
+
+func TestRetryFailedItems(t *testing.T) {
+	type update struct {
+		items []int32
+		retry bool
+	}
+
+	var sent []update
+	fail := "2,3"
+	e := external{
+		service: &ListService{grpcClient: &fakeListServiceClient{
+			MockUpdateListItems: func(ctx context.Context, req *listServicepb.UpdateListItemsReq, opts ...grpc.CallOption) (*listServicepb.UpdateListItemsResp, error) {
+				md, _ := metadata.FromOutgoingContext(ctx)
+				sent = append(sent, update{items: req.GetNewItems(), retry: len(md.Get(retryItemsKey)) > 0})
+				for _, o := range opts {
+					if h, ok := o.(grpc.HeaderCallOption); ok && fail != "" {
+						*h.HeaderAddr = metadata.Pairs(failedItemsKey, fail)
+					}
+				}
+				return &listServicepb.UpdateListItemsResp{Status: "UPDATED"}, nil
+			},
+		}},
+		failures: newItemFailures(),
+	}
+	cr := grpcKindWith("cool-list", withItems(1, 2, 3, 4))
+
+	// The backend fails to apply some items.
+	if _, err := e.Update(context.Background(), cr); err == nil {
+		t.Fatalf("e.Update(...): want an error when items fail to apply")
+	}
+	if diff := cmp.Diff([]int32{2, 3}, cr.Status.AtProvider.FailedItems); diff != "" {
+		t.Errorf("e.Update(...): -want failed items, +got failed items:\n%s\n", diff)
+	}
+	if got := cr.GetCondition(TypeItemsApplied); got.Status != corev1.ConditionFalse || got.Reason != reasonItemsFailed {
+		t.Errorf("e.Update(...): want %s condition, got %+v", reasonItemsFailed, got)
+	}
+
+	// Only the failed items are retried.
+	cr.Status.AtProvider.NextRetryTime = nil
+	fail = ""
+	if _, err := e.Update(context.Background(), cr); err != nil {
+		t.Fatalf("e.Update(...): unexpected error: %v", err)
+	}
+	if cr.Status.AtProvider.FailedItems != nil {
+		t.Errorf("e.Update(...): want failed items cleared, got %v", cr.Status.AtProvider.FailedItems)
+	}
+	want := xpv1.Condition{Type: TypeItemsApplied, Status: corev1.ConditionTrue, Reason: reasonItemsApplied}
+	if diff := cmp.Diff(want, cr.GetCondition(TypeItemsApplied), test.EquateConditions()); diff != "" {
+		t.Errorf("e.Update(...): -want condition, +got condition:\n%s\n", diff)
+	}
+
+	// Once everything was applied, the whole list is sent again.
+	if _, err := e.Update(context.Background(), cr); err != nil {
+		t.Fatalf("e.Update(...): unexpected error: %v", err)
+	}
+
+	wantSent := []update{
+		{items: []int32{1, 2, 3, 4}},
+		{items: []int32{2, 3}, retry: true},
+		{items: []int32{1, 2, 3, 4}},
+	}
+	if diff := cmp.Diff(wantSent, sent, cmp.AllowUnexported(update{})); diff != "" {
+		t.Errorf("e.Update(...): -want sent updates, +got sent updates:\n%s\n", diff)
+	}
+}
+
+func TestRetryFailedItemsSpecChanged(t *testing.T) {
+	f := newItemFailures()
+	f.Record("cool-uid", []int32{1, 2}, []int32{2})
+
+	if got := f.Retry("cool-uid", []int32{1, 2, 3}); got != nil {
+		t.Errorf("f.Retry(...): want no retry once the desired items changed, got %v", got)
+	}
+	if diff := cmp.Diff([]int32{2}, f.Retry("cool-uid", []int32{1, 2})); diff != "" {
+		t.Errorf("f.Retry(...): -want, +got:\n%s\n", diff)
+	}
+}
+
+func TestFailedItems(t *testing.T) {
+	got := failedItems(metadata.Pairs(failedItemsKey, "1, 2,nope", failedItemsKey, "-3"))
+	if diff := cmp.Diff([]int32{1, 2, -3}, got); diff != "" {
+		t.Errorf("failedItems(...): -want, +got:\n%s\n", diff)
+	}
+}
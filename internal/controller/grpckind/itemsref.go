@@ -0,0 +1,64 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpckind
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/crossplane/provider-grpc/apis/mygroup/v1alpha1"
+)
+
+const (
+	errGetItemsConfigMap = "cannot get ConfigMap to read list items from"
+	errNoItemsKeyFmt     = "ConfigMap %s/%s has no key %q"
+	errParseItemsFmt     = "cannot parse list items of ConfigMap key %q"
+)
+
+// resolveListItems sets the GrpcKind's list items to those of the ConfigMap
+// key it references, if any, and returns a function that restores the items
+// of its spec. Observe, Create, and Update defer the function, so that the
+// items are read every reconcile, and changes to the ConfigMap are observed as
+// drift, but are never written to the GrpcKind's spec.
+func (c *external) resolveListItems(ctx context.Context, cr *v1alpha1.GrpcKind) (func(), error) {
+	ref := cr.Spec.ForProvider.ListItemsFromRef
+	if ref == nil {
+		return func() {}, nil
+	}
+
+	cm := &corev1.ConfigMap{}
+	if err := c.kube.Get(ctx, types.NamespacedName{Namespace: ref.Namespace, Name: ref.Name}, cm); err != nil {
+		return nil, errors.Wrap(err, errGetItemsConfigMap)
+	}
+	v, ok := cm.Data[ref.Key]
+	if !ok {
+		return nil, errors.Errorf(errNoItemsKeyFmt, ref.Namespace, ref.Name, ref.Key)
+	}
+	items := []int32{}
+	if err := json.Unmarshal([]byte(v), &items); err != nil {
+		return nil, errors.Wrapf(err, errParseItemsFmt, ref.Key)
+	}
+
+	p := &cr.Spec.ForProvider
+	listItems, structured := p.ListItems, p.StructuredListItems
+	p.ListItems, p.StructuredListItems = items, nil
+	return func() { p.ListItems, p.StructuredListItems = listItems, structured }, nil
+}
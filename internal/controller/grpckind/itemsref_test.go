@@ -0,0 +1,165 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpckind
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	listServicepb "github.com/ashwinshirva/provider-grpc-server/proto"
+	"github.com/crossplane/provider-grpc/apis/mygroup/v1alpha1"
+)
+
+func withItemsFromRef(cr *v1alpha1.GrpcKind) {
+	cr.Spec.ForProvider.ListItemsFromRef = &v1alpha1.ConfigMapKeyReference{Namespace: "cool-ns", Name: "cool-items", Key: "items"}
+}
+
+func TestListItemsFromRef(t *testing.T) {
+	data := map[string]string{"items": "[1,2]"}
+	backend := []int32{1, 2}
+	var updated [][]int32
+	e := external{
+		kube: &test.MockClient{
+			MockGet: test.NewMockGetFn(nil, func(obj client.Object) error {
+				obj.(*corev1.ConfigMap).Data = data
+				return nil
+			}),
+		},
+		service: &ListService{grpcClient: &fakeListServiceClient{
+			MockGetList: func(_ context.Context, _ *listServicepb.GetListReq, _ ...grpc.CallOption) (*listServicepb.GetListResp, error) {
+				return &listServicepb.GetListResp{Status: statusSuccess, Items: backend}, nil
+			},
+			MockUpdateListItems: func(_ context.Context, req *listServicepb.UpdateListItemsReq, _ ...grpc.CallOption) (*listServicepb.UpdateListItemsResp, error) {
+				updated = append(updated, req.GetNewItems())
+				backend = req.GetNewItems()
+				return &listServicepb.UpdateListItemsResp{Status: statusSuccess}, nil
+			},
+		}},
+	}
+	cr := grpcKindWith("cool-list", withItemsFromRef)
+
+	observe := func(step string, wantUpToDate bool) {
+		t.Helper()
+		o, err := e.Observe(context.Background(), cr)
+		if err != nil {
+			t.Fatalf("%s: e.Observe(...): %v", step, err)
+		}
+		want := managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: wantUpToDate, ConnectionDetails: managed.ConnectionDetails{}}
+		if diff := cmp.Diff(want, o); diff != "" {
+			t.Errorf("%s: e.Observe(...): -want, +got:\n%s\n", step, diff)
+		}
+		if cr.Spec.ForProvider.ListItems != nil {
+			t.Errorf("%s: e.Observe(...): want the items read from the ConfigMap kept out of the spec, got %v", step, cr.Spec.ForProvider.ListItems)
+		}
+	}
+
+	// The backend list has the items of the ConfigMap.
+	observe("InSync", true)
+
+	// Changing the ConfigMap drifts the list, which is updated with the
+	// ConfigMap's new items.
+	data = map[string]string{"items": "[1,2,3]"}
+	observe("Drifted", false)
+	if _, err := e.Update(context.Background(), cr); err != nil {
+		t.Fatalf("e.Update(...): %v", err)
+	}
+	if diff := cmp.Diff([][]int32{{1, 2, 3}}, updated); diff != "" {
+		t.Errorf("e.Update(...): -want updated items, +got updated items:\n%s\n", diff)
+	}
+	if cr.Spec.ForProvider.ListItems != nil {
+		t.Errorf("e.Update(...): want the items read from the ConfigMap kept out of the spec, got %v", cr.Spec.ForProvider.ListItems)
+	}
+	observe("Updated", true)
+}
+
+func TestResolveListItems(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	type want struct {
+		items []int32
+		err   error
+	}
+
+	cases := map[string]struct {
+		reason string
+		data   map[string]string
+		getErr error
+		want   want
+	}{
+		"Resolved": {
+			reason: "The items should be read from the referenced ConfigMap key.",
+			data:   map[string]string{"items": "[3, 1, 2]"},
+			want:   want{items: []int32{3, 1, 2}},
+		},
+		"Empty": {
+			reason: "An empty array should resolve to no items.",
+			data:   map[string]string{"items": "[]"},
+			want:   want{items: []int32{}},
+		},
+		"NoKey": {
+			reason: "A ConfigMap without the referenced key should be an error.",
+			data:   map[string]string{"other": "[1]"},
+			want:   want{err: errors.Errorf(errNoItemsKeyFmt, "cool-ns", "cool-items", "items")},
+		},
+		"Malformed": {
+			reason: "A value that isn't a JSON array of int32s should be an error.",
+			data:   map[string]string{"items": "1,2"},
+			want:   want{err: errors.Wrapf(errors.New("invalid character ',' after top-level value"), errParseItemsFmt, "items")},
+		},
+		"GetFailed": {
+			reason: "Errors getting the ConfigMap should be returned.",
+			getErr: errBoom,
+			want:   want{err: errors.Wrap(errBoom, errGetItemsConfigMap)},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := external{kube: &test.MockClient{
+				MockGet: test.NewMockGetFn(tc.getErr, func(obj client.Object) error {
+					obj.(*corev1.ConfigMap).Data = tc.data
+					return nil
+				}),
+			}}
+			cr := grpcKindWith("cool-list", withItems(9), withItemsFromRef)
+
+			restore, err := e.resolveListItems(context.Background(), cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Fatalf("\n%s\ne.resolveListItems(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if err != nil {
+				return
+			}
+			if diff := cmp.Diff(tc.want.items, cr.Spec.ForProvider.ListItems); diff != "" {
+				t.Errorf("\n%s\ne.resolveListItems(...): -want items, +got items:\n%s\n", tc.reason, diff)
+			}
+			restore()
+			if diff := cmp.Diff([]int32{9}, cr.Spec.ForProvider.ListItems); diff != "" {
+				t.Errorf("\n%s\nrestore(): -want spec items, +got spec items:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
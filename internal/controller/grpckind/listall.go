@@ -0,0 +1,103 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpckind
+
+import (
+	"context"
+	"io"
+
+	"google.golang.org/grpc"
+
+	listServicepb "github.com/ashwinshirva/provider-grpc-server/proto"
+)
+
+// listListsMethod is a server-streaming RPC some backends serve in addition to
+// the generated ListService. It streams one message naming each list the
+// backend stores. The ListService has no message for this, so the request is
+// an empty GetListReq and each response a GetListReq naming a list.
+const listListsMethod = "/proto.ListService/ListLists"
+
+// A listListsClient lists the backend's lists using the ListLists RPC.
+type listListsClient interface {
+	ListLists(ctx context.Context, in *listServicepb.GetListReq, opts ...grpc.CallOption) (listListsStream, error)
+}
+
+// A listListsStream receives the names of the backend's lists.
+type listListsStream interface {
+	Recv() (*listServicepb.GetListReq, error)
+}
+
+// newListListsClient returns a listListsClient that uses the supplied
+// connection.
+func newListListsClient(cc grpc.ClientConnInterface) listListsClient {
+	return &listsClient{cc: cc}
+}
+
+type listsClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func (c *listsClient) ListLists(ctx context.Context, in *listServicepb.GetListReq, opts ...grpc.CallOption) (listListsStream, error) {
+	stream, err := c.cc.NewStream(ctx, &grpc.StreamDesc{StreamName: "ListLists", ServerStreams: true}, listListsMethod, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &listListsClientStream{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type listListsClientStream struct {
+	grpc.ClientStream
+}
+
+func (x *listListsClientStream) Recv() (*listServicepb.GetListReq, error) {
+	m := new(listServicepb.GetListReq)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// ListAll returns the names of all lists the backend stores, e.g. so that
+// tooling can find lists no GrpcKind manages. It returns an Unimplemented
+// status error if the backend doesn't serve ListLists. The stream passes the
+// connection's interceptors, e.g. its circuit breaker, but isn't made for a
+// GrpcKind, so it's neither bounded by a call timeout nor recorded in an RPC
+// history.
+func (s *ListService) ListAll(ctx context.Context) ([]string, error) {
+	stream, err := s.listsClient.ListLists(ctx, &listServicepb.GetListReq{})
+	if err != nil {
+		return nil, err
+	}
+	var names []string
+	for {
+		m, err := stream.Recv()
+		if err == io.EOF {
+			return names, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		names = append(names, m.GetName())
+	}
+}
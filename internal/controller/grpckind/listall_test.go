@@ -0,0 +1,101 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpckind
+
+import (
+	"context"
+	"io"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	listServicepb "github.com/ashwinshirva/provider-grpc-server/proto"
+)
+
+// A fakeListListsStream emits the supplied list names, then err.
+type fakeListListsStream struct {
+	names []string
+	err   error
+}
+
+func (f *fakeListListsStream) ListLists(_ context.Context, _ *listServicepb.GetListReq, _ ...grpc.CallOption) (listListsStream, error) {
+	return f, nil
+}
+
+func (f *fakeListListsStream) Recv() (*listServicepb.GetListReq, error) {
+	if len(f.names) == 0 {
+		return nil, f.err
+	}
+	n := f.names[0]
+	f.names = f.names[1:]
+	return &listServicepb.GetListReq{Name: n}, nil
+}
+
+func TestListAll(t *testing.T) {
+	errUnimplemented := status.Error(codes.Unimplemented, "unknown method")
+	errBoom := status.Error(codes.Internal, "boom")
+
+	type want struct {
+		names []string
+		err   error
+	}
+
+	cases := map[string]struct {
+		reason string
+		stream *fakeListListsStream
+		want   want
+	}{
+		"Several": {
+			reason: "ListAll should return the name of each list the backend streams.",
+			stream: &fakeListListsStream{names: []string{"cool-list", "cooler-list", "coolest-list"}, err: io.EOF},
+			want:   want{names: []string{"cool-list", "cooler-list", "coolest-list"}},
+		},
+		"None": {
+			reason: "ListAll should return no names if the backend stores no lists.",
+			stream: &fakeListListsStream{err: io.EOF},
+			want:   want{},
+		},
+		"Unimplemented": {
+			reason: "ListAll should return the backend's error if it doesn't serve ListLists.",
+			stream: &fakeListListsStream{err: errUnimplemented},
+			want:   want{err: errUnimplemented},
+		},
+		"Interrupted": {
+			reason: "ListAll should return an error rather than a partial set of names if the stream fails.",
+			stream: &fakeListListsStream{names: []string{"cool-list"}, err: errBoom},
+			want:   want{err: errBoom},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			s := &ListService{listsClient: tc.stream}
+			got, err := s.ListAll(context.Background())
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\ns.ListAll(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.names, got); diff != "" {
+				t.Errorf("\n%s\ns.ListAll(...): -want names, +got names:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
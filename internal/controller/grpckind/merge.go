@@ -0,0 +1,72 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpckind
+
+import (
+	"context"
+
+	listServicepb "github.com/ashwinshirva/provider-grpc-server/proto"
+
+	"github.com/crossplane/provider-grpc/apis/mygroup/v1alpha1"
+)
+
+// mergeStrategy returns the merge strategy of the supplied GrpcKind.
+func mergeStrategy(cr *v1alpha1.GrpcKind) v1alpha1.MergeStrategy {
+	if s := cr.Spec.ForProvider.MergeStrategy; s != nil {
+		return *s
+	}
+	return v1alpha1.MergeReplace
+}
+
+// itemsDrifted returns true if the supplied items the backend reported don't
+// satisfy the GrpcKind's desired items, allowing items to differ by the
+// supplied tolerance. Under the Union merge strategy the backend may have
+// items the GrpcKind doesn't, in any order.
+func itemsDrifted(cr *v1alpha1.GrpcKind, items []int32, tolerance int32) bool {
+	if mergeStrategy(cr) == v1alpha1.MergeUnion {
+		return len(itemsMissing(cr.Spec.ForProvider.ListItems, items, tolerance)) > 0
+	}
+	return !itemsEqual(cr.Spec.ForProvider.ListItems, items, tolerance)
+}
+
+// unionItems returns the supplied items the backend reported, followed by the
+// desired items the backend doesn't have an item within the supplied
+// tolerance of.
+func unionItems(want, got []int32, tolerance int32) []int32 {
+	added := itemsMissing(want, got, tolerance)
+	out := make([]int32, 0, len(got)+len(added))
+	out = append(out, got...)
+	return append(out, added...)
+}
+
+// mergeItems returns the items the GrpcKind's list should be updated to,
+// according to its merge strategy. Under the Union merge strategy these are
+// the items the backend currently has plus any desired items it's missing,
+// so that items other clients added aren't removed.
+func (c *external) mergeItems(ctx context.Context, cr *v1alpha1.GrpcKind) ([]int32, error) {
+	if mergeStrategy(cr) != v1alpha1.MergeUnion {
+		return cr.Spec.ForProvider.ListItems, nil
+	}
+	if c.observed != nil {
+		return unionItems(cr.Spec.ForProvider.ListItems, c.observed.items, itemTolerance(c.pc)), nil
+	}
+	resp, err := c.service.grpcClient.GetList(ctx, &listServicepb.GetListReq{Name: cr.Spec.ForProvider.Name})
+	if err != nil {
+		return nil, err
+	}
+	return unionItems(cr.Spec.ForProvider.ListItems, resp.GetItems(), itemTolerance(c.pc)), nil
+}
@@ -0,0 +1,119 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpckind
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/grpc"
+
+	listServicepb "github.com/ashwinshirva/provider-grpc-server/proto"
+	"github.com/crossplane/provider-grpc/apis/mygroup/v1alpha1"
+)
+
+func TestMergeStrategy(t *testing.T) {
+	type want struct {
+		upToDate bool
+		items    []int32
+	}
+
+	cases := map[string]struct {
+		reason   string
+		strategy *v1alpha1.MergeStrategy
+		server   []int32
+		desired  []int32
+		observe  bool
+		want     want
+	}{
+		"ReplaceByDefault": {
+			reason:  "Update should replace items other clients added if no merge strategy is specified.",
+			server:  []int32{1, 9},
+			desired: []int32{1, 2},
+			observe: true,
+			want:    want{upToDate: false, items: []int32{1, 2}},
+		},
+		"ReplaceServerAdded": {
+			reason:   "Items other clients added should be drift using the Replace merge strategy.",
+			strategy: func() *v1alpha1.MergeStrategy { s := v1alpha1.MergeReplace; return &s }(),
+			server:   []int32{1, 2, 9},
+			desired:  []int32{1, 2},
+			observe:  true,
+			want:     want{upToDate: false, items: []int32{1, 2}},
+		},
+		"UnionServerAdded": {
+			reason:   "Items other clients added shouldn't be drift using the Union merge strategy.",
+			strategy: func() *v1alpha1.MergeStrategy { s := v1alpha1.MergeUnion; return &s }(),
+			server:   []int32{9, 2, 1},
+			desired:  []int32{1, 2},
+			observe:  true,
+			want:     want{upToDate: true},
+		},
+		"UnionPreservesServerAdded": {
+			reason:   "Update should keep items other clients added using the Union merge strategy.",
+			strategy: func() *v1alpha1.MergeStrategy { s := v1alpha1.MergeUnion; return &s }(),
+			server:   []int32{1, 9},
+			desired:  []int32{1, 2},
+			observe:  true,
+			want:     want{upToDate: false, items: []int32{1, 9, 2}},
+		},
+		"UnionWithoutObserve": {
+			reason:   "Update should get the list's current items if it wasn't just observed using the Union merge strategy.",
+			strategy: func() *v1alpha1.MergeStrategy { s := v1alpha1.MergeUnion; return &s }(),
+			server:   []int32{9},
+			desired:  []int32{1, 2},
+			want:     want{items: []int32{9, 1, 2}},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var sent []int32
+			e := external{service: &ListService{grpcClient: &fakeListServiceClient{
+				MockGetList: func(_ context.Context, _ *listServicepb.GetListReq, _ ...grpc.CallOption) (*listServicepb.GetListResp, error) {
+					return &listServicepb.GetListResp{Status: "SUCCESS", Items: tc.server}, nil
+				},
+				MockUpdateListItems: func(_ context.Context, in *listServicepb.UpdateListItemsReq, _ ...grpc.CallOption) (*listServicepb.UpdateListItemsResp, error) {
+					sent = in.GetNewItems()
+					return &listServicepb.UpdateListItemsResp{Status: "UPDATED"}, nil
+				},
+			}}}
+			cr := grpcKindWith("cool-list", withItems(tc.desired...), func(cr *v1alpha1.GrpcKind) { cr.Spec.ForProvider.MergeStrategy = tc.strategy })
+
+			if tc.observe {
+				o, err := e.Observe(context.Background(), cr)
+				if err != nil {
+					t.Fatalf("e.Observe(...): %v", err)
+				}
+				if o.ResourceUpToDate != tc.want.upToDate {
+					t.Errorf("\n%s\ne.Observe(...): want ResourceUpToDate %t, got %t", tc.reason, tc.want.upToDate, o.ResourceUpToDate)
+				}
+				if o.ResourceUpToDate {
+					return
+				}
+			}
+
+			if _, err := e.Update(context.Background(), cr); err != nil {
+				t.Fatalf("e.Update(...): %v", err)
+			}
+			if diff := cmp.Diff(tc.want.items, sent); diff != "" {
+				t.Errorf("\n%s\ne.Update(...): -want items, +got items:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
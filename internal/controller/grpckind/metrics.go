@@ -0,0 +1,55 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpckind
+
+import (
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// Outcomes of drift detection recorded by Observe.
+const (
+	outcomeUpToDate          = "up_to_date"
+	outcomeDriftItems        = "drift_items"
+	outcomeDriftDescription  = "drift_description"
+	outcomeDriftAccess       = "drift_access"
+	outcomeDriftTTL          = "drift_ttl"
+	outcomeDriftOwner        = "drift_owner"
+	outcomeDriftItemMetadata = "drift_item_metadata"
+	outcomeNotFound          = "not_found"
+	outcomeDeleting          = "deleting"
+)
+
+// observeOutcomes counts how often Observe found a GrpcKind up to date, drifted
+// or missing from the backend.
+var observeOutcomes = prometheus.NewCounterVec(prometheus.CounterOpts{
+	Namespace: "provider_grpc",
+	Subsystem: "grpckind",
+	Name:      "observe_outcomes_total",
+	Help:      "Number of GrpcKind observations, by drift detection outcome.",
+}, []string{"outcome"})
+
+// registerMetrics registers the GrpcKind controller's metrics with the
+// supplied registerer. Metrics that are already registered are ignored.
+func registerMetrics(r prometheus.Registerer) error {
+	if err := r.Register(observeOutcomes); err != nil {
+		if are := (prometheus.AlreadyRegisteredError{}); !errors.As(err, &are) {
+			return err
+		}
+	}
+	return nil
+}
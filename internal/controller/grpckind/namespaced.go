@@ -0,0 +1,123 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpckind
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-grpc/apis/mygroup/v1alpha1"
+)
+
+// ErrNotNamespacedGrpcKind is returned when a managed resource passed to the
+// NamespacedGrpcKind controller is not a NamespacedGrpcKind.
+var ErrNotNamespacedGrpcKind = errors.New("managed resource is not a NamespacedGrpcKind custom resource")
+
+// Namespaced makes the controller reconcile namespace scoped
+// NamespacedGrpcKinds rather than cluster scoped GrpcKinds, so that tenants of
+// a cluster may be isolated by namespace using RBAC.
+var Namespaced = false
+
+// asGrpcKind returns a GrpcKind with the supplied NamespacedGrpcKind's type
+// and object metadata, spec, and status, so that it may be reconciled the
+// same way as a GrpcKind.
+func asGrpcKind(cr *v1alpha1.NamespacedGrpcKind) *v1alpha1.GrpcKind {
+	return &v1alpha1.GrpcKind{
+		TypeMeta:   cr.TypeMeta,
+		ObjectMeta: cr.ObjectMeta,
+		Spec:       cr.Spec,
+		Status:     cr.Status,
+	}
+}
+
+// fromGrpcKind updates the supplied NamespacedGrpcKind with any changes made
+// while reconciling the supplied GrpcKind returned by asGrpcKind.
+func fromGrpcKind(cr *v1alpha1.NamespacedGrpcKind, gk *v1alpha1.GrpcKind) {
+	cr.ObjectMeta = gk.ObjectMeta
+	cr.Spec = gk.Spec
+	cr.Status = gk.Status
+}
+
+// A namespacedConnector connects to the backend of NamespacedGrpcKinds by
+// connecting as if they were GrpcKinds.
+type namespacedConnector struct {
+	managed.ExternalConnecter
+}
+
+func (c namespacedConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.NamespacedGrpcKind)
+	if !ok {
+		return nil, ErrNotNamespacedGrpcKind
+	}
+	gk := asGrpcKind(cr)
+	e, err := c.ExternalConnecter.Connect(ctx, gk)
+	fromGrpcKind(cr, gk)
+	if err != nil {
+		return nil, err
+	}
+	return namespacedExternal{ExternalClient: e}, nil
+}
+
+// A namespacedExternal reconciles NamespacedGrpcKinds using the ExternalClient
+// of a GrpcKind.
+type namespacedExternal struct {
+	managed.ExternalClient
+}
+
+func (e namespacedExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.NamespacedGrpcKind)
+	if !ok {
+		return managed.ExternalObservation{}, ErrNotNamespacedGrpcKind
+	}
+	gk := asGrpcKind(cr)
+	defer fromGrpcKind(cr, gk)
+	return e.ExternalClient.Observe(ctx, gk)
+}
+
+func (e namespacedExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.NamespacedGrpcKind)
+	if !ok {
+		return managed.ExternalCreation{}, ErrNotNamespacedGrpcKind
+	}
+	gk := asGrpcKind(cr)
+	defer fromGrpcKind(cr, gk)
+	return e.ExternalClient.Create(ctx, gk)
+}
+
+func (e namespacedExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.NamespacedGrpcKind)
+	if !ok {
+		return managed.ExternalUpdate{}, ErrNotNamespacedGrpcKind
+	}
+	gk := asGrpcKind(cr)
+	defer fromGrpcKind(cr, gk)
+	return e.ExternalClient.Update(ctx, gk)
+}
+
+func (e namespacedExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.NamespacedGrpcKind)
+	if !ok {
+		return ErrNotNamespacedGrpcKind
+	}
+	gk := asGrpcKind(cr)
+	defer fromGrpcKind(cr, gk)
+	return e.ExternalClient.Delete(ctx, gk)
+}
@@ -0,0 +1,156 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpckind
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	listServicepb "github.com/ashwinshirva/provider-grpc-server/proto"
+	"github.com/crossplane/provider-grpc/apis/mygroup/v1alpha1"
+)
+
+// namespacedGrpcKind returns a NamespacedGrpcKind in the supplied namespace
+// that manages the list with the supplied name.
+func namespacedGrpcKind(namespace, name string) *v1alpha1.NamespacedGrpcKind {
+	gk := grpcKind(name)
+	cr := &v1alpha1.NamespacedGrpcKind{
+		ObjectMeta: gk.ObjectMeta,
+		Spec:       gk.Spec,
+	}
+	cr.SetNamespace(namespace)
+	cr.SetName(name)
+	return cr
+}
+
+func TestNamespacedObserve(t *testing.T) {
+	e := namespacedExternal{ExternalClient: &external{service: &ListService{grpcClient: &fakeListServiceClient{
+		MockGetList: func(_ context.Context, _ *listServicepb.GetListReq, _ ...grpc.CallOption) (*listServicepb.GetListResp, error) {
+			return &listServicepb.GetListResp{Status: statusSuccess, Items: []int32{1}}, nil
+		},
+	}}}}
+	cr := namespacedGrpcKind("team-a", "cool-list")
+	cr.Spec.ForProvider.ListItems = []int32{1, 2}
+
+	o, err := e.Observe(context.Background(), cr)
+	if err != nil {
+		t.Fatalf("e.Observe(...): unexpected error: %v", err)
+	}
+	want := managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false, ConnectionDetails: managed.ConnectionDetails{}}
+	if diff := cmp.Diff(want, o); diff != "" {
+		t.Errorf("e.Observe(...): -want, +got:\n%s\n", diff)
+	}
+	if diff := cmp.Diff(statusSuccess, cr.Status.AtProvider.Status); diff != "" {
+		t.Errorf("e.Observe(...): -want status, +got status:\n%s\n", diff)
+	}
+	if diff := cmp.Diff(xpv1.Available(), cr.GetCondition(xpv1.TypeReady), cmpopts.IgnoreFields(xpv1.Condition{}, "LastTransitionTime")); diff != "" {
+		t.Errorf("e.Observe(...): -want condition, +got condition:\n%s\n", diff)
+	}
+	if diff := cmp.Diff("team-a", cr.GetNamespace()); diff != "" {
+		t.Errorf("e.Observe(...): -want namespace, +got namespace:\n%s\n", diff)
+	}
+}
+
+func TestNamespacedCreate(t *testing.T) {
+	var created string
+	e := namespacedExternal{ExternalClient: &external{service: &ListService{grpcClient: &fakeListServiceClient{
+		MockCreateList: func(_ context.Context, req *listServicepb.CreateListReq, _ ...grpc.CallOption) (*listServicepb.CreateListResp, error) {
+			created = req.GetName()
+			return nil, status.Error(codes.AlreadyExists, "list cool-list already exists")
+		},
+	}}}}
+	cr := namespacedGrpcKind("team-a", "cool-list")
+	meta.SetExternalName(cr, "cool-namespacedgrpckind")
+
+	if _, err := e.Create(context.Background(), cr); err != nil {
+		t.Fatalf("e.Create(...): unexpected error: %v", err)
+	}
+	if diff := cmp.Diff("cool-list", created); diff != "" {
+		t.Errorf("e.Create(...): -want created list, +got created list:\n%s\n", diff)
+	}
+	if diff := cmp.Diff("cool-list", meta.GetExternalName(cr)); diff != "" {
+		t.Errorf("e.Create(...): -want external name, +got external name:\n%s\n", diff)
+	}
+	if diff := cmp.Diff(xpv1.ReconcileSuccess(), cr.GetCondition(xpv1.TypeSynced), cmpopts.IgnoreFields(xpv1.Condition{}, "LastTransitionTime")); diff != "" {
+		t.Errorf("e.Create(...): -want condition, +got condition:\n%s\n", diff)
+	}
+}
+
+func TestNamespacedDelete(t *testing.T) {
+	e := namespacedExternal{ExternalClient: &external{service: &ListService{grpcClient: &fakeListServiceClient{
+		MockDeleteList: func(_ context.Context, _ *listServicepb.DeleteListReq, _ ...grpc.CallOption) (*listServicepb.DeleteListResp, error) {
+			return nil, status.Error(codes.NotFound, "list cool-list does not exist")
+		},
+	}}}}
+	cr := namespacedGrpcKind("team-a", "cool-list")
+
+	if err := e.Delete(context.Background(), cr); err != nil {
+		t.Fatalf("e.Delete(...): unexpected error: %v", err)
+	}
+	if diff := cmp.Diff(statusDeleted, cr.Status.AtProvider.Status); diff != "" {
+		t.Errorf("e.Delete(...): -want status, +got status:\n%s\n", diff)
+	}
+}
+
+func TestNamespacedConnect(t *testing.T) {
+	var tracked resource.Managed
+	c := namespacedConnector{ExternalConnecter: managed.ExternalConnectorFn(func(_ context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+		if _, ok := mg.(*v1alpha1.GrpcKind); !ok {
+			return nil, ErrNotGrpcKind
+		}
+		tracked = mg
+		return &external{}, nil
+	})}
+	cr := namespacedGrpcKind("team-a", "cool-list")
+
+	e, err := c.Connect(context.Background(), cr)
+	if err != nil {
+		t.Fatalf("c.Connect(...): unexpected error: %v", err)
+	}
+	if _, ok := e.(namespacedExternal); !ok {
+		t.Errorf("c.Connect(...): want a namespacedExternal, got %T", e)
+	}
+	if diff := cmp.Diff("team-a", tracked.GetNamespace()); diff != "" {
+		t.Errorf("c.Connect(...): -want tracked namespace, +got tracked namespace:\n%s\n", diff)
+	}
+
+	if _, err := c.Connect(context.Background(), grpcKind("cool-list")); err != ErrNotNamespacedGrpcKind {
+		t.Errorf("c.Connect(...): want ErrNotNamespacedGrpcKind, got %v", err)
+	}
+}
+
+func TestAsGrpcKind(t *testing.T) {
+	cr := namespacedGrpcKind("team-a", "cool-list")
+	cr.TypeMeta = metav1.TypeMeta{APIVersion: v1alpha1.SchemeGroupVersion.String(), Kind: v1alpha1.NamespacedGrpcKindKind}
+
+	gk := asGrpcKind(cr)
+	if diff := cmp.Diff(v1alpha1.NamespacedGrpcKindGroupVersionKind, gk.GetObjectKind().GroupVersionKind()); diff != "" {
+		t.Errorf("asGrpcKind(...): -want GroupVersionKind, +got GroupVersionKind:\n%s\n", diff)
+	}
+}
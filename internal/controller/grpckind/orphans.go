@@ -0,0 +1,187 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpckind
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/utils/clock"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	listServicepb "github.com/ashwinshirva/provider-grpc-server/proto"
+	"github.com/crossplane/provider-grpc/apis/mygroup/v1alpha1"
+	apisv1alpha1 "github.com/crossplane/provider-grpc/apis/v1alpha1"
+)
+
+const (
+	errListProviderConfigs = "cannot list ProviderConfigs"
+	errListManaged         = "cannot list managed resources"
+)
+
+// OrphanCleanupInterval is how often backend lists that no GrpcKind manages
+// are looked for and deleted, e.g. lists left behind by a reconcile that
+// crashed. Zero disables cleaning up orphaned lists.
+var OrphanCleanupInterval time.Duration
+
+// OrphanGracePeriod is how long a list must have been found orphaned before it
+// is deleted, so that lists whose GrpcKind is still being created are kept.
+var OrphanGracePeriod = time.Hour
+
+// OrphanCleanupDryRun makes orphaned lists be logged rather than deleted.
+var OrphanCleanupDryRun = false
+
+// An orphanCollector periodically deletes backend lists that no managed
+// resource manages. It's conservative: a list is kept if any managed resource
+// manages a list with its name, whatever its ProviderConfig or tenant, and
+// only deleted once it has been found orphaned for the whole grace period.
+// Backends that don't serve ListLists are skipped.
+type orphanCollector struct {
+	kube     client.Client
+	connect  func(ctx context.Context, pc *apisv1alpha1.ProviderConfig) (*ListService, error)
+	interval time.Duration
+	grace    time.Duration
+	dryRun   bool
+	clock    clock.PassiveClock
+
+	// namespaced is true if the controller reconciles NamespacedGrpcKinds
+	// rather than GrpcKinds.
+	namespaced bool
+
+	mu sync.Mutex
+	// orphaned is when each list, identified by its ProviderConfig and name,
+	// was first found orphaned.
+	orphaned map[string]time.Time
+}
+
+// Start collects orphaned lists every interval until the supplied context is
+// done.
+func (oc *orphanCollector) Start(ctx context.Context) error {
+	wait.UntilWithContext(ctx, func(ctx context.Context) {
+		if _, err := oc.collect(ctx); err != nil {
+			log.Errorf("OrphanCleanup::Cannot clean up orphaned lists: %v", err)
+		}
+	}, oc.interval)
+	return nil
+}
+
+// NeedLeaderElection returns true, so that only the leader deletes lists.
+func (oc *orphanCollector) NeedLeaderElection() bool {
+	return true
+}
+
+// collect deletes the lists that have been orphaned for the grace period, or
+// only logs them in dry run mode, and returns their ProviderConfig qualified
+// names.
+func (oc *orphanCollector) collect(ctx context.Context) ([]string, error) {
+	managed, err := oc.managedLists(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	pcs := &apisv1alpha1.ProviderConfigList{}
+	if err := oc.kube.List(ctx, pcs); err != nil {
+		return nil, errors.Wrap(err, errListProviderConfigs)
+	}
+
+	oc.mu.Lock()
+	defer oc.mu.Unlock()
+
+	now := oc.clock.Now()
+	found := map[string]bool{}
+	var collected []string
+	for i := range pcs.Items {
+		pc := &pcs.Items[i]
+		svc, err := oc.connect(ctx, pc)
+		if err != nil {
+			log.Infof("OrphanCleanup::Cannot connect to the backend of ProviderConfig %q: %v", pc.GetName(), err)
+			continue
+		}
+		names, err := svc.ListAll(ctx)
+		if status.Code(err) == codes.Unimplemented {
+			continue
+		}
+		if err != nil {
+			log.Infof("OrphanCleanup::Cannot list the lists of ProviderConfig %q: %v", pc.GetName(), err)
+			continue
+		}
+		for _, name := range names {
+			if managed[name] {
+				continue
+			}
+			key := pc.GetName() + "/" + name
+			found[key] = true
+			first, ok := oc.orphaned[key]
+			if !ok {
+				oc.orphaned[key] = now
+				continue
+			}
+			if now.Sub(first) < oc.grace {
+				continue
+			}
+			if oc.dryRun {
+				log.Infof("OrphanCleanup::Would delete list %q of ProviderConfig %q, which no managed resource manages", logName(name), pc.GetName())
+				collected = append(collected, key)
+				continue
+			}
+			log.Infof("OrphanCleanup::Deleting list %q of ProviderConfig %q, which no managed resource manages", logName(name), pc.GetName())
+			if _, err := svc.grpcClient.DeleteList(ctx, &listServicepb.DeleteListReq{Name: name}); err != nil && status.Code(err) != codes.NotFound {
+				log.Errorf("OrphanCleanup::Cannot delete list %q of ProviderConfig %q: %v", logName(name), pc.GetName(), err)
+				continue
+			}
+			delete(oc.orphaned, key)
+			collected = append(collected, key)
+		}
+	}
+
+	// Lists that are gone or managed again are no longer orphaned.
+	for key := range oc.orphaned {
+		if !found[key] {
+			delete(oc.orphaned, key)
+		}
+	}
+	return collected, nil
+}
+
+// managedLists returns the names of the lists managed resources manage.
+func (oc *orphanCollector) managedLists(ctx context.Context) (map[string]bool, error) {
+	managed := map[string]bool{}
+	if oc.namespaced {
+		l := &v1alpha1.NamespacedGrpcKindList{}
+		if err := oc.kube.List(ctx, l); err != nil {
+			return nil, errors.Wrap(err, errListManaged)
+		}
+		for _, cr := range l.Items {
+			managed[cr.Spec.ForProvider.Name] = true
+		}
+		return managed, nil
+	}
+	l := &v1alpha1.GrpcKindList{}
+	if err := oc.kube.List(ctx, l); err != nil {
+		return nil, errors.Wrap(err, errListManaged)
+	}
+	for _, cr := range l.Items {
+		managed[cr.Spec.ForProvider.Name] = true
+	}
+	return managed, nil
+}
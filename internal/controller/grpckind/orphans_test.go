@@ -0,0 +1,203 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpckind
+
+import (
+	"context"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	testingclock "k8s.io/utils/clock/testing"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	listServicepb "github.com/ashwinshirva/provider-grpc-server/proto"
+	"github.com/crossplane/provider-grpc/apis/mygroup/v1alpha1"
+	apisv1alpha1 "github.com/crossplane/provider-grpc/apis/v1alpha1"
+)
+
+func TestCollectOrphans(t *testing.T) {
+	type want struct {
+		first     []string
+		collected []string
+		deleted   []string
+	}
+
+	cases := map[string]struct {
+		reason  string
+		dryRun  bool
+		managed []string
+		lists   []string
+		elapsed time.Duration
+		listErr error
+		want    want
+	}{
+		"Orphaned": {
+			reason:  "Lists no GrpcKind manages should be deleted once they have been orphaned for the grace period.",
+			managed: []string{"cool-list"},
+			lists:   []string{"cool-list", "orphaned-list"},
+			elapsed: time.Hour,
+			want: want{
+				collected: []string{"default/orphaned-list"},
+				deleted:   []string{"orphaned-list"},
+			},
+		},
+		"WithinGracePeriod": {
+			reason:  "Lists that haven't been orphaned for the grace period should be kept.",
+			managed: []string{"cool-list"},
+			lists:   []string{"cool-list", "orphaned-list"},
+			elapsed: time.Minute,
+			want:    want{},
+		},
+		"AllManaged": {
+			reason:  "Lists that GrpcKinds manage should never be deleted.",
+			managed: []string{"cool-list", "cooler-list"},
+			lists:   []string{"cool-list", "cooler-list"},
+			elapsed: time.Hour,
+			want:    want{},
+		},
+		"DryRun": {
+			reason:  "Orphaned lists should be reported but not deleted in dry run mode.",
+			dryRun:  true,
+			lists:   []string{"orphaned-list"},
+			elapsed: time.Hour,
+			want: want{
+				collected: []string{"default/orphaned-list"},
+			},
+		},
+		"Unimplemented": {
+			reason:  "Backends that don't serve ListLists should be skipped.",
+			listErr: status.Error(codes.Unimplemented, "unknown method"),
+			elapsed: time.Hour,
+			want:    want{},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var deleted []string
+			clk := testingclock.NewFakeClock(time.Now())
+			oc := &orphanCollector{
+				kube: &test.MockClient{
+					MockList: func(_ context.Context, obj client.ObjectList, _ ...client.ListOption) error {
+						switch l := obj.(type) {
+						case *v1alpha1.GrpcKindList:
+							for _, n := range tc.managed {
+								l.Items = append(l.Items, *grpcKind(n))
+							}
+						case *apisv1alpha1.ProviderConfigList:
+							l.Items = []apisv1alpha1.ProviderConfig{{ObjectMeta: metav1.ObjectMeta{Name: "default"}}}
+						}
+						return nil
+					},
+				},
+				connect: func(_ context.Context, _ *apisv1alpha1.ProviderConfig) (*ListService, error) {
+					err := tc.listErr
+					if err == nil {
+						err = io.EOF
+					}
+					return &ListService{
+						listsClient: &fakeListListsStream{names: append([]string{}, tc.lists...), err: err},
+						grpcClient: &fakeListServiceClient{
+							MockDeleteList: func(_ context.Context, req *listServicepb.DeleteListReq, _ ...grpc.CallOption) (*listServicepb.DeleteListResp, error) {
+								deleted = append(deleted, req.GetName())
+								return &listServicepb.DeleteListResp{}, nil
+							},
+						},
+					}, nil
+				},
+				grace:    30 * time.Minute,
+				dryRun:   tc.dryRun,
+				clock:    clk,
+				orphaned: map[string]time.Time{},
+			}
+
+			// The first collection only finds which lists are orphaned.
+			first, err := oc.collect(context.Background())
+			if err != nil {
+				t.Fatalf("\n%s\noc.collect(...): %v", tc.reason, err)
+			}
+			if diff := cmp.Diff(tc.want.first, first, cmpopts.EquateEmpty()); diff != "" {
+				t.Errorf("\n%s\noc.collect(...): -want first collected, +got first collected:\n%s\n", tc.reason, diff)
+			}
+
+			clk.Step(tc.elapsed)
+			collected, err := oc.collect(context.Background())
+			if err != nil {
+				t.Fatalf("\n%s\noc.collect(...): %v", tc.reason, err)
+			}
+			if diff := cmp.Diff(tc.want.collected, collected, cmpopts.EquateEmpty()); diff != "" {
+				t.Errorf("\n%s\noc.collect(...): -want collected, +got collected:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.deleted, deleted, cmpopts.EquateEmpty()); diff != "" {
+				t.Errorf("\n%s\noc.collect(...): -want deleted, +got deleted:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestCollectOrphansNoLongerOrphaned(t *testing.T) {
+	managed := false
+	clk := testingclock.NewFakeClock(time.Now())
+	oc := &orphanCollector{
+		kube: &test.MockClient{
+			MockList: func(_ context.Context, obj client.ObjectList, _ ...client.ListOption) error {
+				switch l := obj.(type) {
+				case *v1alpha1.GrpcKindList:
+					if managed {
+						l.Items = []v1alpha1.GrpcKind{*grpcKind("cool-list")}
+					}
+				case *apisv1alpha1.ProviderConfigList:
+					l.Items = []apisv1alpha1.ProviderConfig{{ObjectMeta: metav1.ObjectMeta{Name: "default"}}}
+				}
+				return nil
+			},
+		},
+		connect: func(_ context.Context, _ *apisv1alpha1.ProviderConfig) (*ListService, error) {
+			return &ListService{
+				listsClient: &fakeListListsStream{names: []string{"cool-list"}, err: io.EOF},
+				grpcClient: &fakeListServiceClient{
+					MockDeleteList: func(_ context.Context, _ *listServicepb.DeleteListReq, _ ...grpc.CallOption) (*listServicepb.DeleteListResp, error) {
+						t.Error("oc.collect(...): want no list deleted")
+						return nil, nil
+					},
+				},
+			}, nil
+		},
+		grace:    30 * time.Minute,
+		clock:    clk,
+		orphaned: map[string]time.Time{},
+	}
+
+	// The list is found orphaned, then its GrpcKind is created, then it's
+	// orphaned again. The grace period restarts.
+	for _, m := range []bool{false, true, false} {
+		managed = m
+		clk.Step(20 * time.Minute)
+		if _, err := oc.collect(context.Background()); err != nil {
+			t.Fatalf("oc.collect(...): %v", err)
+		}
+	}
+}
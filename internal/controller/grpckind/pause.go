@@ -0,0 +1,94 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpckind
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/clock"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+)
+
+const errUpdatePausedStatus = "cannot update status of paused managed resource"
+
+// AnnotationKeyPaused is the annotation that pauses reconciliation of a
+// GrpcKind when set to "true", e.g. to freeze its list during maintenance.
+// The managed reconciler this provider uses predates Crossplane's own support
+// for it, so the GrpcKind controller honors it itself.
+const AnnotationKeyPaused = "crossplane.io/paused"
+
+// reasonReconcilePaused is the reason of the Synced condition of a managed
+// resource whose reconciliation is paused.
+const reasonReconcilePaused xpv1.ConditionReason = "ReconcilePaused"
+
+// paused returns true if reconciliation of the supplied managed resource is
+// paused.
+func paused(mg resource.Managed) bool {
+	return mg.GetAnnotations()[AnnotationKeyPaused] == "true"
+}
+
+// reconcilePaused returns a condition that indicates reconciliation of a
+// managed resource is paused.
+func reconcilePaused() xpv1.Condition {
+	return xpv1.Condition{
+		Type:    xpv1.TypeSynced,
+		Status:  corev1.ConditionFalse,
+		Reason:  reasonReconcilePaused,
+		Message: "Reconciliation is paused by the " + AnnotationKeyPaused + " annotation",
+	}
+}
+
+// A pausingReconciler only lets the managed reconciler it wraps reconcile
+// managed resources whose reconciliation isn't paused. Paused resources are
+// never connected to their backend. Their Synced condition reports they're
+// paused, and they aren't requeued; removing the annotation triggers the
+// next reconcile.
+//
+// Deleting a paused resource is deferred too: the resource keeps its
+// finalizer, and its list is left alone, until reconciliation resumes and the
+// managed reconciler deletes both.
+type pausingReconciler struct {
+	reconcile.Reconciler
+	kube       client.Client
+	newManaged func() resource.Managed
+	clock      clock.PassiveClock
+}
+
+func (r *pausingReconciler) Reconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+	mg := r.newManaged()
+	if err := r.kube.Get(ctx, req.NamespacedName, mg); err != nil || !paused(mg) {
+		// The managed reconciler handles resources it can't get.
+		return r.Reconciler.Reconcile(ctx, req)
+	}
+	log.Infof("Reconciliation of \"%v\" is paused", mg.GetName())
+
+	c := reconcilePaused()
+	if mg.GetCondition(xpv1.TypeSynced).Equal(c) {
+		return reconcile.Result{}, nil
+	}
+	c.LastTransitionTime = metav1.NewTime(r.clock.Now())
+	mg.SetConditions(c)
+	return reconcile.Result{}, errors.Wrap(resource.IgnoreNotFound(r.kube.Status().Update(ctx, mg)), errUpdatePausedStatus)
+}
@@ -0,0 +1,131 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpckind
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	testingclock "k8s.io/utils/clock/testing"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-grpc/apis"
+	"github.com/crossplane/provider-grpc/apis/mygroup/v1alpha1"
+)
+
+func TestPaused(t *testing.T) {
+	now := metav1.NewTime(time.Date(2022, 6, 1, 12, 0, 0, 0, time.UTC))
+	deleted := func(cr *v1alpha1.GrpcKind) {
+		cr.SetDeletionTimestamp(&now)
+		cr.SetFinalizers([]string{"finalizer.managedresource.crossplane.io"})
+	}
+	pause := func(cr *v1alpha1.GrpcKind) {
+		cr.SetAnnotations(map[string]string{AnnotationKeyPaused: "true"})
+	}
+
+	type want struct {
+		reconciled bool
+		synced     xpv1.Condition
+		finalizers []string
+	}
+
+	cases := map[string]struct {
+		reason string
+		mods   []grpcKindModifier
+		want   want
+	}{
+		"NotPaused": {
+			reason: "A GrpcKind whose reconciliation isn't paused should be reconciled.",
+			want:   want{reconciled: true},
+		},
+		"Paused": {
+			reason: "A paused GrpcKind should not be reconciled, and should report it's paused.",
+			mods:   []grpcKindModifier{pause},
+			want:   want{synced: xpv1.Condition{Type: xpv1.TypeSynced, Status: corev1.ConditionFalse, LastTransitionTime: now, Reason: reasonReconcilePaused, Message: reconcilePaused().Message}},
+		},
+		"PausedDeleted": {
+			reason: "Deleting a paused GrpcKind should be deferred until reconciliation resumes, keeping its finalizer.",
+			mods:   []grpcKindModifier{pause, deleted},
+			want: want{
+				synced:     xpv1.Condition{Type: xpv1.TypeSynced, Status: corev1.ConditionFalse, LastTransitionTime: now, Reason: reasonReconcilePaused, Message: reconcilePaused().Message},
+				finalizers: []string{"finalizer.managedresource.crossplane.io"},
+			},
+		},
+		"Unpaused": {
+			reason: "A GrpcKind whose paused annotation isn't true should be reconciled.",
+			mods: []grpcKindModifier{func(cr *v1alpha1.GrpcKind) {
+				cr.SetAnnotations(map[string]string{AnnotationKeyPaused: "false"})
+			}},
+			want: want{reconciled: true},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			s := runtime.NewScheme()
+			if err := apis.AddToScheme(s); err != nil {
+				t.Fatalf("apis.AddToScheme(...): %v", err)
+			}
+			cr := grpcKindWith("cool-list", tc.mods...)
+			cr.SetName("cool")
+			kube := fake.NewClientBuilder().WithScheme(s).WithObjects(cr).Build()
+
+			reconciled := false
+			r := &pausingReconciler{
+				Reconciler: reconcile.Func(func(_ context.Context, _ reconcile.Request) (reconcile.Result, error) {
+					reconciled = true
+					return reconcile.Result{}, nil
+				}),
+				kube:       kube,
+				newManaged: func() resource.Managed { return &v1alpha1.GrpcKind{} },
+				clock:      testingclock.NewFakeClock(now.Time),
+			}
+
+			nn := types.NamespacedName{Name: "cool"}
+			got, err := r.Reconcile(context.Background(), reconcile.Request{NamespacedName: nn})
+			if err != nil {
+				t.Fatalf("\n%s\nr.Reconcile(...): unexpected error: %v", tc.reason, err)
+			}
+			if diff := cmp.Diff(reconcile.Result{}, got); diff != "" {
+				t.Errorf("\n%s\nr.Reconcile(...): -want result, +got result:\n%s\n", tc.reason, diff)
+			}
+
+			stored := &v1alpha1.GrpcKind{}
+			if err := kube.Get(context.Background(), nn, stored); err != nil {
+				t.Fatalf("kube.Get(...): %v", err)
+			}
+			w := want{reconciled: reconciled, finalizers: stored.GetFinalizers()}
+			if c := stored.GetCondition(xpv1.TypeSynced); c.Reason != "" {
+				w.synced = c
+			}
+			if diff := cmp.Diff(tc.want, w, test.EquateConditions(), cmp.AllowUnexported(want{})); diff != "" {
+				t.Errorf("\n%s\nr.Reconcile(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
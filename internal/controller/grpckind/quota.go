@@ -0,0 +1,56 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpckind
+
+import (
+	"strconv"
+
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/crossplane/provider-grpc/apis/mygroup/v1alpha1"
+)
+
+// GetList response header metadata keys a backend may use to report how much
+// of its quota the tenant a list belongs to has used, and what the quota is.
+const (
+	quotaUsedKey  = "x-quota-used"
+	quotaLimitKey = "x-quota-limit"
+)
+
+// recordQuota records the quota usage reported in the supplied response
+// header in the status of the supplied GrpcKind. Backends that don't report
+// quota usage leave it unset.
+func recordQuota(cr *v1alpha1.GrpcKind, header metadata.MD) {
+	cr.Status.AtProvider.QuotaUsed = headerInt(header, quotaUsedKey)
+	cr.Status.AtProvider.QuotaLimit = headerInt(header, quotaLimitKey)
+}
+
+// headerInt returns the integer found at the supplied key of the response
+// header metadata, or nil if there is no valid integer.
+func headerInt(md metadata.MD, key string) *int64 {
+	v := md.Get(key)
+	if len(v) == 0 {
+		return nil
+	}
+	i, err := strconv.ParseInt(v[0], 10, 64)
+	if err != nil {
+		log.Infof("Observe::Ignoring invalid %s integer %q: %v", key, v[0], err)
+		return nil
+	}
+	return &i
+}
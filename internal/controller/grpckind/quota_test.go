@@ -0,0 +1,83 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpckind
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	listServicepb "github.com/ashwinshirva/provider-grpc-server/proto"
+)
+
+func TestObserveQuota(t *testing.T) {
+	type want struct {
+		used  *int64
+		limit *int64
+	}
+
+	cases := map[string]struct {
+		reason string
+		header metadata.MD
+		want   want
+	}{
+		"Reported": {
+			reason: "The quota usage the backend reports should be recorded in the observation.",
+			header: metadata.Pairs(quotaUsedKey, "42", quotaLimitKey, "100"),
+			want:   want{used: int64Ptr(42), limit: int64Ptr(100)},
+		},
+		"NotReported": {
+			reason: "Backends that don't report quota usage should leave it unset.",
+			header: metadata.MD{},
+			want:   want{},
+		},
+		"Invalid": {
+			reason: "Invalid quota usage should be ignored.",
+			header: metadata.Pairs(quotaUsedKey, "lots", quotaLimitKey, "100"),
+			want:   want{limit: int64Ptr(100)},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := external{service: &ListService{grpcClient: &fakeListServiceClient{
+				MockGetList: func(_ context.Context, _ *listServicepb.GetListReq, opts ...grpc.CallOption) (*listServicepb.GetListResp, error) {
+					for _, o := range opts {
+						if h, ok := o.(grpc.HeaderCallOption); ok {
+							*h.HeaderAddr = tc.header
+						}
+					}
+					return &listServicepb.GetListResp{Status: statusSuccess, Items: []int32{1}}, nil
+				},
+			}}}
+			cr := grpcKindWith("cool-list", withItems(1))
+
+			if _, err := e.Observe(context.Background(), cr); err != nil {
+				t.Fatalf("\n%s\ne.Observe(...): %v", tc.reason, err)
+			}
+			if diff := cmp.Diff(tc.want.used, cr.Status.AtProvider.QuotaUsed); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want quota used, +got quota used:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.limit, cr.Status.AtProvider.QuotaLimit); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want quota limit, +got quota limit:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
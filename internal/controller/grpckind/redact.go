@@ -0,0 +1,50 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpckind
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+)
+
+// RedactLogs makes the controller hash the names of lists and mask their
+// descriptions when it logs them, or describes them in events and errors, for
+// backends whose lists may carry sensitive data. Hashed names still tell lists
+// apart.
+var RedactLogs = false
+
+// redactedDescription replaces descriptions when logs are redacted.
+const redactedDescription = "<redacted>"
+
+// logName returns the supplied list name as it should be logged.
+func logName(name string) string {
+	if !RedactLogs {
+		return name
+	}
+	sum := sha256.Sum256([]byte(name))
+	return "sha256:" + hex.EncodeToString(sum[:6])
+}
+
+// logDescription returns the supplied list description, quoted, as it should
+// be logged.
+func logDescription(d string) string {
+	if !RedactLogs {
+		return fmt.Sprintf("%q", d)
+	}
+	return redactedDescription
+}
@@ -0,0 +1,135 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpckind
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"strings"
+	"testing"
+
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+
+	listServicepb "github.com/ashwinshirva/provider-grpc-server/proto"
+	"github.com/crossplane/provider-grpc/apis/mygroup/v1alpha1"
+)
+
+func TestRedactLogs(t *testing.T) {
+	const (
+		name        = "patient-1234"
+		description = "Jane Doe, date of birth 1970-01-01"
+	)
+
+	cases := map[string]struct {
+		reason   string
+		redact   bool
+		want     []string
+		wantNone []string
+	}{
+		"Redacted": {
+			reason:   "The list's name should be hashed and its description masked when logs are redacted.",
+			redact:   true,
+			want:     []string{"sha256:", redactedDescription},
+			wantNone: []string{name, description},
+		},
+		"NotRedacted": {
+			reason: "The list's name and description should be logged as is by default.",
+			want:   []string{name, description},
+		},
+	}
+
+	for n, tc := range cases {
+		t.Run(n, func(t *testing.T) {
+			defer func(r bool) { RedactLogs = r }(RedactLogs)
+			RedactLogs = tc.redact
+
+			var buf bytes.Buffer
+			log.SetOutput(&buf)
+			defer log.SetOutput(os.Stderr)
+
+			e := external{service: &ListService{grpcClient: &fakeListServiceClient{
+				MockGetList: func(_ context.Context, _ *listServicepb.GetListReq, _ ...grpc.CallOption) (*listServicepb.GetListResp, error) {
+					return &listServicepb.GetListResp{Status: statusSuccess, Items: []int32{1}}, nil
+				},
+			}}}
+			cr := grpcKindWith(name, withItems(1), func(cr *v1alpha1.GrpcKind) {
+				cr.Spec.ForProvider.Description = strPtr(description)
+			})
+			if _, err := e.Observe(context.Background(), cr); err != nil {
+				t.Fatalf("\n%s\ne.Observe(...): %v", tc.reason, err)
+			}
+
+			got := buf.String()
+			for _, s := range tc.want {
+				if !strings.Contains(got, s) {
+					t.Errorf("\n%s\ne.Observe(...): want logs to contain %q, got:\n%s", tc.reason, s, got)
+				}
+			}
+			for _, s := range tc.wantNone {
+				if strings.Contains(got, s) {
+					t.Errorf("\n%s\ne.Observe(...): want logs not to contain %q, got:\n%s", tc.reason, s, got)
+				}
+			}
+		})
+	}
+}
+
+func TestRedactErrors(t *testing.T) {
+	defer func(r bool) { RedactLogs = r }(RedactLogs)
+	RedactLogs = true
+
+	const name = "patient-1234"
+	e := external{service: &ListService{grpcClient: &fakeListServiceClient{
+		MockCreateList: func(_ context.Context, _ *listServicepb.CreateListReq, _ ...grpc.CallOption) (*listServicepb.CreateListResp, error) {
+			return &listServicepb.CreateListResp{Status: statusFailed}, nil
+		},
+	}}}
+	cr := grpcKindWith(name, withItems(1))
+
+	// The error is recorded in the resource's Synced condition, and in an
+	// event, so it must not reveal the list's name either.
+	_, err := e.Create(context.Background(), cr)
+	if err == nil {
+		t.Fatalf("e.Create(...): want the backend's failure")
+	}
+	if strings.Contains(err.Error(), name) {
+		t.Errorf("e.Create(...): want error not to contain %q, got: %v", name, err)
+	}
+
+	// So must the error of the next reconcile, which backs off.
+	_, err = e.Create(context.Background(), cr)
+	if err == nil {
+		t.Fatalf("e.Create(...): want an error while backing off")
+	}
+	if strings.Contains(err.Error(), name) {
+		t.Errorf("e.Create(...): want error not to contain %q, got: %v", name, err)
+	}
+}
+
+func TestLogNameStable(t *testing.T) {
+	defer func(r bool) { RedactLogs = r }(RedactLogs)
+	RedactLogs = true
+
+	if logName("cool-list") != logName("cool-list") {
+		t.Errorf("logName(...): want the same name always hashed the same way")
+	}
+	if logName("cool-list") == logName("cooler-list") {
+		t.Errorf("logName(...): want different names hashed differently")
+	}
+}
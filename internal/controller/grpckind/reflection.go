@@ -0,0 +1,180 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpckind
+
+import (
+	"context"
+	"strings"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+	rpb "google.golang.org/grpc/reflection/grpc_reflection_v1alpha"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/descriptorpb"
+
+	listServicepb "github.com/ashwinshirva/provider-grpc-server/proto"
+)
+
+const (
+	errReflect          = "cannot discover list service using server reflection"
+	errNoListService    = "backend describes no service with the CreateList, GetList, UpdateListItems, and DeleteList methods"
+	errReflectionFmt    = "server reflection error %d: %s"
+	errParseDescriptors = "cannot parse file descriptors"
+)
+
+// listMethods are the methods a service must have to be used as the list
+// service.
+var listMethods = []string{"CreateList", "GetList", "UpdateListItems", "DeleteList"}
+
+// discoverListService uses server reflection to find the full name of a
+// service the backend serves that has all of the list service's methods.
+func discoverListService(ctx context.Context, cc grpc.ClientConnInterface) (string, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	stream, err := rpb.NewServerReflectionClient(cc).ServerReflectionInfo(ctx)
+	if err != nil {
+		return "", err
+	}
+	resp, err := reflectionCall(stream, &rpb.ServerReflectionRequest{
+		MessageRequest: &rpb.ServerReflectionRequest_ListServices{},
+	})
+	if err != nil {
+		return "", err
+	}
+
+	for _, svc := range resp.GetListServicesResponse().GetService() {
+		if strings.HasPrefix(svc.GetName(), "grpc.") {
+			continue
+		}
+		resp, err := reflectionCall(stream, &rpb.ServerReflectionRequest{
+			MessageRequest: &rpb.ServerReflectionRequest_FileContainingSymbol{FileContainingSymbol: svc.GetName()},
+		})
+		if err != nil {
+			return "", err
+		}
+		ok, err := hasListMethods(resp.GetFileDescriptorResponse().GetFileDescriptorProto(), svc.GetName())
+		if err != nil {
+			return "", err
+		}
+		if ok {
+			return svc.GetName(), nil
+		}
+	}
+	return "", errors.New(errNoListService)
+}
+
+// reflectionCall sends the supplied request and returns the response.
+func reflectionCall(stream rpb.ServerReflection_ServerReflectionInfoClient, req *rpb.ServerReflectionRequest) (*rpb.ServerReflectionResponse, error) {
+	if err := stream.Send(req); err != nil {
+		return nil, err
+	}
+	resp, err := stream.Recv()
+	if err != nil {
+		return nil, err
+	}
+	if e := resp.GetErrorResponse(); e != nil {
+		return nil, errors.Errorf(errReflectionFmt, e.GetErrorCode(), e.GetErrorMessage())
+	}
+	return resp, nil
+}
+
+// hasListMethods returns true if the named service, described by one of the
+// supplied serialized file descriptors, has all of the list methods.
+func hasListMethods(files [][]byte, service string) (bool, error) {
+	for _, b := range files {
+		fd := &descriptorpb.FileDescriptorProto{}
+		if err := proto.Unmarshal(b, fd); err != nil {
+			return false, errors.Wrap(err, errParseDescriptors)
+		}
+		for _, sd := range fd.GetService() {
+			name := sd.GetName()
+			if fd.GetPackage() != "" {
+				name = fd.GetPackage() + "." + name
+			}
+			if name != service {
+				continue
+			}
+			methods := map[string]bool{}
+			for _, md := range sd.GetMethod() {
+				methods[md.GetName()] = true
+			}
+			for _, m := range listMethods {
+				if !methods[m] {
+					return false, nil
+				}
+			}
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// A reflectedListService is a ListServiceClient that calls the methods of a
+// service discovered using server reflection. The service's messages must be
+// wire compatible with those of the list service.
+type reflectedListService struct {
+	cc      grpc.ClientConnInterface
+	service string
+}
+
+func (c *reflectedListService) method(name string) string {
+	return "/" + c.service + "/" + name
+}
+
+func (c *reflectedListService) CreateList(ctx context.Context, in *listServicepb.CreateListReq, opts ...grpc.CallOption) (*listServicepb.CreateListResp, error) {
+	out := new(listServicepb.CreateListResp)
+	if err := c.cc.Invoke(ctx, c.method("CreateList"), in, out, opts...); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reflectedListService) GetList(ctx context.Context, in *listServicepb.GetListReq, opts ...grpc.CallOption) (*listServicepb.GetListResp, error) {
+	out := new(listServicepb.GetListResp)
+	if err := c.cc.Invoke(ctx, c.method("GetList"), in, out, opts...); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reflectedListService) UpdateListItems(ctx context.Context, in *listServicepb.UpdateListItemsReq, opts ...grpc.CallOption) (*listServicepb.UpdateListItemsResp, error) {
+	out := new(listServicepb.UpdateListItemsResp)
+	if err := c.cc.Invoke(ctx, c.method("UpdateListItems"), in, out, opts...); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reflectedListService) DeleteList(ctx context.Context, in *listServicepb.DeleteListReq, opts ...grpc.CallOption) (*listServicepb.DeleteListResp, error) {
+	out := new(listServicepb.DeleteListResp)
+	if err := c.cc.Invoke(ctx, c.method("DeleteList"), in, out, opts...); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// useReflection makes the ListService call the list service the backend
+// describes using server reflection.
+func (s *ListService) useReflection(ctx context.Context) error {
+	name, err := discoverListService(ctx, s.conn)
+	if err != nil {
+		return errors.Wrap(err, errReflect)
+	}
+	s.grpcClient = &reflectedListService{cc: s.conn, service: name}
+	return nil
+}
@@ -0,0 +1,132 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpckind
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/reflection"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/testing/protocmp"
+
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	listServicepb "github.com/ashwinshirva/provider-grpc-server/proto"
+)
+
+// reflectedListServer serves a single list.
+type reflectedListServer struct {
+	listServicepb.UnimplementedListServiceServer
+}
+
+func (reflectedListServer) GetList(context.Context, *listServicepb.GetListReq) (*listServicepb.GetListResp, error) {
+	return &listServicepb.GetListResp{Status: statusSuccess, Items: []int32{1, 2, 3}}, nil
+}
+
+// dialBufconn starts a server on a bufconn listener, registers services with
+// it using the supplied function, and returns a connection to it.
+func dialBufconn(t *testing.T, register func(s *grpc.Server)) *grpc.ClientConn {
+	t.Helper()
+	lis := bufconn.Listen(1024 * 1024)
+	srv := grpc.NewServer()
+	register(srv)
+	go func() { _ = srv.Serve(lis) }()
+	t.Cleanup(srv.Stop)
+
+	conn, err := grpc.Dial("passthrough:///bufconn",
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return lis.DialContext(ctx)
+		}))
+	if err != nil {
+		t.Fatalf("grpc.Dial(...): %v", err)
+	}
+	t.Cleanup(func() { _ = conn.Close() })
+	return conn
+}
+
+func TestReflection(t *testing.T) {
+	type want struct {
+		service string
+		resp    *listServicepb.GetListResp
+		err     error
+	}
+
+	cases := map[string]struct {
+		reason   string
+		register func(s *grpc.Server)
+		want     want
+	}{
+		"ListService": {
+			reason: "The list service should be discovered and called using reflection.",
+			register: func(s *grpc.Server) {
+				listServicepb.RegisterListServiceServer(s, reflectedListServer{})
+				healthpb.RegisterHealthServer(s, &authorityHealthServer{})
+				reflection.Register(s)
+			},
+			want: want{
+				service: "proto.ListService",
+				resp:    &listServicepb.GetListResp{Status: statusSuccess, Items: []int32{1, 2, 3}},
+			},
+		},
+		"NoListService": {
+			reason: "An error should be returned if the backend serves no list service.",
+			register: func(s *grpc.Server) {
+				healthpb.RegisterHealthServer(s, &authorityHealthServer{})
+				reflection.Register(s)
+			},
+			want: want{
+				err: errors.Wrap(errors.New(errNoListService), errReflect),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			conn := dialBufconn(t, tc.register)
+			s := &ListService{conn: conn}
+			err := s.useReflection(context.Background())
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Fatalf("\n%s\nuseReflection(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if err != nil {
+				return
+			}
+			rs, ok := s.grpcClient.(*reflectedListService)
+			if !ok {
+				t.Fatalf("\n%s\nuseReflection(...): want *reflectedListService, got %T", tc.reason, s.grpcClient)
+			}
+			if diff := cmp.Diff(tc.want.service, rs.service); diff != "" {
+				t.Errorf("\n%s\nuseReflection(...): -want service, +got service:\n%s\n", tc.reason, diff)
+			}
+			resp, err := s.grpcClient.GetList(context.Background(), &listServicepb.GetListReq{Name: "example"})
+			if err != nil {
+				t.Fatalf("\n%s\nGetList(...): %v", tc.reason, err)
+			}
+			if diff := cmp.Diff(tc.want.resp, resp, protocmp.Transform()); diff != "" {
+				t.Errorf("\n%s\nGetList(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
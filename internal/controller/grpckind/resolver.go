@@ -0,0 +1,57 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpckind
+
+import (
+	"strings"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/resolver"
+
+	apisv1alpha1 "github.com/crossplane/provider-grpc/apis/v1alpha1"
+)
+
+const errUnknownResolverFmt = "gRPC resolver %q is not registered"
+
+// resolverTarget returns the dial target that resolves the supplied target
+// using the ProviderConfig's resolver, if it has one. Targets that already use
+// the resolver's scheme are returned unmodified. An error is returned if the
+// resolver is neither registered with gRPC nor one of the supplied resolvers.
+func resolverTarget(spec apisv1alpha1.ProviderConfigSpec, target string, extra []resolver.Builder) (string, error) {
+	if spec.Resolver == nil {
+		return target, nil
+	}
+	scheme := *spec.Resolver
+	if !resolverRegistered(scheme, extra) {
+		return "", errors.Errorf(errUnknownResolverFmt, scheme)
+	}
+	if strings.HasPrefix(target, scheme+":") {
+		return target, nil
+	}
+	return scheme + ":///" + target, nil
+}
+
+// resolverRegistered returns true if a resolver for the supplied scheme is
+// registered with gRPC, or is one of the supplied resolvers.
+func resolverRegistered(scheme string, extra []resolver.Builder) bool {
+	for _, b := range extra {
+		if b.Scheme() == scheme {
+			return true
+		}
+	}
+	return resolver.Get(scheme) != nil
+}
@@ -0,0 +1,167 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpckind
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/resolver"
+	"google.golang.org/grpc/resolver/manual"
+	"google.golang.org/grpc/test/bufconn"
+	testingclock "k8s.io/utils/clock/testing"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	listServicepb "github.com/ashwinshirva/provider-grpc-server/proto"
+	apisv1alpha1 "github.com/crossplane/provider-grpc/apis/v1alpha1"
+)
+
+func TestConnectResolver(t *testing.T) {
+	type want struct {
+		targets []string
+		err     error
+	}
+
+	cases := map[string]struct {
+		reason       string
+		resolver     *string
+		endpoint     string
+		readEndpoint *string
+		want         want
+	}{
+		"NoResolver": {
+			reason:   "Endpoints should be dialed unmodified if the ProviderConfig has no resolver.",
+			endpoint: "lists.example.org:443",
+			want:     want{targets: []string{"lists.example.org:443"}},
+		},
+		"CustomResolver": {
+			reason:   "Endpoints should be resolved using a custom resolver the SetupOptions specify.",
+			resolver: strPtr("coolmesh"),
+			endpoint: "lists.example.org:443",
+			want:     want{targets: []string{"coolmesh:///lists.example.org:443"}},
+		},
+		"RegisteredResolver": {
+			reason:   "Endpoints should be resolved using a resolver registered with gRPC.",
+			resolver: strPtr("passthrough"),
+			endpoint: "10.0.0.1:443",
+			want:     want{targets: []string{"passthrough:///10.0.0.1:443"}},
+		},
+		"SchemeAlreadyUsed": {
+			reason:   "Endpoints that already use the resolver's scheme should be passed to it unmodified.",
+			resolver: strPtr("coolmesh"),
+			endpoint: "coolmesh://authority/lists.example.org:443",
+			want:     want{targets: []string{"coolmesh://authority/lists.example.org:443"}},
+		},
+		"ReadEndpoint": {
+			reason:       "The read endpoint should be resolved using the same resolver.",
+			resolver:     strPtr("coolmesh"),
+			endpoint:     "lists.example.org:443",
+			readEndpoint: strPtr("replica.lists.example.org:443"),
+			want:         want{targets: []string{"coolmesh:///lists.example.org:443", "coolmesh:///replica.lists.example.org:443"}},
+		},
+		"UnknownResolver": {
+			reason:   "A resolver that isn't registered should be rejected rather than dialed.",
+			resolver: strPtr("nomesh"),
+			endpoint: "lists.example.org:443",
+			want:     want{err: errors.Errorf(errUnknownResolverFmt, "nomesh")},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var targets []string
+			so := SetupOptions{
+				Resolvers: []resolver.Builder{manual.NewBuilderWithScheme("coolmesh")},
+				NewService: func(target string, _ []byte, _ ...grpc.DialOption) (*ListService, error) {
+					targets = append(targets, target)
+					return &ListService{grpcClient: &fakeListServiceClient{}}, nil
+				},
+			}
+			c := connector{
+				kube: &test.MockClient{
+					MockGet: test.NewMockGetFn(nil, func(obj client.Object) error {
+						pc := obj.(*apisv1alpha1.ProviderConfig)
+						pc.Spec.Credentials.Source = xpv1.CredentialsSourceNone
+						pc.Spec.Endpoint = &tc.endpoint
+						pc.Spec.ReadEndpoint = tc.readEndpoint
+						pc.Spec.Resolver = tc.resolver
+						return nil
+					}),
+				},
+				usage:        resource.TrackerFn(func(_ context.Context, _ resource.Managed) error { return nil }),
+				newServiceFn: so.serviceFn(nil),
+				resolvers:    so.Resolvers,
+			}
+			_, err := c.Connect(context.Background(), grpcKind("cool-list"))
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nc.Connect(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.targets, targets); diff != "" {
+				t.Errorf("\n%s\nc.Connect(...): -want targets, +got targets:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestCustomResolverDial(t *testing.T) {
+	lis := bufconn.Listen(1024 * 1024)
+	srv := grpc.NewServer()
+	listServicepb.RegisterListServiceServer(srv, reflectedListServer{})
+	go func() { _ = srv.Serve(lis) }()
+	defer srv.Stop()
+
+	// The custom resolver resolves every endpoint to the mesh's sidecar.
+	r := manual.NewBuilderWithScheme("coolmesh")
+	r.InitialState(resolver.State{Addresses: []resolver.Address{{Addr: "sidecar"}}})
+	var dialed string
+	so := SetupOptions{
+		Resolvers: []resolver.Builder{r},
+		DialOptions: []grpc.DialOption{grpc.WithContextDialer(func(ctx context.Context, addr string) (net.Conn, error) {
+			dialed = addr
+			return lis.DialContext(ctx)
+		})},
+	}
+	c := connector{
+		newServiceFn: so.serviceFn(testingclock.NewFakeClock(time.Now())),
+		resolvers:    so.Resolvers,
+	}
+	pc := &apisv1alpha1.ProviderConfig{Spec: apisv1alpha1.ProviderConfigSpec{
+		Endpoint: strPtr("lists.example.org:443"),
+		Resolver: strPtr("coolmesh"),
+	}}
+	pc.Spec.Credentials.Source = xpv1.CredentialsSourceNone
+
+	svc, _, _, err := c.connectBackend(context.Background(), pc)
+	if err != nil {
+		t.Fatalf("c.connectBackend(...): %v", err)
+	}
+	if _, err := svc.grpcClient.GetList(context.Background(), &listServicepb.GetListReq{Name: "cool-list"}); err != nil {
+		t.Fatalf("GetList(...): %v", err)
+	}
+	if dialed != "sidecar" {
+		t.Errorf("c.connectBackend(...): want the address the custom resolver resolved dialed, got %q", dialed)
+	}
+}
@@ -0,0 +1,110 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpckind
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+
+	"github.com/crossplane/provider-grpc/apis/mygroup/v1alpha1"
+	"github.com/crossplane/provider-grpc/internal/grpcerr"
+)
+
+const errBackingOffFmt = "backing off after %d failed attempts to apply list %q; retrying after %s"
+
+// Bounds of the delay before a failed CreateList or UpdateListItems is
+// retried. The delay doubles with each consecutive failure.
+var (
+	retryBaseDelay = time.Second
+	retryMaxDelay  = 5 * time.Minute
+)
+
+// transientBackoff bounds how often a call to the backend that failed for a
+// transient reason, e.g. because the backend was unavailable, is retried
+// within a reconcile. Retries also stop once the reconcile context is done.
+var transientBackoff = wait.Backoff{
+	Duration: 250 * time.Millisecond,
+	Factor:   2,
+	Steps:    4,
+}
+
+// retryTransient makes the supplied call to the backend, and retries it for as
+// long as grpcerr.IsRetryable considers the error it returns transient. It
+// returns the error of the last attempt, or the context's error if it was done
+// before the call could be made at all. Observe, Create, Update, and Delete
+// make their calls using it, so that they agree on which failures are worth
+// retrying.
+//
+// Calls that fail because the backend's resources are exhausted aren't
+// retried. Retrying them right away would only add to the load the backend
+// asked to shed, so they're left to the backpressureLimiter, which slows down
+// reconciles instead.
+func retryTransient(ctx context.Context, call func() error) error {
+	var err error
+	werr := wait.ExponentialBackoffWithContext(ctx, transientBackoff, func() (bool, error) {
+		err = call()
+		return !grpcerr.IsRetryable(err) || status.Code(err) == codes.ResourceExhausted, nil
+	})
+	if err == nil && werr != nil {
+		return werr
+	}
+	return err
+}
+
+// retryDelay returns the delay before retrying after the supplied number of
+// consecutive failures.
+func retryDelay(failures int32) time.Duration {
+	d := retryBaseDelay
+	for i := int32(1); i < failures && d < retryMaxDelay; i++ {
+		d *= 2
+	}
+	if d > retryMaxDelay {
+		return retryMaxDelay
+	}
+	return d
+}
+
+// backingOff returns an error if the GrpcKind's status records that its list
+// should not be applied again yet, because previous attempts failed. The
+// status survives restarts of the provider, unlike its requeue backoff.
+func (c *external) backingOff(cr *v1alpha1.GrpcKind) error {
+	next := cr.Status.AtProvider.NextRetryTime
+	if now := c.now(); next == nil || !now.Before(next) {
+		return nil
+	}
+	return errors.Errorf(errBackingOffFmt, cr.Status.AtProvider.RetryCount, logName(cr.Spec.ForProvider.Name), next.Format(time.RFC3339))
+}
+
+// recordAttempt records the outcome of an attempt to apply the GrpcKind's list
+// in its status. Failures delay the next attempt exponentially, while a
+// success resets the delay.
+func (c *external) recordAttempt(cr *v1alpha1.GrpcKind, err error) {
+	if err == nil {
+		cr.Status.AtProvider.RetryCount = 0
+		cr.Status.AtProvider.NextRetryTime = nil
+		return
+	}
+	cr.Status.AtProvider.RetryCount++
+	next := metav1.NewTime(c.now().Add(retryDelay(cr.Status.AtProvider.RetryCount)))
+	cr.Status.AtProvider.NextRetryTime = &next
+}
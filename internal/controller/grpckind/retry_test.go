@@ -0,0 +1,184 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpckind
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	testingclock "k8s.io/utils/clock/testing"
+
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	listServicepb "github.com/ashwinshirva/provider-grpc-server/proto"
+)
+
+func TestRetryTransient(t *testing.T) {
+	defer func(b wait.Backoff) { transientBackoff = b }(transientBackoff)
+	transientBackoff = wait.Backoff{Duration: time.Millisecond, Steps: 3}
+
+	errUnavailable := status.Error(codes.Unavailable, "backend unavailable")
+	errInternal := status.Error(codes.Internal, "boom")
+	errExhausted := status.Error(codes.ResourceExhausted, "quota exceeded")
+
+	type want struct {
+		calls int
+		err   error
+	}
+
+	cases := map[string]struct {
+		reason string
+		errs   []error
+		want   want
+	}{
+		"Success": {
+			reason: "A call that succeeds should be made once.",
+			errs:   []error{nil},
+			want:   want{calls: 1},
+		},
+		"TransientThenSuccess": {
+			reason: "A call that fails for a transient reason should be retried.",
+			errs:   []error{errUnavailable, nil},
+			want:   want{calls: 2},
+		},
+		"NotRetryable": {
+			reason: "A call that fails for a reason that isn't transient should not be retried.",
+			errs:   []error{errInternal},
+			want:   want{calls: 1, err: errInternal},
+		},
+		"ResourceExhausted": {
+			reason: "A call that fails because the backend's resources are exhausted should be left to the backpressure limiter, not retried.",
+			errs:   []error{errExhausted, nil},
+			want:   want{calls: 1, err: errExhausted},
+		},
+		"NotStatus": {
+			reason: "A call that fails with an error that isn't a gRPC status should not be retried.",
+			errs:   []error{errors.New("boom")},
+			want:   want{calls: 1, err: errors.New("boom")},
+		},
+		"Exhausted": {
+			reason: "The error of the last attempt should be returned once the retries are exhausted.",
+			errs:   []error{errUnavailable, errUnavailable, errUnavailable, nil},
+			want:   want{calls: 3, err: errUnavailable},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			calls := 0
+			err := retryTransient(context.Background(), func() error {
+				calls++
+				return tc.errs[calls-1]
+			})
+			if diff := cmp.Diff(tc.want, want{calls: calls, err: err}, test.EquateErrors(), cmp.AllowUnexported(want{})); diff != "" {
+				t.Errorf("\n%s\nretryTransient(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestRetryDelay(t *testing.T) {
+	cases := map[string]struct {
+		failures int32
+		want     time.Duration
+	}{
+		"First":   {failures: 1, want: time.Second},
+		"Doubled": {failures: 4, want: 8 * time.Second},
+		"Capped":  {failures: 100, want: retryMaxDelay},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if got := retryDelay(tc.failures); got != tc.want {
+				t.Errorf("retryDelay(%d): want %s, got %s", tc.failures, tc.want, got)
+			}
+		})
+	}
+}
+
+func TestRetryBackoff(t *testing.T) {
+	start := time.Date(2022, 6, 1, 12, 0, 0, 0, time.UTC)
+	clk := testingclock.NewFakeClock(start)
+	cr := grpcKindWith("cool-list", withItems(1))
+
+	calls, failures := 0, 3
+	client := &fakeListServiceClient{
+		MockUpdateListItems: func(_ context.Context, _ *listServicepb.UpdateListItemsReq, _ ...grpc.CallOption) (*listServicepb.UpdateListItemsResp, error) {
+			calls++
+			if calls <= failures {
+				return nil, status.Error(codes.Internal, "boom")
+			}
+			return &listServicepb.UpdateListItemsResp{Status: "UPDATED"}, nil
+		},
+	}
+
+	// Each reconcile uses a new external client, as it would after the
+	// provider restarted. Only the managed resource's status carries over.
+	reconcile := func() error {
+		e := external{clock: clk, service: &ListService{grpcClient: client}}
+		_, err := e.Update(context.Background(), cr)
+		return err
+	}
+
+	type state struct {
+		calls int
+		count int32
+		next  *metav1.Time
+	}
+	at := func(d time.Duration) *metav1.Time { t := metav1.NewTime(start.Add(d)); return &t }
+	check := func(step string, want state) {
+		t.Helper()
+		got := state{calls: calls, count: cr.Status.AtProvider.RetryCount, next: cr.Status.AtProvider.NextRetryTime}
+		if diff := cmp.Diff(want, got, cmp.AllowUnexported(state{})); diff != "" {
+			t.Errorf("%s: -want, +got:\n%s\n", step, diff)
+		}
+	}
+
+	_ = reconcile()
+	check("FirstFailure", state{calls: 1, count: 1, next: at(time.Second)})
+
+	if err := reconcile(); err == nil {
+		t.Errorf("BackingOff: want error while backing off, got nil")
+	}
+	check("BackingOff", state{calls: 1, count: 1, next: at(time.Second)})
+
+	clk.Step(time.Second)
+	_ = reconcile()
+	check("SecondFailure", state{calls: 2, count: 2, next: at(3 * time.Second)})
+
+	clk.Step(time.Second)
+	_ = reconcile()
+	check("StillBackingOff", state{calls: 2, count: 2, next: at(3 * time.Second)})
+
+	clk.Step(time.Second)
+	_ = reconcile()
+	check("ThirdFailure", state{calls: 3, count: 3, next: at(7 * time.Second)})
+
+	clk.Step(4 * time.Second)
+	if err := reconcile(); err != nil {
+		t.Errorf("Success: %v", err)
+	}
+	check("Success", state{calls: 4})
+}
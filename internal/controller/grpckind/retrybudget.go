@@ -0,0 +1,84 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpckind
+
+import (
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"sigs.k8s.io/controller-runtime/pkg/ratelimiter"
+)
+
+// RetryBudget is how many GrpcKinds may be retrying at once, e.g. because
+// their backend is failing, before further retries are deferred so that they
+// don't exhaust the controller's workers. Zero disables the budget.
+var RetryBudget = 0
+
+// RetryBudgetDelay is how long retries are deferred for while the retry budget
+// is exhausted.
+var RetryBudgetDelay = 30 * time.Second
+
+// A retryBudget limits how many items may be retrying at once. It wraps the
+// RateLimiter of the controller's work queue, which is consulted each time a
+// reconcile is retried and told to forget an item once its reconcile succeeds.
+// An item that retries while the budget is exhausted is deferred by at least
+// the budget's delay, and takes a place in the budget if one is free when it
+// next retries.
+type retryBudget struct {
+	ratelimiter.RateLimiter
+	budget int
+	delay  time.Duration
+
+	mu       sync.Mutex
+	retrying map[interface{}]struct{}
+}
+
+// newRetryBudget returns a retryBudget that wraps the supplied RateLimiter.
+func newRetryBudget(budget int, delay time.Duration, l ratelimiter.RateLimiter) *retryBudget {
+	return &retryBudget{RateLimiter: l, budget: budget, delay: delay, retrying: make(map[interface{}]struct{})}
+}
+
+// When returns how long the supplied item should wait before it is retried.
+func (b *retryBudget) When(item interface{}) time.Duration {
+	d := b.RateLimiter.When(item)
+	if b.budget <= 0 {
+		return d
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if _, ok := b.retrying[item]; ok || len(b.retrying) < b.budget {
+		b.retrying[item] = struct{}{}
+		return d
+	}
+	log.Infof("Retry budget of %d retrying reconciles is exhausted. Deferring retry of %v for %s", b.budget, item, b.delay)
+	if b.delay > d {
+		return b.delay
+	}
+	return d
+}
+
+// Forget indicates the supplied item is no longer retrying, which frees its
+// place in the budget.
+func (b *retryBudget) Forget(item interface{}) {
+	b.RateLimiter.Forget(item)
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	delete(b.retrying, item)
+}
@@ -0,0 +1,57 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpckind
+
+import (
+	"testing"
+	"time"
+)
+
+func TestRetryBudget(t *testing.T) {
+	b := newRetryBudget(2, 30*time.Second, &fakeRateLimiter{delay: 100 * time.Millisecond})
+
+	when := func(item string, want time.Duration) {
+		t.Helper()
+		if got := b.When(item); got != want {
+			t.Errorf("b.When(%q): want %s, got %s", item, want, got)
+		}
+	}
+
+	// Retries within the budget are only rate limited.
+	when("a", 100*time.Millisecond)
+	when("b", 100*time.Millisecond)
+
+	// Once the budget is exhausted new retries are deferred.
+	when("c", 30*time.Second)
+
+	// Items that are already retrying keep their place in the budget.
+	when("a", 100*time.Millisecond)
+
+	// An item that stops retrying frees its place for a deferred one.
+	b.Forget("a")
+	when("c", 100*time.Millisecond)
+	when("a", 30*time.Second)
+}
+
+func TestRetryBudgetDisabled(t *testing.T) {
+	b := newRetryBudget(0, 30*time.Second, &fakeRateLimiter{delay: 100 * time.Millisecond})
+	for _, item := range []string{"a", "b", "c"} {
+		if got := b.When(item); got != 100*time.Millisecond {
+			t.Errorf("b.When(%q): want retries only rate limited without a budget, got %s", item, got)
+		}
+	}
+}
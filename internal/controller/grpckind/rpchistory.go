@@ -0,0 +1,117 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpckind
+
+import (
+	"context"
+	"sync"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/clock"
+
+	listServicepb "github.com/ashwinshirva/provider-grpc-server/proto"
+
+	"github.com/crossplane/provider-grpc/apis/mygroup/v1alpha1"
+)
+
+// maxRPCHistory is how many of the most recent calls made for a GrpcKind's
+// list are kept in its status, so that its status doesn't grow unbounded.
+const maxRPCHistory = 10
+
+// An rpcAttempts records the calls made to the backend while reconciling a
+// managed resource, until they're recorded in its status.
+type rpcAttempts struct {
+	clock clock.PassiveClock
+
+	mu       sync.Mutex
+	attempts []v1alpha1.RPCAttempt
+}
+
+// record records a call to the supplied method that returned the supplied
+// error. A nil rpcAttempts records nothing.
+func (a *rpcAttempts) record(method string, err error) {
+	if a == nil {
+		return
+	}
+	t := metav1.Now()
+	if a.clock != nil {
+		t = metav1.NewTime(a.clock.Now())
+	}
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.attempts = append(a.attempts, v1alpha1.RPCAttempt{Method: method, Code: status.Code(err).String(), Time: t})
+}
+
+// drain returns the calls recorded since it was last called.
+func (a *rpcAttempts) drain() []v1alpha1.RPCAttempt {
+	if a == nil {
+		return nil
+	}
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	attempts := a.attempts
+	a.attempts = nil
+	return attempts
+}
+
+// A recordingListService is a ListServiceClient that records each call to the
+// ListServiceClient it wraps.
+type recordingListService struct {
+	listServicepb.ListServiceClient
+	attempts *rpcAttempts
+}
+
+func (c *recordingListService) CreateList(ctx context.Context, in *listServicepb.CreateListReq, opts ...grpc.CallOption) (*listServicepb.CreateListResp, error) {
+	resp, err := c.ListServiceClient.CreateList(ctx, in, opts...)
+	c.attempts.record("CreateList", err)
+	return resp, err
+}
+
+func (c *recordingListService) GetList(ctx context.Context, in *listServicepb.GetListReq, opts ...grpc.CallOption) (*listServicepb.GetListResp, error) {
+	resp, err := c.ListServiceClient.GetList(ctx, in, opts...)
+	c.attempts.record("GetList", err)
+	return resp, err
+}
+
+func (c *recordingListService) UpdateListItems(ctx context.Context, in *listServicepb.UpdateListItemsReq, opts ...grpc.CallOption) (*listServicepb.UpdateListItemsResp, error) {
+	resp, err := c.ListServiceClient.UpdateListItems(ctx, in, opts...)
+	c.attempts.record("UpdateListItems", err)
+	return resp, err
+}
+
+func (c *recordingListService) DeleteList(ctx context.Context, in *listServicepb.DeleteListReq, opts ...grpc.CallOption) (*listServicepb.DeleteListResp, error) {
+	resp, err := c.ListServiceClient.DeleteList(ctx, in, opts...)
+	c.attempts.record("DeleteList", err)
+	return resp, err
+}
+
+// recordRPCHistory appends the calls made to the backend since it was last
+// called to the supplied GrpcKind's RPC history, evicting the oldest calls
+// once the history is full.
+func (c *external) recordRPCHistory(cr *v1alpha1.GrpcKind) {
+	attempts := c.attempts.drain()
+	if len(attempts) == 0 {
+		return
+	}
+	h := append(append([]v1alpha1.RPCAttempt{}, cr.Status.AtProvider.RPCHistory...), attempts...)
+	if n := len(h) - maxRPCHistory; n > 0 {
+		h = h[n:]
+	}
+	cr.Status.AtProvider.RPCHistory = h
+}
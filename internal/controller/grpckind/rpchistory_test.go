@@ -0,0 +1,111 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpckind
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	testingclock "k8s.io/utils/clock/testing"
+
+	listServicepb "github.com/ashwinshirva/provider-grpc-server/proto"
+	"github.com/crossplane/provider-grpc/apis/mygroup/v1alpha1"
+)
+
+// recordingExternal returns an external that records the calls it makes to the
+// supplied client at the time of the supplied clock.
+func recordingExternal(clk *testingclock.FakeClock, c listServicepb.ListServiceClient) *external {
+	attempts := &rpcAttempts{clock: clk}
+	return &external{
+		service:  &ListService{grpcClient: &recordingListService{ListServiceClient: c, attempts: attempts}},
+		attempts: attempts,
+		clock:    clk,
+	}
+}
+
+func TestRPCHistory(t *testing.T) {
+	clk := testingclock.NewFakeClock(time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC))
+	e := recordingExternal(clk, &fakeListServiceClient{
+		MockGetList: func(_ context.Context, _ *listServicepb.GetListReq, _ ...grpc.CallOption) (*listServicepb.GetListResp, error) {
+			return &listServicepb.GetListResp{Status: statusSuccess, Items: []int32{1}}, nil
+		},
+		MockUpdateListItems: func(_ context.Context, _ *listServicepb.UpdateListItemsReq, _ ...grpc.CallOption) (*listServicepb.UpdateListItemsResp, error) {
+			return nil, status.Error(codes.Internal, "boom")
+		},
+		MockDeleteList: func(_ context.Context, _ *listServicepb.DeleteListReq, _ ...grpc.CallOption) (*listServicepb.DeleteListResp, error) {
+			return nil, status.Error(codes.NotFound, "list cool-list does not exist")
+		},
+	})
+	cr := grpcKindWith("cool-list", withItems(1, 2))
+	start := clk.Now()
+
+	if _, err := e.Observe(context.Background(), cr); err != nil {
+		t.Fatalf("e.Observe(...): %v", err)
+	}
+	clk.Step(time.Second)
+	if _, err := e.Update(context.Background(), cr); err == nil {
+		t.Fatalf("e.Update(...): want the backend's error")
+	}
+	clk.Step(time.Second)
+	if err := e.Delete(context.Background(), cr); err != nil {
+		t.Fatalf("e.Delete(...): %v", err)
+	}
+
+	want := []v1alpha1.RPCAttempt{
+		{Method: "GetList", Code: "OK", Time: metav1.NewTime(start)},
+		{Method: "UpdateListItems", Code: "Internal", Time: metav1.NewTime(start.Add(time.Second))},
+		{Method: "DeleteList", Code: "NotFound", Time: metav1.NewTime(start.Add(2 * time.Second))},
+	}
+	if diff := cmp.Diff(want, cr.Status.AtProvider.RPCHistory); diff != "" {
+		t.Errorf("RPCHistory: -want, +got:\n%s\n", diff)
+	}
+}
+
+func TestRPCHistoryEvictsOldest(t *testing.T) {
+	clk := testingclock.NewFakeClock(time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC))
+	e := recordingExternal(clk, &fakeListServiceClient{
+		MockGetList: func(_ context.Context, _ *listServicepb.GetListReq, _ ...grpc.CallOption) (*listServicepb.GetListResp, error) {
+			return &listServicepb.GetListResp{Status: statusSuccess, Items: []int32{1}}, nil
+		},
+	})
+	cr := grpcKindWith("cool-list", withItems(1))
+	for i := 0; i < maxRPCHistory; i++ {
+		cr.Status.AtProvider.RPCHistory = append(cr.Status.AtProvider.RPCHistory, v1alpha1.RPCAttempt{Method: fmt.Sprintf("Old%d", i), Code: "OK"})
+	}
+
+	if _, err := e.Observe(context.Background(), cr); err != nil {
+		t.Fatalf("e.Observe(...): %v", err)
+	}
+
+	h := cr.Status.AtProvider.RPCHistory
+	if len(h) != maxRPCHistory {
+		t.Fatalf("RPCHistory: want %d calls, got %d", maxRPCHistory, len(h))
+	}
+	if diff := cmp.Diff("Old1", h[0].Method); diff != "" {
+		t.Errorf("RPCHistory: want the oldest call evicted, -want oldest, +got oldest:\n%s\n", diff)
+	}
+	if diff := cmp.Diff(v1alpha1.RPCAttempt{Method: "GetList", Code: "OK", Time: metav1.NewTime(clk.Now())}, h[len(h)-1]); diff != "" {
+		t.Errorf("RPCHistory: -want newest, +got newest:\n%s\n", diff)
+	}
+}
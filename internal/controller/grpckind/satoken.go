@@ -0,0 +1,162 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpckind
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/utils/clock"
+
+	apisv1alpha1 "github.com/crossplane/provider-grpc/apis/v1alpha1"
+)
+
+const errMintSAToken = "cannot mint ServiceAccount token"
+
+// defaultSATokenExpiration is how long minted ServiceAccount tokens are valid
+// for if the ProviderConfig doesn't say.
+const defaultSATokenExpiration int64 = 3600
+
+// A tokenMinter mints a token for the named ServiceAccount using the supplied
+// TokenRequest.
+type tokenMinter func(ctx context.Context, namespace, name string, tr *authenticationv1.TokenRequest) (*authenticationv1.TokenRequest, error)
+
+// clientsetMinter returns a tokenMinter that mints tokens using the Kubernetes
+// TokenRequest API.
+func clientsetMinter(cs kubernetes.Interface) tokenMinter {
+	return func(ctx context.Context, namespace, name string, tr *authenticationv1.TokenRequest) (*authenticationv1.TokenRequest, error) {
+		return cs.CoreV1().ServiceAccounts(namespace).CreateToken(ctx, name, tr, metav1.CreateOptions{})
+	}
+}
+
+// A serviceAccountToken authenticates each call to a backend using a token
+// minted for a ServiceAccount. It mints a new token once 80% of the current
+// token's lifetime has passed, like the kubelet refreshes projected tokens.
+type serviceAccountToken struct {
+	mint       tokenMinter
+	namespace  string
+	name       string
+	audience   string
+	expiration int64
+	clock      clock.PassiveClock
+
+	mu        sync.Mutex
+	token     string
+	refreshAt time.Time
+}
+
+// GetRequestMetadata returns the authorization header for a call, minting a
+// token if there is none or the current one is due to be refreshed.
+func (t *serviceAccountToken) GetRequestMetadata(ctx context.Context, _ ...string) (map[string]string, error) {
+	token, err := t.get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return map[string]string{"authorization": "Bearer " + token}, nil
+}
+
+// RequireTransportSecurity returns true, because a token must never be sent
+// over an insecure connection.
+func (t *serviceAccountToken) RequireTransportSecurity() bool {
+	return true
+}
+
+func (t *serviceAccountToken) get(ctx context.Context) (string, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	now := t.clock.Now()
+	if t.token != "" && now.Before(t.refreshAt) {
+		return t.token, nil
+	}
+
+	tr := &authenticationv1.TokenRequest{Spec: authenticationv1.TokenRequestSpec{ExpirationSeconds: &t.expiration}}
+	if t.audience != "" {
+		tr.Spec.Audiences = []string{t.audience}
+	}
+	tr, err := t.mint(ctx, t.namespace, t.name, tr)
+	if err != nil {
+		return "", errors.Wrap(err, errMintSAToken)
+	}
+	log.Infof("Minted token for ServiceAccount %s/%s, valid until %s", t.namespace, t.name, tr.Status.ExpirationTimestamp)
+
+	t.token = tr.Status.Token
+	t.refreshAt = now.Add(tr.Status.ExpirationTimestamp.Sub(now) * 4 / 5)
+	return t.token, nil
+}
+
+type saTokenKey struct {
+	namespace  string
+	name       string
+	audience   string
+	expiration int64
+}
+
+// serviceAccountTokens share a serviceAccountToken between all ProviderConfigs
+// that configure the same ServiceAccount token, so that a token is minted only
+// as often as it needs to be refreshed, and connections using it can be
+// cached.
+type serviceAccountTokens struct {
+	mint  tokenMinter
+	clock clock.PassiveClock
+
+	mu     sync.Mutex
+	tokens map[saTokenKey]*serviceAccountToken
+}
+
+// newServiceAccountTokens returns serviceAccountTokens that mint tokens using
+// the supplied tokenMinter.
+func newServiceAccountTokens(m tokenMinter, c clock.PassiveClock) *serviceAccountTokens {
+	return &serviceAccountTokens{mint: m, clock: c, tokens: make(map[saTokenKey]*serviceAccountToken)}
+}
+
+// Get returns the serviceAccountToken configured by the supplied config.
+func (s *serviceAccountTokens) Get(cfg *apisv1alpha1.ServiceAccountTokenConfig) *serviceAccountToken {
+	key := saTokenKey{
+		namespace:  cfg.ServiceAccountRef.Namespace,
+		name:       cfg.ServiceAccountRef.Name,
+		expiration: defaultSATokenExpiration,
+	}
+	if cfg.Audience != nil {
+		key.audience = *cfg.Audience
+	}
+	if cfg.ExpirationSeconds != nil {
+		key.expiration = *cfg.ExpirationSeconds
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	t, ok := s.tokens[key]
+	if !ok {
+		t = &serviceAccountToken{
+			mint:       s.mint,
+			namespace:  key.namespace,
+			name:       key.name,
+			audience:   key.audience,
+			expiration: key.expiration,
+			clock:      s.clock,
+		}
+		s.tokens[key] = t
+	}
+	return t
+}
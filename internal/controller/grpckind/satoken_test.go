@@ -0,0 +1,115 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpckind
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	testingclock "k8s.io/utils/clock/testing"
+
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	apisv1alpha1 "github.com/crossplane/provider-grpc/apis/v1alpha1"
+)
+
+func TestServiceAccountToken(t *testing.T) {
+	clk := testingclock.NewFakeClock(time.Now())
+
+	var reqs []authenticationv1.TokenRequestSpec
+	mint := func(_ context.Context, namespace, name string, tr *authenticationv1.TokenRequest) (*authenticationv1.TokenRequest, error) {
+		if namespace != "crossplane-system" || name != "lists" {
+			t.Errorf("mint(...): want token for crossplane-system/lists, got %s/%s", namespace, name)
+		}
+		reqs = append(reqs, tr.Spec)
+		tr.Status = authenticationv1.TokenRequestStatus{
+			Token:               fmt.Sprintf("token-%d", len(reqs)),
+			ExpirationTimestamp: metav1.NewTime(clk.Now().Add(time.Duration(*tr.Spec.ExpirationSeconds) * time.Second)),
+		}
+		return tr, nil
+	}
+
+	tokens := newServiceAccountTokens(mint, clk)
+	tok := tokens.Get(&apisv1alpha1.ServiceAccountTokenConfig{
+		ServiceAccountRef: apisv1alpha1.ServiceAccountReference{Namespace: "crossplane-system", Name: "lists"},
+		Audience:          strPtr("lists.example.org"),
+	})
+
+	authorization := func() string {
+		md, err := tok.GetRequestMetadata(context.Background())
+		if err != nil {
+			t.Fatalf("tok.GetRequestMetadata(...): unexpected error: %v", err)
+		}
+		return md["authorization"]
+	}
+
+	if diff := cmp.Diff("Bearer token-1", authorization()); diff != "" {
+		t.Errorf("tok.GetRequestMetadata(...): -want, +got:\n%s\n", diff)
+	}
+	want := []authenticationv1.TokenRequestSpec{{Audiences: []string{"lists.example.org"}, ExpirationSeconds: func() *int64 { e := int64(3600); return &e }()}}
+	if diff := cmp.Diff(want, reqs); diff != "" {
+		t.Errorf("tok.GetRequestMetadata(...): -want token requests, +got token requests:\n%s\n", diff)
+	}
+
+	// The token is reused until 80% of its lifetime passed.
+	clk.Step(47 * time.Minute)
+	if diff := cmp.Diff("Bearer token-1", authorization()); diff != "" {
+		t.Errorf("tok.GetRequestMetadata(...): -want, +got before the token is due to be refreshed:\n%s\n", diff)
+	}
+
+	// It's refreshed as it nears expiry.
+	clk.Step(2 * time.Minute)
+	if diff := cmp.Diff("Bearer token-2", authorization()); diff != "" {
+		t.Errorf("tok.GetRequestMetadata(...): -want, +got once the token is due to be refreshed:\n%s\n", diff)
+	}
+
+	// ProviderConfigs that configure the same token share it.
+	same := tokens.Get(&apisv1alpha1.ServiceAccountTokenConfig{
+		ServiceAccountRef: apisv1alpha1.ServiceAccountReference{Namespace: "crossplane-system", Name: "lists"},
+		Audience:          strPtr("lists.example.org"),
+	})
+	if same != tok {
+		t.Errorf("tokens.Get(...): want the same token for the same config")
+	}
+	other := tokens.Get(&apisv1alpha1.ServiceAccountTokenConfig{
+		ServiceAccountRef: apisv1alpha1.ServiceAccountReference{Namespace: "crossplane-system", Name: "lists"},
+	})
+	if other == tok {
+		t.Errorf("tokens.Get(...): want a different token for a different audience")
+	}
+}
+
+func TestServiceAccountTokenMintError(t *testing.T) {
+	errBoom := errors.New("boom")
+	tokens := newServiceAccountTokens(func(_ context.Context, _, _ string, _ *authenticationv1.TokenRequest) (*authenticationv1.TokenRequest, error) {
+		return nil, errBoom
+	}, testingclock.NewFakeClock(time.Now()))
+	tok := tokens.Get(&apisv1alpha1.ServiceAccountTokenConfig{
+		ServiceAccountRef: apisv1alpha1.ServiceAccountReference{Namespace: "crossplane-system", Name: "lists"},
+	})
+
+	_, err := tok.GetRequestMetadata(context.Background())
+	if diff := cmp.Diff(errors.Wrap(errBoom, errMintSAToken), err, test.EquateErrors()); diff != "" {
+		t.Errorf("tok.GetRequestMetadata(...): -want error, +got error:\n%s\n", diff)
+	}
+}
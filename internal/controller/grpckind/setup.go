@@ -0,0 +1,72 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpckind
+
+import (
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/resolver"
+	"k8s.io/utils/clock"
+)
+
+// A ServiceFactory returns a ListService that calls the backend at the
+// supplied target, authenticating with the supplied credentials. The supplied
+// DialOptions describe how the ProviderConfig says to connect to the backend.
+type ServiceFactory func(target string, creds []byte, opts ...grpc.DialOption) (*ListService, error)
+
+// SetupOptions customize the GrpcKind controller, e.g. for tests or for
+// providers that reuse it.
+type SetupOptions struct {
+	// NewService returns the ListService used to reconcile each GrpcKind.
+	// By default ListServices share cached connections to their backend.
+	NewService ServiceFactory
+
+	// Endpoint is the address of the backend used for ProviderConfigs that
+	// don't describe one. The default Address is used if empty.
+	Endpoint string
+
+	// DialOptions are appended to the DialOptions used to connect to the
+	// backend.
+	DialOptions []grpc.DialOption
+
+	// Resolvers may be used by ProviderConfigs to resolve their endpoints, in
+	// addition to the resolvers registered with gRPC, e.g. at init.
+	Resolvers []resolver.Builder
+}
+
+// serviceFn returns the function the connector uses to create a ListService.
+// The supplied DialOptions precede any the SetupOptions specify, which precede
+// any injected using WithDialOptions.
+func (so SetupOptions) serviceFn(clk clock.Clock, extra ...grpc.DialOption) func(target string, creds []byte, t transport) (*ListService, error) {
+	extra = append(extra, so.DialOptions...)
+	if len(so.Resolvers) > 0 {
+		extra = append(extra, grpc.WithResolvers(so.Resolvers...))
+	}
+	if so.NewService == nil {
+		dial := func(target string, t transport) (*grpc.ClientConn, error) {
+			return dialListService(target, t, extra...)
+		}
+		return newListService(newConnCache(dial, IdleTimeout, clk))
+	}
+	return func(target string, creds []byte, t transport) (*ListService, error) {
+		opts, err := t.dialOptions()
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, extra...)
+		return so.NewService(target, creds, append(opts, injected()...)...)
+	}
+}
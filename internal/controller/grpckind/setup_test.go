@@ -0,0 +1,177 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpckind
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/grpc"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	listServicepb "github.com/ashwinshirva/provider-grpc-server/proto"
+	apisv1alpha1 "github.com/crossplane/provider-grpc/apis/v1alpha1"
+)
+
+func TestSetupOptions(t *testing.T) {
+	type want struct {
+		target string
+		opts   int
+	}
+
+	cases := map[string]struct {
+		reason   string
+		endpoint *string
+		so       SetupOptions
+		want     want
+	}{
+		"DefaultAddress": {
+			reason: "The injected factory should be used to dial the default Address.",
+			so:     SetupOptions{},
+			want:   want{target: Address, opts: 3},
+		},
+		"Endpoint": {
+			reason: "The injected factory should be used to dial the endpoint the SetupOptions specify.",
+			so:     SetupOptions{Endpoint: "backend:50051"},
+			want:   want{target: "backend:50051", opts: 3},
+		},
+		"ProviderConfigEndpoint": {
+			reason:   "The ProviderConfig's endpoint should take precedence over the SetupOptions.",
+			endpoint: strPtr("pc-backend:50051"),
+			so:       SetupOptions{Endpoint: "backend:50051"},
+			want:     want{target: "pc-backend:50051", opts: 3},
+		},
+		"DialOptions": {
+			reason: "DialOptions the SetupOptions specify should be passed to the injected factory.",
+			so:     SetupOptions{DialOptions: []grpc.DialOption{grpc.WithUserAgent("fork")}},
+			want:   want{target: Address, opts: 4},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var target string
+			var opts int
+			fake := &fakeListServiceClient{
+				MockGetList: func(_ context.Context, _ *listServicepb.GetListReq, _ ...grpc.CallOption) (*listServicepb.GetListResp, error) {
+					return &listServicepb.GetListResp{Status: "SUCCESS"}, nil
+				},
+			}
+			tc.so.NewService = func(t string, _ []byte, o ...grpc.DialOption) (*ListService, error) {
+				target = t
+				opts = len(o)
+				return &ListService{grpcClient: fake}, nil
+			}
+
+			c := connector{
+				kube: &test.MockClient{
+					MockGet: test.NewMockGetFn(nil, func(obj client.Object) error {
+						pc := obj.(*apisv1alpha1.ProviderConfig)
+						pc.Spec.Credentials.Source = xpv1.CredentialsSourceNone
+						pc.Spec.Endpoint = tc.endpoint
+						return nil
+					}),
+				},
+				usage:        resource.TrackerFn(func(_ context.Context, _ resource.Managed) error { return nil }),
+				newServiceFn: tc.so.serviceFn(nil, grpc.WithUserAgent("limiter")),
+				endpoint:     tc.so.Endpoint,
+			}
+			ext, err := c.Connect(context.Background(), grpcKind("cool-list"))
+			if err != nil {
+				t.Fatalf("c.Connect(...): %v", err)
+			}
+			// Calls are recorded in the GrpcKind's RPC history.
+			if got := ext.(*external).service.grpcClient.(*recordingListService).ListServiceClient.(*warningListService).ListServiceClient; got != fake {
+				t.Errorf("\n%s\nc.Connect(...): want the injected factory's ListService to be used", tc.reason)
+			}
+			if diff := cmp.Diff(tc.want.target, target); diff != "" {
+				t.Errorf("\n%s\nc.Connect(...): -want target, +got target:\n%s\n", tc.reason, diff)
+			}
+			if opts != tc.want.opts {
+				t.Errorf("\n%s\nc.Connect(...): want %d DialOptions, got %d", tc.reason, tc.want.opts, opts)
+			}
+		})
+	}
+}
+
+func TestDefaultAddress(t *testing.T) {
+	defer func(addr string) { Address = addr }(Address)
+
+	cases := map[string]struct {
+		reason   string
+		set      []string
+		endpoint string
+		want     string
+	}{
+		"Unset": {
+			reason: "The built in default address should be dialed if nothing overrides it.",
+			want:   ":50050",
+		},
+		"Set": {
+			reason: "The default address should be dialed if nothing overrides it.",
+			set:    []string{"backend:50051"},
+			want:   "backend:50051",
+		},
+		"SetEmpty": {
+			reason: "Setting an empty default address should leave the default unchanged.",
+			set:    []string{"backend:50051", ""},
+			want:   "backend:50051",
+		},
+		"Overridden": {
+			reason:   "The endpoint the SetupOptions specify should take precedence over the default address.",
+			set:      []string{"backend:50051"},
+			endpoint: "other:50051",
+			want:     "other:50051",
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			Address = ":50050"
+			for _, addr := range tc.set {
+				SetDefaultAddress(addr)
+			}
+
+			var target string
+			c := connector{
+				kube: &test.MockClient{
+					MockGet: test.NewMockGetFn(nil, func(obj client.Object) error {
+						obj.(*apisv1alpha1.ProviderConfig).Spec.Credentials.Source = xpv1.CredentialsSourceNone
+						return nil
+					}),
+				},
+				usage: resource.TrackerFn(func(_ context.Context, _ resource.Managed) error { return nil }),
+				newServiceFn: func(t string, _ []byte, _ transport) (*ListService, error) {
+					target = t
+					return &ListService{}, nil
+				},
+				endpoint: tc.endpoint,
+			}
+			if _, err := c.Connect(context.Background(), grpcKind("cool-list")); err != nil {
+				t.Fatalf("\n%s\nc.Connect(...): %v", tc.reason, err)
+			}
+			if diff := cmp.Diff(tc.want, target); diff != "" {
+				t.Errorf("\n%s\nc.Connect(...): -want target, +got target:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
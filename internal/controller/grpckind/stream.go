@@ -0,0 +1,115 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpckind
+
+import (
+	"context"
+	"io"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+
+	apisv1alpha1 "github.com/crossplane/provider-grpc/apis/v1alpha1"
+)
+
+// An endedStream is a ClientStream that calls a function with the error its
+// stream ended with, or nil if it ended successfully, once it ended. The
+// interceptors and wrappers of unary calls learn how a call ended when it
+// returns; those of streams learn it from endedStream.
+type endedStream struct {
+	grpc.ClientStream
+	desc *grpc.StreamDesc
+
+	once  sync.Once
+	ended func(error)
+}
+
+// onEnd returns a ClientStream that calls the supplied function once the
+// supplied stream ended. The function is called right away with the supplied
+// error if the stream couldn't be opened.
+func onEnd(desc *grpc.StreamDesc, s grpc.ClientStream, err error, ended func(error)) (grpc.ClientStream, error) {
+	if err != nil {
+		ended(err)
+		return nil, err
+	}
+	return &endedStream{ClientStream: s, desc: desc, ended: ended}, nil
+}
+
+func (s *endedStream) RecvMsg(m interface{}) error {
+	err := s.ClientStream.RecvMsg(m)
+	switch {
+	case err == io.EOF:
+		s.end(nil)
+	case err != nil:
+		s.end(err)
+	case !s.desc.ServerStreams:
+		// Streams the server doesn't stream end with its only message.
+		s.end(nil)
+	}
+	return err
+}
+
+func (s *endedStream) end(err error) {
+	s.once.Do(func() { s.ended(err) })
+}
+
+// A callConn is a ClientConnInterface whose streams behave like the calls of
+// a ListServiceClient wrapped by Connect: the fields of each message they
+// send are remapped, they're bounded by the call timeout, and the warnings
+// and outcome of each are recorded. Unary calls are made as is, since they're
+// made using a wrapped ListServiceClient.
+type callConn struct {
+	grpc.ClientConnInterface
+	mapping  *apisv1alpha1.FieldMapping
+	timeout  time.Duration
+	warnings *rpcWarnings
+	attempts *rpcAttempts
+}
+
+func (c *callConn) NewStream(ctx context.Context, desc *grpc.StreamDesc, method string, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+	cancel := func() {}
+	if c.timeout > 0 {
+		ctx, cancel = context.WithTimeout(ctx, c.timeout)
+	}
+	s, err := c.ClientConnInterface.NewStream(ctx, desc, method, opts...)
+	if err == nil && c.mapping != nil {
+		s = &fieldMappingStream{ClientStream: s, mapping: c.mapping}
+	}
+	return onEnd(desc, s, err, func(err error) {
+		if s != nil {
+			c.warnings.record(s.Trailer())
+		}
+		c.attempts.record(desc.StreamName, err)
+		cancel()
+	})
+}
+
+// A fieldMappingStream is a ClientStream that remaps the fields of each
+// message it sends using the ClientStream it wraps.
+type fieldMappingStream struct {
+	grpc.ClientStream
+	mapping *apisv1alpha1.FieldMapping
+}
+
+func (s *fieldMappingStream) SendMsg(m interface{}) error {
+	if pm, ok := m.(proto.Message); ok {
+		m = remapFields(pm, s.mapping)
+	}
+	return s.ClientStream.SendMsg(m)
+}
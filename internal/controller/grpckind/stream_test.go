@@ -0,0 +1,180 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpckind
+
+import (
+	"context"
+	"io"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	testingclock "k8s.io/utils/clock/testing"
+
+	listServicepb "github.com/ashwinshirva/provider-grpc-server/proto"
+	apisv1alpha1 "github.com/crossplane/provider-grpc/apis/v1alpha1"
+)
+
+// serveStreams serves the supplied handler for every method, and returns a
+// connection to it dialed with the supplied options.
+func serveStreams(t *testing.T, handler grpc.StreamHandler, opts ...grpc.DialOption) *grpc.ClientConn {
+	t.Helper()
+	lis := bufconn.Listen(1024 * 1024)
+	s := grpc.NewServer(grpc.UnknownServiceHandler(handler))
+	go func() { _ = s.Serve(lis) }()
+	t.Cleanup(s.Stop)
+
+	opts = append(opts,
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return lis.DialContext(ctx)
+		}))
+	conn, err := grpc.Dial("passthrough:///bufconn", opts...)
+	if err != nil {
+		t.Fatalf("grpc.Dial(...): %v", err)
+	}
+	t.Cleanup(func() { _ = conn.Close() })
+	return conn
+}
+
+// bulkUpdateHandler serves BulkUpdateItems, sending the names of the lists it
+// received on the supplied channel and warning about each.
+func bulkUpdateHandler(names chan<- string) grpc.StreamHandler {
+	return func(_ interface{}, stream grpc.ServerStream) error {
+		for {
+			m := new(listServicepb.UpdateListItemsReq)
+			err := stream.RecvMsg(m)
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				return err
+			}
+			names <- m.GetName()
+		}
+		stream.SetTrailer(metadata.Pairs(warningKey, "list is nearly full"))
+		return stream.SendMsg(&listServicepb.UpdateListItemsResp{Status: statusSuccess})
+	}
+}
+
+func TestCallConn(t *testing.T) {
+	names := make(chan string, 1)
+	conn := serveStreams(t, bulkUpdateHandler(names))
+
+	// Mapping the name to its own field number leaves it as is.
+	warnings, attempts := &rpcWarnings{}, &rpcAttempts{clock: testingclock.NewFakeClock(time.Now())}
+	cc := &callConn{ClientConnInterface: conn, mapping: &apisv1alpha1.FieldMapping{Name: int32Ptr(1)}, timeout: time.Minute, warnings: warnings, attempts: attempts}
+	if _, err := bulkUpdateItems(context.Background(), newBulkUpdateClient(cc), "cool-list", []int32{1}); err != nil {
+		t.Fatalf("bulkUpdateItems(...): %v", err)
+	}
+	if got := <-names; got != "cool-list" {
+		t.Errorf("bulkUpdateItems(...): want the list's name sent, got %q", got)
+	}
+	if got, _ := warnings.drain(); !cmp.Equal([]string{"list is nearly full"}, got) {
+		t.Errorf("bulkUpdateItems(...): want the stream's warnings recorded, got %v", got)
+	}
+	got := attempts.drain()
+	if len(got) != 1 || got[0].Method != "BulkUpdateItems" || got[0].Code != codes.OK.String() {
+		t.Errorf("bulkUpdateItems(...): want the stream recorded, got %+v", got)
+	}
+
+	// Remapping the name to another field hides it from the handler, which
+	// expects it in field 1.
+	cc.mapping = &apisv1alpha1.FieldMapping{Name: int32Ptr(7)}
+	if _, err := bulkUpdateItems(context.Background(), newBulkUpdateClient(cc), "cool-list", []int32{1}); err != nil {
+		t.Fatalf("bulkUpdateItems(...): %v", err)
+	}
+	if got := <-names; got != "" {
+		t.Errorf("bulkUpdateItems(...): want the list's name remapped, got %q", got)
+	}
+}
+
+func TestCallConnTimeout(t *testing.T) {
+	conn := serveStreams(t, func(_ interface{}, stream grpc.ServerStream) error {
+		<-stream.Context().Done()
+		return stream.Context().Err()
+	})
+
+	attempts := &rpcAttempts{}
+	cc := &callConn{ClientConnInterface: conn, timeout: 10 * time.Millisecond, attempts: attempts}
+	if _, err := bulkUpdateItems(context.Background(), newBulkUpdateClient(cc), "cool-list", []int32{1}); status.Code(err) != codes.DeadlineExceeded {
+		t.Errorf("bulkUpdateItems(...): want DeadlineExceeded once the call timeout passed, got %v", err)
+	}
+	if got := attempts.drain(); len(got) != 1 || got[0].Code != codes.DeadlineExceeded.String() {
+		t.Errorf("bulkUpdateItems(...): want the timed out stream recorded, got %+v", got)
+	}
+}
+
+func TestStreamInterceptors(t *testing.T) {
+	clk := testingclock.NewFakeClock(time.Now())
+	bp := newBackpressureLimiter(&fakeRateLimiter{delay: 100 * time.Millisecond}, clk)
+	cb := newCircuitBreakers(1, time.Minute, clk)
+
+	streams := 0
+	conn := serveStreams(t, func(_ interface{}, _ grpc.ServerStream) error {
+		streams++
+		return status.Error(codes.ResourceExhausted, "quota exceeded")
+	}, grpc.WithChainStreamInterceptor(bp.interceptStream, cb.interceptStream))
+
+	c := newBulkUpdateClient(conn)
+	if _, err := bulkUpdateItems(context.Background(), c, "cool-list", []int32{1}); status.Code(err) != codes.ResourceExhausted {
+		t.Fatalf("bulkUpdateItems(...): want ResourceExhausted, got %v", err)
+	}
+	if got := bp.When("cool"); got != backpressureBaseDelay {
+		t.Errorf("bp.When(...): want reconciles slowed down once a stream was exhausted, got %s", got)
+	}
+
+	// The exhausted stream opened the breaker, so the next stream isn't
+	// opened at all.
+	if _, err := bulkUpdateItems(context.Background(), c, "cool-list", []int32{1}); status.Code(err) != codes.Unavailable {
+		t.Errorf("bulkUpdateItems(...): want Unavailable while the breaker is open, got %v", err)
+	}
+	if streams != 1 {
+		t.Errorf("bulkUpdateItems(...): want no stream opened while the breaker is open, got %d streams", streams)
+	}
+}
+
+func TestDeleteListStreamCallConn(t *testing.T) {
+	conn := serveStreams(t, func(_ interface{}, stream grpc.ServerStream) error {
+		if err := stream.RecvMsg(new(listServicepb.DeleteListReq)); err != nil {
+			return err
+		}
+		if err := stream.SendMsg(&listServicepb.DeleteListResp{Status: statusDeleting}); err != nil {
+			return err
+		}
+		// The deletion never completes.
+		<-stream.Context().Done()
+		return stream.Context().Err()
+	})
+
+	attempts := &rpcAttempts{}
+	cc := &callConn{ClientConnInterface: conn, timeout: 10 * time.Millisecond, attempts: attempts}
+	if _, err := streamDeleteList(context.Background(), newDeleteListStreamClient(cc), "cool-list"); status.Code(err) != codes.DeadlineExceeded {
+		t.Errorf("streamDeleteList(...): want DeadlineExceeded once the call timeout passed, got %v", err)
+	}
+	got := attempts.drain()
+	if len(got) != 1 || got[0].Method != "DeleteListStream" || got[0].Code != codes.DeadlineExceeded.String() {
+		t.Errorf("streamDeleteList(...): want the timed out stream recorded, got %+v", got)
+	}
+}
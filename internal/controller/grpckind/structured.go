@@ -0,0 +1,115 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpckind
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+
+	"google.golang.org/grpc/metadata"
+
+	"github.com/crossplane/provider-grpc/apis/mygroup/v1alpha1"
+)
+
+// structuredItemsKey is the metadata key used to send a list's structured
+// items with CreateList and UpdateListItems, and to receive them with
+// GetList's response header. The ListService items are plain int32s, so the
+// plain items of a list with structured items are empty and its structured
+// items are sent alongside them as a JSON array of structuredItem.
+const structuredItemsKey = "x-list-structured-items"
+
+// structuredItem is a structured list item and its metadata.
+type structuredItem struct {
+	Value  json.RawMessage   `json:"value"`
+	Weight *int32            `json:"weight,omitempty"`
+	Labels map[string]string `json:"labels,omitempty"`
+}
+
+// desiredStructuredItems returns the GrpcKind's structured items, with the
+// metadata of each. It returns nil if the GrpcKind has no structured items.
+func desiredStructuredItems(cr *v1alpha1.GrpcKind) []structuredItem {
+	p := cr.Spec.ForProvider
+	if len(p.StructuredListItems) == 0 {
+		return nil
+	}
+	items := make([]structuredItem, len(p.StructuredListItems))
+	for i, v := range p.StructuredListItems {
+		items[i] = structuredItem{Value: v.Raw}
+		if i < len(p.ListItemMetadata) {
+			items[i].Weight = p.ListItemMetadata[i].Weight
+			items[i].Labels = p.ListItemMetadata[i].Labels
+		}
+	}
+	return items
+}
+
+// withStructuredItems returns a context that sends the GrpcKind's structured
+// items to the backend, if it has any.
+func withStructuredItems(ctx context.Context, cr *v1alpha1.GrpcKind) context.Context {
+	items := desiredStructuredItems(cr)
+	if len(items) == 0 {
+		return ctx
+	}
+	b, err := json.Marshal(items)
+	if err != nil {
+		return ctx
+	}
+	return metadata.AppendToOutgoingContext(ctx, structuredItemsKey, string(b))
+}
+
+// structuredItemsDrifted returns true if the backend reported structured items
+// in the supplied response header that differ from the desired ones. Items
+// are compared as JSON values, so formatting and object key order don't
+// matter. Items that can't be parsed are considered different. Backends that
+// don't report structured items never drift, nor do GrpcKinds without any.
+func structuredItemsDrifted(cr *v1alpha1.GrpcKind, header metadata.MD) bool {
+	want := desiredStructuredItems(cr)
+	got := header.Get(structuredItemsKey)
+	if len(want) == 0 || len(got) == 0 {
+		return false
+	}
+	b, err := json.Marshal(want)
+	if err != nil {
+		return true
+	}
+	var w, g interface{}
+	if err := json.Unmarshal(b, &w); err != nil {
+		return true
+	}
+	if err := json.Unmarshal([]byte(got[0]), &g); err != nil {
+		return true
+	}
+	return !reflect.DeepEqual(normalizeStructuredItems(w), normalizeStructuredItems(g))
+}
+
+// normalizeStructuredItems drops the empty label maps of the supplied decoded
+// structured items, so that equivalent items compare equal.
+func normalizeStructuredItems(v interface{}) interface{} {
+	items, ok := v.([]interface{})
+	if !ok {
+		return v
+	}
+	for _, it := range items {
+		if m, ok := it.(map[string]interface{}); ok {
+			if l, ok := m["labels"].(map[string]interface{}); ok && len(l) == 0 {
+				delete(m, "labels")
+			}
+		}
+	}
+	return items
+}
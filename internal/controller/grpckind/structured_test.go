@@ -0,0 +1,79 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpckind
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/crossplane/provider-grpc/apis/mygroup/v1alpha1"
+)
+
+func TestStructuredItemsDrifted(t *testing.T) {
+	cases := map[string]struct {
+		reason string
+		mg     *v1alpha1.GrpcKind
+		header metadata.MD
+		want   bool
+	}{
+		"NoDesiredItems": {
+			reason: "GrpcKinds without structured items should never drift.",
+			mg:     grpcKindWith("cool-list", withItems(1)),
+			header: metadata.Pairs(structuredItemsKey, `[{"value":"a"}]`),
+			want:   false,
+		},
+		"NotReported": {
+			reason: "Backends that don't report structured items should never drift.",
+			mg:     grpcKindWith("cool-list", withListStructuredItems(`"a"`)),
+			want:   false,
+		},
+		"Equal": {
+			reason: "Reported items equivalent to the desired items, regardless of formatting, key order, and empty labels, should not drift.",
+			mg:     grpcKindWith("cool-list", withListStructuredItems(`{"b": 1, "a": [true, null]}`), withListItemMetadata(v1alpha1.ListItemMetadata{Weight: int32Ptr(3)})),
+			header: metadata.Pairs(structuredItemsKey, `[{"labels":{},"value":{"a":[true,null],"b":1},"weight":3}]`),
+			want:   false,
+		},
+		"DifferentValue": {
+			reason: "Reported items whose values differ from the desired items should drift.",
+			mg:     grpcKindWith("cool-list", withListStructuredItems(`"a"`, `"b"`)),
+			header: metadata.Pairs(structuredItemsKey, `[{"value":"b"},{"value":"a"}]`),
+			want:   true,
+		},
+		"DifferentMetadata": {
+			reason: "Reported items whose metadata differs from the desired items should drift.",
+			mg:     grpcKindWith("cool-list", withListStructuredItems(`"a"`), withListItemMetadata(v1alpha1.ListItemMetadata{Labels: map[string]string{"tier": "gold"}})),
+			header: metadata.Pairs(structuredItemsKey, `[{"value":"a"}]`),
+			want:   true,
+		},
+		"Unparseable": {
+			reason: "Reported items that can't be parsed should drift.",
+			mg:     grpcKindWith("cool-list", withListStructuredItems(`"a"`)),
+			header: metadata.Pairs(structuredItemsKey, "a"),
+			want:   true,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := structuredItemsDrifted(tc.mg, tc.header)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\n%s\nstructuredItemsDrifted(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
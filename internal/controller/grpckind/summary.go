@@ -0,0 +1,34 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpckind
+
+import (
+	"github.com/crossplane/provider-grpc/apis/mygroup/v1alpha1"
+)
+
+// summarize records a summary of the supplied observed list in the GrpcKind's
+// status. It must be called once the rest of the observation was recorded, so
+// that the summary is consistent with it.
+func (c *external) summarize(cr *v1alpha1.GrpcKind, obs *observedList) {
+	now := c.now()
+	cr.Status.AtProvider.Summary = &v1alpha1.GrpcKindSummary{
+		Status:            cr.Status.AtProvider.Status,
+		ItemCount:         int32(len(obs.items)),
+		LastReconcileTime: &now,
+		BackendVersion:    cr.Status.AtProvider.BackendVersion,
+	}
+}
@@ -0,0 +1,88 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpckind
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	testingclock "k8s.io/utils/clock/testing"
+
+	listServicepb "github.com/ashwinshirva/provider-grpc-server/proto"
+	"github.com/crossplane/provider-grpc/apis/mygroup/v1alpha1"
+)
+
+func TestObserveSummary(t *testing.T) {
+	now := time.Date(2022, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	cases := map[string]struct {
+		reason string
+		resp   *listServicepb.GetListResp
+		cr     *v1alpha1.GrpcKind
+	}{
+		"UpToDate": {
+			reason: "The summary of an up to date list should be consistent with its observation.",
+			resp:   &listServicepb.GetListResp{Status: statusSuccess, Items: []int32{1, 2}},
+			cr:     grpcKindWith("cool-list", withItems(1, 2)),
+		},
+		"Drifted": {
+			reason: "The summary of a drifted list should count the items the backend has, not the desired items.",
+			resp:   &listServicepb.GetListResp{Status: "PENDING", Items: []int32{1}},
+			cr:     grpcKindWith("cool-list", withItems(1, 2, 3)),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := external{
+				service: &ListService{grpcClient: &fakeListServiceClient{
+					MockGetList: func(_ context.Context, _ *listServicepb.GetListReq, opts ...grpc.CallOption) (*listServicepb.GetListResp, error) {
+						for _, o := range opts {
+							if h, ok := o.(grpc.HeaderCallOption); ok {
+								*h.HeaderAddr = metadata.Pairs(versionKey, "1.2.0")
+							}
+						}
+						return tc.resp, nil
+					},
+				}},
+				clock: testingclock.NewFakeClock(now),
+			}
+
+			if _, err := e.Observe(context.Background(), tc.cr); err != nil {
+				t.Fatalf("\n%s\ne.Observe(...): %v", tc.reason, err)
+			}
+			o := tc.cr.Status.AtProvider
+			want := &v1alpha1.GrpcKindSummary{
+				Status:            o.Status,
+				ItemCount:         int32(len(tc.resp.Items)),
+				LastReconcileTime: &metav1.Time{Time: now},
+				BackendVersion:    o.BackendVersion,
+			}
+			if diff := cmp.Diff(want, o.Summary); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want summary, +got summary:\n%s\n", tc.reason, diff)
+			}
+			if o.Summary.BackendVersion != "1.2.0" || o.Summary.Status != tc.resp.Status {
+				t.Errorf("\n%s\ne.Observe(...): want summary of status %q and version %q, got %+v", tc.reason, tc.resp.Status, "1.2.0", o.Summary)
+			}
+		})
+	}
+}
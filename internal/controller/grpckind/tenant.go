@@ -0,0 +1,48 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpckind
+
+import (
+	"context"
+
+	"google.golang.org/grpc/metadata"
+
+	"github.com/crossplane/provider-grpc/apis/mygroup/v1alpha1"
+)
+
+// tenantKey is the metadata key used to send the tenant a list belongs to with
+// every call concerning the list. None of the ListService messages have a
+// field for it.
+const tenantKey = "x-list-tenant"
+
+// withTenant returns a context that sends the GrpcKind's tenant with every
+// call made using it, if it has one.
+func withTenant(ctx context.Context, cr *v1alpha1.GrpcKind) context.Context {
+	if t := cr.Spec.ForProvider.Tenant; t != nil {
+		return metadata.AppendToOutgoingContext(ctx, tenantKey, *t)
+	}
+	return ctx
+}
+
+// listID returns what identifies the supplied GrpcKind's list on its backend:
+// the list's name, qualified by its tenant if it has one.
+func listID(cr *v1alpha1.GrpcKind) string {
+	if t := cr.Spec.ForProvider.Tenant; t != nil {
+		return *t + "/" + cr.Spec.ForProvider.Name
+	}
+	return cr.Spec.ForProvider.Name
+}
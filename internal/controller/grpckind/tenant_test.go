@@ -0,0 +1,116 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpckind
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	listServicepb "github.com/ashwinshirva/provider-grpc-server/proto"
+	"github.com/crossplane/provider-grpc/apis/mygroup/v1alpha1"
+)
+
+func TestTenantPropagated(t *testing.T) {
+	var tenants []string
+	record := func(ctx context.Context) {
+		md, _ := metadata.FromOutgoingContext(ctx)
+		tenants = append(tenants, md.Get(tenantKey)...)
+	}
+
+	e := &external{service: &ListService{grpcClient: &fakeListServiceClient{
+		MockGetList: func(ctx context.Context, _ *listServicepb.GetListReq, _ ...grpc.CallOption) (*listServicepb.GetListResp, error) {
+			record(ctx)
+			return &listServicepb.GetListResp{Status: "SUCCESS"}, nil
+		},
+		MockCreateList: func(ctx context.Context, _ *listServicepb.CreateListReq, _ ...grpc.CallOption) (*listServicepb.CreateListResp, error) {
+			record(ctx)
+			return &listServicepb.CreateListResp{Status: "SUCCESS"}, nil
+		},
+		MockUpdateListItems: func(ctx context.Context, _ *listServicepb.UpdateListItemsReq, _ ...grpc.CallOption) (*listServicepb.UpdateListItemsResp, error) {
+			record(ctx)
+			return &listServicepb.UpdateListItemsResp{Status: "SUCCESS"}, nil
+		},
+		MockDeleteList: func(ctx context.Context, _ *listServicepb.DeleteListReq, _ ...grpc.CallOption) (*listServicepb.DeleteListResp, error) {
+			record(ctx)
+			return &listServicepb.DeleteListResp{Status: "DELETED"}, nil
+		},
+	}}}
+
+	cr := grpcKindWith("cool-list", withItems(1), withListTenant("acme"), func(cr *v1alpha1.GrpcKind) { p := v1alpha1.DeleteAsync; cr.Spec.ForProvider.DeletePolicy = &p })
+	if _, err := e.Observe(context.Background(), cr); err != nil {
+		t.Fatalf("e.Observe(...): unexpected error: %v", err)
+	}
+	if _, err := e.Create(context.Background(), cr); err != nil {
+		t.Fatalf("e.Create(...): unexpected error: %v", err)
+	}
+	if _, err := e.Update(context.Background(), cr); err != nil {
+		t.Fatalf("e.Update(...): unexpected error: %v", err)
+	}
+	if err := e.Delete(context.Background(), cr); err != nil {
+		t.Fatalf("e.Delete(...): unexpected error: %v", err)
+	}
+
+	// Observe's GetList is reused by Update to merge items.
+	want := []string{"acme", "acme", "acme", "acme"}
+	if diff := cmp.Diff(want, tenants); diff != "" {
+		t.Errorf("-want tenant of each call, +got tenant of each call:\n%s\n", diff)
+	}
+}
+
+func TestListID(t *testing.T) {
+	cases := map[string]struct {
+		reason string
+		a, b   string
+		same   bool
+	}{
+		"SameTenant": {
+			reason: "Same-named lists of the same tenant are the same list.",
+			a:      "acme",
+			b:      "acme",
+			same:   true,
+		},
+		"DifferentTenants": {
+			reason: "Same-named lists of different tenants are different lists.",
+			a:      "acme",
+			b:      "globex",
+			same:   false,
+		},
+		"NoTenant": {
+			reason: "A list without a tenant is not the same as a same-named list of a tenant.",
+			a:      "acme",
+			same:   false,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			a, b := grpcKind("cool-list"), grpcKind("cool-list")
+			if tc.a != "" {
+				withListTenant(tc.a)(a)
+			}
+			if tc.b != "" {
+				withListTenant(tc.b)(b)
+			}
+			if diff := cmp.Diff(tc.same, listID(a) == listID(b)); diff != "" {
+				t.Errorf("\n%s\nlistID(...) == listID(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
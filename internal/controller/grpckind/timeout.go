@@ -0,0 +1,93 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpckind
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+
+	listServicepb "github.com/ashwinshirva/provider-grpc-server/proto"
+
+	"github.com/crossplane/provider-grpc/apis/mygroup/v1alpha1"
+	apisv1alpha1 "github.com/crossplane/provider-grpc/apis/v1alpha1"
+)
+
+// AnnotationKeyCallTimeout is the annotation a GrpcKind may use to override
+// its ProviderConfig's call timeout, e.g. because its list is very large. Its
+// value is a positive duration, e.g. 2m.
+const AnnotationKeyCallTimeout = "grpc.crossplane.io/call-timeout"
+
+const errCallTimeoutFmt = "invalid %s annotation %q: must be a positive duration"
+
+// callTimeout returns how long each call made for the supplied GrpcKind may
+// take. Calls may take as long as their context allows if it returns zero.
+// The ProviderConfig's call timeout grows with the GrpcKind's items if it
+// configures a per item timeout, whereas the annotation is used as is.
+func callTimeout(cr *v1alpha1.GrpcKind, spec apisv1alpha1.ProviderConfigSpec) (time.Duration, error) {
+	if v, ok := cr.GetAnnotations()[AnnotationKeyCallTimeout]; ok {
+		d, err := time.ParseDuration(v)
+		if err != nil || d <= 0 {
+			return 0, errors.Errorf(errCallTimeoutFmt, AnnotationKeyCallTimeout, v)
+		}
+		return d, nil
+	}
+	if spec.CallTimeout == nil {
+		return 0, nil
+	}
+	d := spec.CallTimeout.Duration
+	if per := spec.CallTimeoutPerItem; per != nil {
+		d += time.Duration(len(cr.Spec.ForProvider.ListItems)+len(cr.Spec.ForProvider.StructuredListItems)) * per.Duration
+	}
+	if max := spec.MaxCallTimeout; max != nil && d > max.Duration {
+		d = max.Duration
+	}
+	return d, nil
+}
+
+// A timeoutListService is a ListServiceClient that bounds how long each call
+// to the ListServiceClient it wraps may take.
+type timeoutListService struct {
+	listServicepb.ListServiceClient
+	timeout time.Duration
+}
+
+func (c *timeoutListService) CreateList(ctx context.Context, in *listServicepb.CreateListReq, opts ...grpc.CallOption) (*listServicepb.CreateListResp, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+	return c.ListServiceClient.CreateList(ctx, in, opts...)
+}
+
+func (c *timeoutListService) GetList(ctx context.Context, in *listServicepb.GetListReq, opts ...grpc.CallOption) (*listServicepb.GetListResp, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+	return c.ListServiceClient.GetList(ctx, in, opts...)
+}
+
+func (c *timeoutListService) UpdateListItems(ctx context.Context, in *listServicepb.UpdateListItemsReq, opts ...grpc.CallOption) (*listServicepb.UpdateListItemsResp, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+	return c.ListServiceClient.UpdateListItems(ctx, in, opts...)
+}
+
+func (c *timeoutListService) DeleteList(ctx context.Context, in *listServicepb.DeleteListReq, opts ...grpc.CallOption) (*listServicepb.DeleteListResp, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+	return c.ListServiceClient.DeleteList(ctx, in, opts...)
+}
@@ -0,0 +1,194 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpckind
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	listServicepb "github.com/ashwinshirva/provider-grpc-server/proto"
+
+	"github.com/crossplane/provider-grpc/apis/mygroup/v1alpha1"
+	apisv1alpha1 "github.com/crossplane/provider-grpc/apis/v1alpha1"
+)
+
+func TestCallTimeout(t *testing.T) {
+	type want struct {
+		timeout time.Duration
+		err     error
+	}
+
+	cases := map[string]struct {
+		reason     string
+		pc         *metav1.Duration
+		annotation *string
+		want       want
+	}{
+		"Unbounded": {
+			reason: "Calls should not be bounded if neither the ProviderConfig nor the GrpcKind configure a timeout.",
+		},
+		"ProviderConfig": {
+			reason: "Calls should be bounded by the ProviderConfig's call timeout.",
+			pc:     &metav1.Duration{Duration: time.Minute},
+			want:   want{timeout: time.Minute},
+		},
+		"Annotation": {
+			reason:     "The GrpcKind's call timeout annotation should override the ProviderConfig's call timeout.",
+			pc:         &metav1.Duration{Duration: time.Minute},
+			annotation: strPtr("10m"),
+			want:       want{timeout: 10 * time.Minute},
+		},
+		"InvalidAnnotation": {
+			reason:     "A call timeout annotation that isn't a duration should be rejected.",
+			annotation: strPtr("forever"),
+			want:       want{err: errors.Errorf(errCallTimeoutFmt, AnnotationKeyCallTimeout, "forever")},
+		},
+		"NegativeAnnotation": {
+			reason:     "A call timeout annotation that isn't positive should be rejected.",
+			annotation: strPtr("-1m"),
+			want:       want{err: errors.Errorf(errCallTimeoutFmt, AnnotationKeyCallTimeout, "-1m")},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var deadline time.Time
+			c := connector{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, _ client.ObjectKey, obj client.Object) error {
+						if o, ok := obj.(*apisv1alpha1.ProviderConfig); ok {
+							o.Spec.Credentials.Source = xpv1.CredentialsSourceNone
+							o.Spec.CallTimeout = tc.pc
+						}
+						return nil
+					},
+				},
+				usage: resource.TrackerFn(func(_ context.Context, _ resource.Managed) error { return nil }),
+				newServiceFn: func(_ string, _ []byte, _ transport) (*ListService, error) {
+					return &ListService{grpcClient: &fakeListServiceClient{
+						MockGetList: func(ctx context.Context, _ *listServicepb.GetListReq, _ ...grpc.CallOption) (*listServicepb.GetListResp, error) {
+							deadline, _ = ctx.Deadline()
+							return &listServicepb.GetListResp{Status: "SUCCESS"}, nil
+						},
+					}}, nil
+				},
+			}
+			cr := grpcKindWith("cool-list", func(cr *v1alpha1.GrpcKind) {
+				if tc.annotation != nil {
+					cr.SetAnnotations(map[string]string{AnnotationKeyCallTimeout: *tc.annotation})
+				}
+			})
+
+			start := time.Now()
+			e, err := c.Connect(context.Background(), cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Fatalf("\n%s\nc.Connect(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if err != nil {
+				return
+			}
+			if _, err := e.Observe(context.Background(), cr); err != nil {
+				t.Fatalf("\n%s\ne.Observe(...): %v", tc.reason, err)
+			}
+
+			var got time.Duration
+			if !deadline.IsZero() {
+				got = deadline.Sub(start).Round(time.Second)
+			}
+			if diff := cmp.Diff(tc.want.timeout, got); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want GetList timeout, +got GetList timeout:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestScaledCallTimeout(t *testing.T) {
+	spec := apisv1alpha1.ProviderConfigSpec{
+		CallTimeout:        &metav1.Duration{Duration: 10 * time.Second},
+		CallTimeoutPerItem: &metav1.Duration{Duration: 100 * time.Millisecond},
+		MaxCallTimeout:     &metav1.Duration{Duration: time.Minute},
+	}
+
+	cases := map[string]struct {
+		reason string
+		items  int
+		spec   apisv1alpha1.ProviderConfigSpec
+		want   time.Duration
+	}{
+		"NoItems": {
+			reason: "A list without items should use the ProviderConfig's call timeout.",
+			spec:   spec,
+			want:   10 * time.Second,
+		},
+		"SomeItems": {
+			reason: "The call timeout should grow by the per item timeout for each item.",
+			items:  100,
+			spec:   spec,
+			want:   20 * time.Second,
+		},
+		"MoreItems": {
+			reason: "The call timeout should keep growing with the number of items.",
+			items:  400,
+			spec:   spec,
+			want:   50 * time.Second,
+		},
+		"Bounded": {
+			reason: "The call timeout should grow no larger than the maximum call timeout.",
+			items:  10000,
+			spec:   spec,
+			want:   time.Minute,
+		},
+		"Unbounded": {
+			reason: "The call timeout should grow without limit if there is no maximum call timeout.",
+			items:  10000,
+			spec: apisv1alpha1.ProviderConfigSpec{
+				CallTimeout:        spec.CallTimeout,
+				CallTimeoutPerItem: spec.CallTimeoutPerItem,
+			},
+			want: 1010 * time.Second,
+		},
+		"NoCallTimeout": {
+			reason: "Calls should not be bounded without a base call timeout, whatever the per item timeout.",
+			items:  100,
+			spec:   apisv1alpha1.ProviderConfigSpec{CallTimeoutPerItem: spec.CallTimeoutPerItem},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			cr := grpcKindWith("cool-list", withItems(make([]int32, tc.items)...))
+			got, err := callTimeout(cr, tc.spec)
+			if err != nil {
+				t.Fatalf("\n%s\ncallTimeout(...): %v", tc.reason, err)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\n%s\ncallTimeout(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
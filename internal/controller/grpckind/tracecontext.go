@@ -0,0 +1,54 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpckind
+
+import (
+	"context"
+
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+)
+
+// PropagateTraceContext makes calls to the backend carry the trace context of
+// the reconcile that makes them, using the global OpenTelemetry propagator, so
+// that the backend's spans join the reconcile's trace.
+var PropagateTraceContext = false
+
+// tracerName is the name of the OpenTelemetry tracer reconciles start their
+// spans with.
+const tracerName = "github.com/crossplane/provider-grpc/internal/controller/grpckind"
+
+// traceDialOptions returns the options that make a connection propagate the
+// trace context of its calls, if PropagateTraceContext is set.
+func traceDialOptions() []grpc.DialOption {
+	if !PropagateTraceContext {
+		return nil
+	}
+	return []grpc.DialOption{
+		grpc.WithChainUnaryInterceptor(otelgrpc.UnaryClientInterceptor()),
+		grpc.WithChainStreamInterceptor(otelgrpc.StreamClientInterceptor()),
+	}
+}
+
+// startSpan starts a span of the supplied reconcile operation using the global
+// OpenTelemetry tracer provider. Calls to the backend made with the returned
+// context are part of the span's trace.
+func startSpan(ctx context.Context, op string) (context.Context, trace.Span) {
+	return otel.Tracer(tracerName).Start(ctx, "GrpcKind."+op)
+}
@@ -0,0 +1,105 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpckind
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/test/bufconn"
+
+	listServicepb "github.com/ashwinshirva/provider-grpc-server/proto"
+)
+
+// A tracedListServer records the traceparent of the GetList calls it serves.
+type tracedListServer struct {
+	listServicepb.UnimplementedListServiceServer
+	traceparents chan []string
+}
+
+func (s tracedListServer) GetList(ctx context.Context, _ *listServicepb.GetListReq) (*listServicepb.GetListResp, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	s.traceparents <- md.Get("traceparent")
+	return &listServicepb.GetListResp{Status: statusSuccess, Items: []int32{1}}, nil
+}
+
+func TestPropagateTraceContext(t *testing.T) {
+	prev := otel.GetTextMapPropagator()
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+	defer otel.SetTextMapPropagator(prev)
+
+	traceID, _ := trace.TraceIDFromHex("4bf92f3577b34da6a3ce929d0e0e4736")
+	spanID, _ := trace.SpanIDFromHex("00f067aa0ba902b7")
+	sc := trace.NewSpanContext(trace.SpanContextConfig{TraceID: traceID, SpanID: spanID, TraceFlags: trace.FlagsSampled})
+
+	cases := map[string]struct {
+		reason  string
+		enabled bool
+		want    []string
+	}{
+		"Enabled": {
+			reason:  "Calls should carry the trace context of the reconcile.",
+			enabled: true,
+			want:    []string{"00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01"},
+		},
+		"Disabled": {
+			reason: "Calls should carry no trace context unless propagation is enabled.",
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			defer func(v bool) { PropagateTraceContext = v }(PropagateTraceContext)
+			PropagateTraceContext = tc.enabled
+
+			srv := tracedListServer{traceparents: make(chan []string, 1)}
+			lis := bufconn.Listen(1024 * 1024)
+			s := grpc.NewServer()
+			listServicepb.RegisterListServiceServer(s, srv)
+			go func() { _ = s.Serve(lis) }()
+			defer s.Stop()
+
+			opts := append(traceDialOptions(),
+				grpc.WithTransportCredentials(insecure.NewCredentials()),
+				grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+					return lis.DialContext(ctx)
+				}))
+			conn, err := grpc.Dial("passthrough:///bufconn", opts...)
+			if err != nil {
+				t.Fatalf("grpc.Dial(...): %v", err)
+			}
+			defer func() { _ = conn.Close() }()
+
+			e := external{service: &ListService{grpcClient: listServicepb.NewListServiceClient(conn)}}
+			ctx := trace.ContextWithSpanContext(context.Background(), sc)
+			if _, err := e.Observe(ctx, grpcKindWith("cool-list", withItems(1))); err != nil {
+				t.Fatalf("\n%s\ne.Observe(...): %v", tc.reason, err)
+			}
+			if diff := cmp.Diff(tc.want, <-srv.traceparents); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want traceparent, +got traceparent:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,105 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpckind
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/clock"
+
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+
+	"github.com/crossplane/provider-grpc/apis/mygroup/v1alpha1"
+)
+
+// TraceEvents makes each step of a reconcile, such as observing drift or
+// starting an update, be recorded as a Kubernetes event of the managed
+// resource. It's verbose, so it's meant for demos and debugging.
+var TraceEvents = false
+
+// TraceEventInterval is how long a trace event suppresses identical events of
+// the same managed resource, so that rapid reconciles don't cause an event
+// storm.
+var TraceEventInterval = time.Minute
+
+// Reasons of trace events.
+const (
+	reasonTraceNotFound event.Reason = "ObservedNotFound"
+	reasonTraceDrift    event.Reason = "ObservedDrift"
+	reasonTraceUpToDate event.Reason = "ObservedUpToDate"
+	reasonTraceCreate   event.Reason = "StartingCreate"
+	reasonTraceUpdate   event.Reason = "StartingUpdate"
+	reasonTraceDelete   event.Reason = "StartingDelete"
+)
+
+// A traceRecorder records trace events, suppressing events identical to one
+// recorded for the same managed resource within the interval.
+type traceRecorder struct {
+	recorder event.Recorder
+	interval time.Duration
+	clock    clock.PassiveClock
+
+	mu       sync.Mutex
+	recorded map[traceEvent]time.Time
+}
+
+type traceEvent struct {
+	uid     types.UID
+	reason  event.Reason
+	message string
+}
+
+// newTraceRecorder returns a traceRecorder that records trace events using the
+// supplied recorder.
+func newTraceRecorder(r event.Recorder, interval time.Duration, c clock.PassiveClock) *traceRecorder {
+	return &traceRecorder{
+		recorder: r,
+		interval: interval,
+		clock:    c,
+		recorded: make(map[traceEvent]time.Time),
+	}
+}
+
+// Trace records a normal event of the supplied GrpcKind, unless an identical
+// event was recorded within the interval. A nil traceRecorder records nothing.
+func (r *traceRecorder) Trace(cr *v1alpha1.GrpcKind, reason event.Reason, format string, args ...interface{}) {
+	if r == nil {
+		return
+	}
+	e := traceEvent{uid: cr.GetUID(), reason: reason, message: fmt.Sprintf(format, args...)}
+
+	r.mu.Lock()
+	now := r.clock.Now()
+	for k, at := range r.recorded {
+		if now.Sub(at) >= r.interval {
+			delete(r.recorded, k)
+		}
+	}
+	_, suppressed := r.recorded[e]
+	if !suppressed {
+		r.recorded[e] = now
+	}
+	r.mu.Unlock()
+
+	if suppressed {
+		return
+	}
+	r.recorder.Event(cr, event.Normal(e.reason, e.message))
+}
@@ -0,0 +1,92 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpckind
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/grpc"
+	"k8s.io/apimachinery/pkg/runtime"
+	testingclock "k8s.io/utils/clock/testing"
+
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+
+	listServicepb "github.com/ashwinshirva/provider-grpc-server/proto"
+)
+
+// A fakeRecorder remembers the reasons of the events it records.
+type fakeRecorder struct {
+	reasons []event.Reason
+}
+
+func (r *fakeRecorder) Event(_ runtime.Object, e event.Event) {
+	r.reasons = append(r.reasons, e.Reason)
+}
+
+func (r *fakeRecorder) WithAnnotations(_ ...string) event.Recorder { return r }
+
+func TestTraceEventsThrottled(t *testing.T) {
+	rec := &fakeRecorder{}
+	clk := testingclock.NewFakeClock(time.Now())
+	e := external{
+		service: &ListService{grpcClient: &fakeListServiceClient{
+			MockGetList: func(_ context.Context, _ *listServicepb.GetListReq, _ ...grpc.CallOption) (*listServicepb.GetListResp, error) {
+				return &listServicepb.GetListResp{Status: statusSuccess, Items: []int32{1}}, nil
+			},
+			MockUpdateListItems: func(_ context.Context, _ *listServicepb.UpdateListItemsReq, _ ...grpc.CallOption) (*listServicepb.UpdateListItemsResp, error) {
+				return &listServicepb.UpdateListItemsResp{}, nil
+			},
+		}},
+		traces: newTraceRecorder(rec, time.Minute, clk),
+	}
+	cr := grpcKindWith("cool-list", withItems(1, 2))
+	cr.SetUID("cool-uid")
+
+	// Rapid reconciles of a drifted list record each step once.
+	for i := 0; i < 5; i++ {
+		if _, err := e.Observe(context.Background(), cr); err != nil {
+			t.Fatalf("e.Observe(...): unexpected error: %v", err)
+		}
+		if _, err := e.Update(context.Background(), cr); err != nil {
+			t.Fatalf("e.Update(...): unexpected error: %v", err)
+		}
+		clk.Step(time.Second)
+	}
+	want := []event.Reason{reasonTraceDrift, reasonTraceUpdate}
+	if diff := cmp.Diff(want, rec.reasons); diff != "" {
+		t.Errorf("e.Observe(...): -want reasons, +got reasons:\n%s\n", diff)
+	}
+
+	// Identical events are recorded again once the interval passed.
+	clk.Step(time.Minute)
+	if _, err := e.Observe(context.Background(), cr); err != nil {
+		t.Fatalf("e.Observe(...): unexpected error: %v", err)
+	}
+	want = append(want, reasonTraceDrift)
+	if diff := cmp.Diff(want, rec.reasons); diff != "" {
+		t.Errorf("e.Observe(...): -want reasons, +got reasons:\n%s\n", diff)
+	}
+}
+
+func TestTraceEventsDisabled(t *testing.T) {
+	var r *traceRecorder
+	// A nil traceRecorder must not panic.
+	r.Trace(grpcKind("cool-list"), reasonTraceDrift, "List %q drifted", "cool-list")
+}
@@ -0,0 +1,142 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpckind
+
+import (
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	listServicepb "github.com/ashwinshirva/provider-grpc-server/proto"
+	"github.com/crossplane/provider-grpc/apis/mygroup/v1alpha1"
+	apisv1alpha1 "github.com/crossplane/provider-grpc/apis/v1alpha1"
+)
+
+// This file maps GrpcKinds to and from the messages of the backend's list
+// service. Backends that map fields differently only need to change it.
+
+// An observedList is the state of a list as reported by the backend.
+type observedList struct {
+	status    string
+	items     []int32
+	createdAt *metav1.Time
+	updatedAt *metav1.Time
+
+	// header is the response header the backend sent with the list.
+	header metadata.MD
+
+	// itemsMatch is whether the list's items match the desired items
+	// according to the checksum the backend reported, if any.
+	itemsMatch *bool
+}
+
+// toCreateReq returns a request that creates the list of the supplied
+// GrpcKind. An unset description is sent as an empty one.
+func toCreateReq(cr *v1alpha1.GrpcKind) *listServicepb.CreateListReq {
+	req := &listServicepb.CreateListReq{Name: cr.Spec.ForProvider.Name}
+	if d := cr.Spec.ForProvider.Description; d != nil {
+		req.Description = *d
+	}
+	return req
+}
+
+// toUpdateReq returns a request that replaces the items of the list of the
+// supplied GrpcKind.
+func toUpdateReq(cr *v1alpha1.GrpcKind) *listServicepb.UpdateListItemsReq {
+	return &listServicepb.UpdateListItemsReq{
+		Name:     cr.Spec.ForProvider.Name,
+		NewItems: cr.Spec.ForProvider.ListItems,
+	}
+}
+
+// remapFields returns a copy of the supplied request whose fields are sent as
+// the field numbers the supplied FieldMapping maps them to. Remapped fields
+// are sent as unknown fields, which are encoded like any other.
+func remapFields[M proto.Message](req M, fm *apisv1alpha1.FieldMapping) M {
+	out := proto.Clone(req).(M)
+	m := out.ProtoReflect()
+	remapField(m, "name", fm.Name)
+	remapField(m, "description", fm.Description)
+	remapField(m, "new_items", fm.ListItems)
+	return out
+}
+
+// remapField moves the named field of the supplied message, if it has one, to
+// the supplied field number.
+func remapField(m protoreflect.Message, name protoreflect.Name, num *int32) {
+	fd := m.Descriptor().Fields().ByName(name)
+	if fd == nil || num == nil || protowire.Number(*num) == fd.Number() || !m.Has(fd) {
+		return
+	}
+	to := protowire.Number(*num)
+	var b []byte
+	switch {
+	case fd.IsList() && fd.Kind() == protoreflect.Int32Kind:
+		var packed []byte
+		l := m.Get(fd).List()
+		for i := 0; i < l.Len(); i++ {
+			packed = protowire.AppendVarint(packed, uint64(l.Get(i).Int()))
+		}
+		b = protowire.AppendTag(b, to, protowire.BytesType)
+		b = protowire.AppendBytes(b, packed)
+	case fd.Kind() == protoreflect.StringKind:
+		b = protowire.AppendTag(b, to, protowire.BytesType)
+		b = protowire.AppendString(b, m.Get(fd).String())
+	default:
+		return
+	}
+	m.Clear(fd)
+	m.SetUnknown(append(m.GetUnknown(), b...))
+}
+
+// fromGetResp returns the state of a list described by the supplied GetList
+// response and header.
+func fromGetResp(resp *listServicepb.GetListResp, header metadata.MD) *observedList {
+	// A list without items may be reported with nil or empty items, depending
+	// on the backend. Always observe empty items so they compare consistently.
+	items := resp.GetItems()
+	if items == nil {
+		items = []int32{}
+	}
+	return &observedList{
+		status:    resp.GetStatus(),
+		items:     items,
+		createdAt: headerTime(header, createdAtKey),
+		updatedAt: headerTime(header, updatedAtKey),
+		header:    header,
+	}
+}
+
+// headerTime returns the RFC 3339 timestamp found at the supplied key of the
+// response header metadata, or nil if there is no valid timestamp.
+func headerTime(md metadata.MD, key string) *metav1.Time {
+	v := md.Get(key)
+	if len(v) == 0 {
+		return nil
+	}
+	t, err := time.Parse(time.RFC3339, v[0])
+	if err != nil {
+		log.Infof("Observe::Ignoring invalid %s timestamp %q: %v", key, v[0], err)
+		return nil
+	}
+	return &metav1.Time{Time: t}
+}
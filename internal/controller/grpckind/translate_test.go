@@ -0,0 +1,140 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpckind
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/testing/protocmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	listServicepb "github.com/ashwinshirva/provider-grpc-server/proto"
+	"github.com/crossplane/provider-grpc/apis/mygroup/v1alpha1"
+)
+
+func TestToCreateReq(t *testing.T) {
+	cases := map[string]struct {
+		reason string
+		cr     *v1alpha1.GrpcKind
+		want   *listServicepb.CreateListReq
+	}{
+		"NoDescription": {
+			reason: "An unset description should be sent as an empty one.",
+			cr:     grpcKind("cool-list"),
+			want:   &listServicepb.CreateListReq{Name: "cool-list"},
+		},
+		"Description": {
+			reason: "The description should be sent if set.",
+			cr:     grpcKindWith("cool-list", withDescription(strPtr("cool"))),
+			want:   &listServicepb.CreateListReq{Name: "cool-list", Description: "cool"},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, toCreateReq(tc.cr), protocmp.Transform()); diff != "" {
+				t.Errorf("\n%s\ntoCreateReq(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestToUpdateReq(t *testing.T) {
+	cases := map[string]struct {
+		reason string
+		cr     *v1alpha1.GrpcKind
+		want   *listServicepb.UpdateListItemsReq
+	}{
+		"NoItems": {
+			reason: "A list without items should be updated to have no items.",
+			cr:     grpcKind("cool-list"),
+			want:   &listServicepb.UpdateListItemsReq{Name: "cool-list"},
+		},
+		"Items": {
+			reason: "The items of the list should be sent.",
+			cr:     grpcKindWith("cool-list", withItems(1, 2)),
+			want:   &listServicepb.UpdateListItemsReq{Name: "cool-list", NewItems: []int32{1, 2}},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, toUpdateReq(tc.cr), protocmp.Transform()); diff != "" {
+				t.Errorf("\n%s\ntoUpdateReq(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestFromGetResp(t *testing.T) {
+	created := time.Date(2022, 10, 1, 12, 0, 0, 0, time.UTC)
+
+	cases := map[string]struct {
+		reason string
+		resp   *listServicepb.GetListResp
+		header metadata.MD
+		want   *observedList
+	}{
+		"NoHeader": {
+			reason: "A list without timestamps should be observed without them.",
+			resp:   &listServicepb.GetListResp{Status: "SUCCESS", Items: []int32{1}},
+			want:   &observedList{status: "SUCCESS", items: []int32{1}},
+		},
+		"NilItems": {
+			reason: "A list reported without items should be observed with empty items.",
+			resp:   &listServicepb.GetListResp{Status: "SUCCESS"},
+			want:   &observedList{status: "SUCCESS", items: []int32{}},
+		},
+		"EmptyItems": {
+			reason: "A list reported with empty items should be observed with empty items.",
+			resp:   &listServicepb.GetListResp{Status: "SUCCESS", Items: []int32{}},
+			want:   &observedList{status: "SUCCESS", items: []int32{}},
+		},
+		"Timestamps": {
+			reason: "Timestamps sent in the header should be observed.",
+			resp:   &listServicepb.GetListResp{Status: "SUCCESS"},
+			header: metadata.Pairs(createdAtKey, created.Format(time.RFC3339)),
+			want: &observedList{
+				status:    "SUCCESS",
+				items:     []int32{},
+				createdAt: &metav1.Time{Time: created},
+				header:    metadata.Pairs(createdAtKey, created.Format(time.RFC3339)),
+			},
+		},
+		"InvalidTimestamp": {
+			reason: "Invalid timestamps should be ignored.",
+			resp:   &listServicepb.GetListResp{Status: "SUCCESS"},
+			header: metadata.Pairs(updatedAtKey, "yesterday"),
+			want: &observedList{
+				status: "SUCCESS",
+				items:  []int32{},
+				header: metadata.Pairs(updatedAtKey, "yesterday"),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, fromGetResp(tc.resp, tc.header), cmp.AllowUnexported(observedList{})); diff != "" {
+				t.Errorf("\n%s\nfromGetResp(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
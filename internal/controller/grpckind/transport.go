@@ -0,0 +1,175 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpckind
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	apisv1alpha1 "github.com/crossplane/provider-grpc/apis/v1alpha1"
+)
+
+const (
+	errGetCASecret = "cannot get TLS certificate authority secret"
+	errParseCA     = "cannot parse TLS certificate authority"
+	errParseCert   = "cannot parse TLS client certificate"
+	errTLSRequired = "ProviderConfig must configure TLS because the provider requires TLS connections"
+)
+
+// A transport describes how a connection to a backend is established. The
+// zero value describes an insecure connection.
+type transport struct {
+	// authority overrides the :authority header sent to the backend.
+	authority string
+
+	// tls is true if the connection uses TLS.
+	tls bool
+
+	// ca is the PEM encoded certificate authority used to verify the
+	// backend. The host's root certificate authorities are used if empty.
+	ca string
+
+	// serverName overrides the name used to verify the backend's certificate.
+	serverName string
+
+	// clientCert and clientKey are the PEM encoded certificate and key used
+	// to authenticate to the backend, if any.
+	clientCert string
+	clientKey  string
+
+	// clientCertificate authenticates to the backend using a client
+	// certificate that may rotate, if set. It takes precedence over
+	// clientCert and clientKey.
+	clientCertificate *clientCertificate
+
+	// token is sent as a bearer token with each call, if set.
+	token string
+
+	// saToken authenticates each call using a ServiceAccount token, if set.
+	// It takes precedence over token.
+	saToken *serviceAccountToken
+
+	// loadBalancingPolicy balances requests across the backend's addresses.
+	// The gRPC default, pick_first, is used if empty.
+	loadBalancingPolicy string
+
+	// ipFamily is the IP family of the backend's addresses that are
+	// connected to first, if set.
+	ipFamily apisv1alpha1.IPFamily
+
+	// compression compresses requests, if set.
+	compression string
+
+	// waitForReady makes calls wait for the backend to become available
+	// rather than failing fast.
+	waitForReady bool
+}
+
+// getTransport returns the transport described by the supplied ProviderConfig.
+func getTransport(ctx context.Context, kube client.Client, spec apisv1alpha1.ProviderConfigSpec) (transport, error) {
+	t := transport{}
+	if spec.Authority != nil {
+		t.authority = *spec.Authority
+	}
+	if spec.LoadBalancingPolicy != nil {
+		t.loadBalancingPolicy = string(*spec.LoadBalancingPolicy)
+	}
+	if spec.Compression != nil {
+		t.compression = string(*spec.Compression)
+	}
+	if spec.PreferredIPFamily != nil {
+		t.ipFamily = *spec.PreferredIPFamily
+	}
+	t.waitForReady = spec.WaitForReady
+	if spec.TLS == nil {
+		return t, nil
+	}
+
+	t.tls = true
+	t.serverName = spec.TLS.ServerName
+	if ref := spec.TLS.CASecretRef; ref != nil {
+		s := &corev1.Secret{}
+		if err := kube.Get(ctx, types.NamespacedName{Namespace: ref.Namespace, Name: ref.Name}, s); err != nil {
+			return transport{}, errors.Wrap(err, errGetCASecret)
+		}
+		t.ca = string(s.Data[ref.Key])
+	}
+	return t, nil
+}
+
+// dialOptions returns the DialOptions that establish a connection using the
+// transport.
+func (t transport) dialOptions() ([]grpc.DialOption, error) {
+	opts := []grpc.DialOption{grpc.WithStatsHandler(compressionHandler{})}
+	if t.authority != "" {
+		opts = append(opts, grpc.WithAuthority(t.authority))
+	}
+	if t.loadBalancingPolicy != "" {
+		opts = append(opts, grpc.WithDefaultServiceConfig(fmt.Sprintf(`{"loadBalancingConfig":[{%q:{}}]}`, t.loadBalancingPolicy)))
+	}
+	if t.compression != "" {
+		opts = append(opts, grpc.WithDefaultCallOptions(grpc.UseCompressor(t.compression)))
+	}
+	if t.ipFamily != "" {
+		opts = append(opts, grpc.WithContextDialer(newFamilyDialer(t.ipFamily).DialContext))
+	}
+	if t.waitForReady {
+		// Calls are still bounded by their context, e.g. the call timeout.
+		opts = append(opts, grpc.WithDefaultCallOptions(grpc.WaitForReady(true)))
+	}
+	if !t.tls {
+		return append(opts, grpc.WithInsecure()), nil
+	}
+
+	cfg := &tls.Config{
+		ServerName: t.serverName,
+		MinVersion: tls.VersionTLS12,
+	}
+	if t.ca != "" {
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM([]byte(t.ca)) {
+			return nil, errors.New(errParseCA)
+		}
+		cfg.RootCAs = pool
+	}
+	switch {
+	case t.clientCertificate != nil:
+		cfg.GetClientCertificate = t.clientCertificate.GetClientCertificate
+	case t.clientCert != "":
+		cert, err := tls.X509KeyPair([]byte(t.clientCert), []byte(t.clientKey))
+		if err != nil {
+			return nil, errors.Wrap(err, errParseCert)
+		}
+		cfg.Certificates = []tls.Certificate{cert}
+	}
+	switch {
+	case t.saToken != nil:
+		opts = append(opts, grpc.WithPerRPCCredentials(t.saToken))
+	case t.token != "":
+		opts = append(opts, grpc.WithPerRPCCredentials(bearerToken(t.token)))
+	}
+	return append(opts, grpc.WithTransportCredentials(credentials.NewTLS(cfg))), nil
+}
@@ -0,0 +1,217 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpckind
+
+import (
+	"context"
+	"net"
+	"path/filepath"
+	"testing"
+
+	"google.golang.org/grpc"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/resolver"
+	"google.golang.org/grpc/resolver/manual"
+	"google.golang.org/grpc/test/bufconn"
+
+	apisv1alpha1 "github.com/crossplane/provider-grpc/apis/v1alpha1"
+)
+
+// authorityHealthServer records the :authority header of the health checks it
+// serves.
+type authorityHealthServer struct {
+	healthpb.UnimplementedHealthServer
+	authority string
+	checks    int
+}
+
+func (s *authorityHealthServer) Check(ctx context.Context, _ *healthpb.HealthCheckRequest) (*healthpb.HealthCheckResponse, error) {
+	s.checks++
+	md, _ := metadata.FromIncomingContext(ctx)
+	if a := md.Get(":authority"); len(a) > 0 {
+		s.authority = a[0]
+	}
+	return &healthpb.HealthCheckResponse{Status: healthpb.HealthCheckResponse_SERVING}, nil
+}
+
+func TestTransportAuthority(t *testing.T) {
+	lis := bufconn.Listen(1024 * 1024)
+	hs := &authorityHealthServer{}
+	srv := grpc.NewServer()
+	healthpb.RegisterHealthServer(srv, hs)
+	go func() { _ = srv.Serve(lis) }()
+	defer srv.Stop()
+
+	opts, err := transport{authority: "lists.example.org"}.dialOptions()
+	if err != nil {
+		t.Fatalf("dialOptions(): %v", err)
+	}
+	opts = append(opts, grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+		return lis.DialContext(ctx)
+	}))
+	conn, err := grpc.Dial("passthrough:///10.0.0.1:443", opts...)
+	if err != nil {
+		t.Fatalf("grpc.Dial(...): %v", err)
+	}
+	defer func() { _ = conn.Close() }()
+
+	if _, err := healthpb.NewHealthClient(conn).Check(context.Background(), &healthpb.HealthCheckRequest{}); err != nil {
+		t.Fatalf("Check(...): %v", err)
+	}
+	if hs.authority != "lists.example.org" {
+		t.Errorf("dialOptions(): want authority %q, got %q", "lists.example.org", hs.authority)
+	}
+}
+
+func TestTransportLoadBalancingPolicy(t *testing.T) {
+	cases := map[string]struct {
+		reason string
+		policy string
+		both   bool
+	}{
+		"PickFirst": {
+			reason: "Requests should be sent to a single address by default.",
+			both:   false,
+		},
+		"RoundRobin": {
+			reason: "Requests should be balanced across all addresses using round_robin.",
+			policy: "round_robin",
+			both:   true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			listeners := map[string]*bufconn.Listener{}
+			servers := map[string]*authorityHealthServer{}
+			for _, addr := range []string{"a", "b"} {
+				lis := bufconn.Listen(1024 * 1024)
+				hs := &authorityHealthServer{}
+				srv := grpc.NewServer()
+				healthpb.RegisterHealthServer(srv, hs)
+				go func() { _ = srv.Serve(lis) }()
+				defer srv.Stop()
+				listeners[addr], servers[addr] = lis, hs
+			}
+
+			r := manual.NewBuilderWithScheme("lists")
+			r.InitialState(resolver.State{Addresses: []resolver.Address{{Addr: "a"}, {Addr: "b"}}})
+
+			opts, err := transport{loadBalancingPolicy: tc.policy}.dialOptions()
+			if err != nil {
+				t.Fatalf("\n%s\ndialOptions(): %v", tc.reason, err)
+			}
+			opts = append(opts, grpc.WithResolvers(r), grpc.WithContextDialer(func(ctx context.Context, addr string) (net.Conn, error) {
+				return listeners[addr].DialContext(ctx)
+			}))
+			conn, err := grpc.Dial("lists:///cool", opts...)
+			if err != nil {
+				t.Fatalf("\n%s\ngrpc.Dial(...): %v", tc.reason, err)
+			}
+			defer func() { _ = conn.Close() }()
+
+			// Round robin only balances across addresses that are ready, so
+			// allow time for both connections to be established.
+			hc := healthpb.NewHealthClient(conn)
+			for i := 0; i < 100 && !(servers["a"].checks > 0 && servers["b"].checks > 0); i++ {
+				if _, err := hc.Check(context.Background(), &healthpb.HealthCheckRequest{}, grpc.WaitForReady(true)); err != nil {
+					t.Fatalf("\n%s\nCheck(...): %v", tc.reason, err)
+				}
+			}
+
+			if got := servers["a"].checks > 0 && servers["b"].checks > 0; got != tc.both {
+				t.Errorf("\n%s\ndialOptions(): want requests sent to both addresses %t, got %d and %d requests", tc.reason, tc.both, servers["a"].checks, servers["b"].checks)
+			}
+		})
+	}
+}
+
+func TestDialUnixSocket(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "lists.sock")
+	lis, err := net.Listen("unix", path)
+	if err != nil {
+		t.Fatalf("net.Listen(...): %v", err)
+	}
+	srv := grpc.NewServer()
+	healthpb.RegisterHealthServer(srv, &authorityHealthServer{})
+	go func() { _ = srv.Serve(lis) }()
+	defer srv.Stop()
+
+	conn, err := dialListService("unix://"+path, transport{})
+	if err != nil {
+		t.Fatalf("dialListService(...): %v", err)
+	}
+	defer func() { _ = conn.Close() }()
+
+	if _, err := healthpb.NewHealthClient(conn).Check(context.Background(), &healthpb.HealthCheckRequest{}); err != nil {
+		t.Errorf("Check(...): want a call over a unix socket to succeed, got %v", err)
+	}
+}
+
+func TestTransportWaitForReady(t *testing.T) {
+	cases := map[string]struct {
+		reason string
+		spec   apisv1alpha1.ProviderConfigSpec
+		want   bool
+	}{
+		"FailFast": {
+			reason: "Calls should fail fast while the backend is unavailable by default.",
+			want:   false,
+		},
+		"WaitForReady": {
+			reason: "Calls should wait for the backend to become available if the ProviderConfig enables it.",
+			spec:   apisv1alpha1.ProviderConfigSpec{WaitForReady: true},
+			want:   true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			tr, err := getTransport(context.Background(), nil, tc.spec)
+			if err != nil {
+				t.Fatalf("\n%s\ngetTransport(...): %v", tc.reason, err)
+			}
+			opts, err := tr.dialOptions()
+			if err != nil {
+				t.Fatalf("\n%s\ndialOptions(): %v", tc.reason, err)
+			}
+
+			got := false
+			opts = append(opts, grpc.WithUnaryInterceptor(func(_ context.Context, _ string, _, _ interface{}, _ *grpc.ClientConn, _ grpc.UnaryInvoker, co ...grpc.CallOption) error {
+				for _, o := range co {
+					if ff, ok := o.(grpc.FailFastCallOption); ok {
+						got = !ff.FailFast
+					}
+				}
+				return nil
+			}))
+			conn, err := grpc.Dial("passthrough:///cool", opts...)
+			if err != nil {
+				t.Fatalf("\n%s\ngrpc.Dial(...): %v", tc.reason, err)
+			}
+			defer func() { _ = conn.Close() }()
+
+			if _, err := healthpb.NewHealthClient(conn).Check(context.Background(), &healthpb.HealthCheckRequest{}); err != nil {
+				t.Fatalf("\n%s\nCheck(...): %v", tc.reason, err)
+			}
+			if got != tc.want {
+				t.Errorf("\n%s\ndialOptions(): want wait for ready %t, got %t", tc.reason, tc.want, got)
+			}
+		})
+	}
+}
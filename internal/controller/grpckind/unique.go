@@ -0,0 +1,80 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpckind
+
+import (
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	"github.com/crossplane/provider-grpc/apis/mygroup/v1alpha1"
+	"github.com/crossplane/provider-grpc/internal/grpcerr"
+)
+
+const errItemsRejectedFmt = "backend rejected the items of list %q as duplicates; fix the spec to apply the list again"
+
+// TypeItemsValid indicates whether the backend accepted the items of a
+// GrpcKind's list.
+const TypeItemsValid xpv1.ConditionType = "ItemsValid"
+
+// Condition reasons used for the ItemsValid condition.
+const (
+	reasonValidItems     xpv1.ConditionReason = "ValidItems"
+	reasonDuplicateItems xpv1.ConditionReason = "DuplicateItems"
+)
+
+// itemsRejected returns an error if the backend rejected the items of the
+// GrpcKind's current spec as duplicates. Applying the same items again would
+// fail the same way, so the list isn't applied until the spec changes.
+func itemsRejected(cr *v1alpha1.GrpcKind) error {
+	if g := cr.Status.AtProvider.RejectedGeneration; g != nil && *g == cr.GetGeneration() {
+		return errors.Errorf(errItemsRejectedFmt, logName(cr.Spec.ForProvider.Name))
+	}
+	return nil
+}
+
+// recordItemsValidity records whether the backend rejected the items of the
+// GrpcKind's list as duplicates when it was last applied, which returned the
+// supplied error. It returns true if it did, in which case the attempt
+// shouldn't count towards retrying the list.
+func (c *external) recordItemsValidity(cr *v1alpha1.GrpcKind, err error) bool {
+	if grpcerr.IsDuplicateItems(err) {
+		log.Infof("Backend rejected the items of list \"%v\" as duplicates: %v", logName(cr.Spec.ForProvider.Name), err)
+		g := cr.GetGeneration()
+		cr.Status.AtProvider.RejectedGeneration = &g
+		cr.Status.SetConditions(xpv1.Condition{
+			Type:               TypeItemsValid,
+			Status:             corev1.ConditionFalse,
+			LastTransitionTime: c.now(),
+			Reason:             reasonDuplicateItems,
+			Message:            err.Error(),
+		})
+		return true
+	}
+	if err == nil && cr.Status.AtProvider.RejectedGeneration != nil {
+		cr.Status.AtProvider.RejectedGeneration = nil
+		cr.Status.SetConditions(xpv1.Condition{
+			Type:               TypeItemsValid,
+			Status:             corev1.ConditionTrue,
+			LastTransitionTime: c.now(),
+			Reason:             reasonValidItems,
+		})
+	}
+	return false
+}
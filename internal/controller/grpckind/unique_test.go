@@ -0,0 +1,146 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpckind
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	corev1 "k8s.io/api/core/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	listServicepb "github.com/ashwinshirva/provider-grpc-server/proto"
+)
+
+func TestDuplicateItems(t *testing.T) {
+	errDuplicate := status.Error(codes.InvalidArgument, "list contains duplicate item 1")
+
+	calls := 0
+	e := external{service: &ListService{grpcClient: &fakeListServiceClient{
+		MockUpdateListItems: func(_ context.Context, _ *listServicepb.UpdateListItemsReq, _ ...grpc.CallOption) (*listServicepb.UpdateListItemsResp, error) {
+			calls++
+			if calls == 1 {
+				return nil, errDuplicate
+			}
+			return &listServicepb.UpdateListItemsResp{}, nil
+		},
+	}}}
+	cr := grpcKindWith("cool-list", withItems(1, 1))
+	cr.SetGeneration(1)
+
+	// The backend rejects the duplicate items.
+	if _, err := e.Update(context.Background(), cr); !cmp.Equal(errDuplicate, err, test.EquateErrors()) {
+		t.Fatalf("e.Update(...): want duplicate items error, got %v", err)
+	}
+	want := xpv1.Condition{
+		Type:    TypeItemsValid,
+		Status:  corev1.ConditionFalse,
+		Reason:  reasonDuplicateItems,
+		Message: errDuplicate.Error(),
+	}
+	if diff := cmp.Diff(want, cr.GetCondition(TypeItemsValid), test.EquateConditions()); diff != "" {
+		t.Errorf("e.Update(...): -want condition, +got condition:\n%s\n", diff)
+	}
+	if cr.Status.AtProvider.RetryCount != 0 || cr.Status.AtProvider.NextRetryTime != nil {
+		t.Errorf("e.Update(...): want a rejection not to be retried with backoff, got %d retries", cr.Status.AtProvider.RetryCount)
+	}
+
+	// The rejection is terminal until the spec changes.
+	if _, err := e.Update(context.Background(), cr); err == nil {
+		t.Errorf("e.Update(...): want an error while the spec is unchanged")
+	}
+	if calls != 1 {
+		t.Errorf("e.Update(...): want no UpdateListItems call while the spec is unchanged, got %d calls", calls)
+	}
+
+	// Fixing the spec applies the list again.
+	cr.Spec.ForProvider.ListItems = []int32{1}
+	cr.SetGeneration(2)
+	if _, err := e.Update(context.Background(), cr); err != nil {
+		t.Fatalf("e.Update(...): unexpected error: %v", err)
+	}
+	if calls != 2 {
+		t.Errorf("e.Update(...): want UpdateListItems called once the spec changed, got %d calls", calls)
+	}
+	want = xpv1.Condition{Type: TypeItemsValid, Status: corev1.ConditionTrue, Reason: reasonValidItems}
+	if diff := cmp.Diff(want, cr.GetCondition(TypeItemsValid), test.EquateConditions()); diff != "" {
+		t.Errorf("e.Update(...): -want condition, +got condition:\n%s\n", diff)
+	}
+	if cr.Status.AtProvider.RejectedGeneration != nil {
+		t.Errorf("e.Update(...): want rejected generation cleared, got %d", *cr.Status.AtProvider.RejectedGeneration)
+	}
+}
+
+func TestDuplicateItemsCreate(t *testing.T) {
+	errDuplicate := status.Error(codes.InvalidArgument, "duplicate items")
+	e := external{service: &ListService{grpcClient: &fakeListServiceClient{
+		MockCreateList: func(_ context.Context, _ *listServicepb.CreateListReq, _ ...grpc.CallOption) (*listServicepb.CreateListResp, error) {
+			return nil, errDuplicate
+		},
+	}}}
+	cr := grpcKindWith("cool-list", withItems(1, 1))
+
+	if _, err := e.Create(context.Background(), cr); !cmp.Equal(errDuplicate, err, test.EquateErrors()) {
+		t.Fatalf("e.Create(...): want duplicate items error, got %v", err)
+	}
+	if got := cr.GetCondition(TypeItemsValid); got.Reason != reasonDuplicateItems {
+		t.Errorf("e.Create(...): want %s condition, got %s", reasonDuplicateItems, got.Reason)
+	}
+	if err := itemsRejected(cr); err == nil {
+		t.Errorf("itemsRejected(...): want the rejected spec not to be created again")
+	}
+}
+
+func TestDuplicateItemsCreatePersisted(t *testing.T) {
+	creates := 0
+	lc := &fakeListServiceClient{
+		MockGetList: func(_ context.Context, _ *listServicepb.GetListReq, _ ...grpc.CallOption) (*listServicepb.GetListResp, error) {
+			return nil, status.Error(codes.NotFound, "list cool-list does not exist")
+		},
+		MockCreateList: func(_ context.Context, _ *listServicepb.CreateListReq, _ ...grpc.CallOption) (*listServicepb.CreateListResp, error) {
+			creates++
+			return nil, status.Error(codes.InvalidArgument, "duplicate items")
+		},
+	}
+	cr := grpcKindWith("cool-list", withItems(1, 1))
+	cr.SetName("cool")
+	cr.SetGeneration(1)
+	reconcileOnce := reconcileGrpcKind(t, cr, func() *external {
+		return &external{service: &ListService{grpcClient: lc}}
+	})
+
+	// The rejection must survive the managed reconciler getting the GrpcKind
+	// again once Create returns, or every reconcile creates the list again.
+	got := reconcileOnce()
+	if g := got.Status.AtProvider.RejectedGeneration; g == nil || *g != 1 {
+		t.Errorf("r.Reconcile(...): want rejected generation 1 persisted, got %v", g)
+	}
+	if c := got.GetCondition(TypeItemsValid); c.Status != corev1.ConditionFalse || c.Reason != reasonDuplicateItems {
+		t.Errorf("r.Reconcile(...): want %s condition persisted, got %s %s", reasonDuplicateItems, c.Status, c.Reason)
+	}
+
+	reconcileOnce()
+	if creates != 1 {
+		t.Errorf("r.Reconcile(...): want the rejected spec not created again, got %d creates", creates)
+	}
+}
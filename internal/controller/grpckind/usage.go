@@ -0,0 +1,80 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpckind
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/util/wait"
+
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+)
+
+const errTrackPCUsageTransient = "cannot track ProviderConfig usage because the API server is temporarily unavailable"
+
+// trackBackoff bounds how often Connect retries tracking ProviderConfig usage
+// after the API server failed for a transient reason. Retries also stop once
+// the reconcile context is done.
+var trackBackoff = wait.Backoff{
+	Duration: 100 * time.Millisecond,
+	Factor:   2,
+	Steps:    3,
+}
+
+// transientKubeError returns true if the supplied error indicates the API
+// server failed for a transient reason, such that making the same request
+// again may succeed.
+func transientKubeError(err error) bool {
+	return kerrors.IsServerTimeout(err) ||
+		kerrors.IsTimeout(err) ||
+		kerrors.IsTooManyRequests(err) ||
+		kerrors.IsServiceUnavailable(err) ||
+		kerrors.IsInternalError(err) ||
+		kerrors.IsConflict(err) ||
+		kerrors.IsUnexpectedServerError(err)
+}
+
+// trackUsage tracks that the supplied managed resource uses its
+// ProviderConfig. Transient API server errors are retried with trackBackoff;
+// if they persist the returned error says so, and the managed resource is
+// requeued like for any other error. Other errors, e.g. because the provider
+// isn't allowed to track usage, are returned without retrying.
+func trackUsage(ctx context.Context, t resource.Tracker, mg resource.Managed) error {
+	var err error
+	werr := wait.ExponentialBackoffWithContext(ctx, trackBackoff, func() (bool, error) {
+		err = t.Track(ctx, mg)
+		if transientKubeError(err) {
+			log.Infof("Connect::Retrying tracking ProviderConfig usage: %v", err)
+			return false, nil
+		}
+		return true, nil
+	})
+	switch {
+	case err == nil && werr != nil:
+		// The context was done before usage could be tracked at all.
+		return errors.Wrap(werr, errTrackPCUsage)
+	case transientKubeError(err):
+		return errors.Wrap(err, errTrackPCUsageTransient)
+	case err != nil:
+		return errors.Wrap(err, errTrackPCUsage)
+	}
+	return nil
+}
@@ -0,0 +1,97 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpckind
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+)
+
+func TestTrackUsage(t *testing.T) {
+	trackBackoff.Duration = time.Millisecond
+	defer func() { trackBackoff.Duration = 100 * time.Millisecond }()
+
+	gr := schema.GroupResource{Group: "grpc.crossplane.io", Resource: "providerconfigusages"}
+	errUnavailable := kerrors.NewServiceUnavailable("try again")
+	errForbidden := kerrors.NewForbidden(gr, "cool-usage", errors.New("nope"))
+
+	type want struct {
+		calls int
+		err   error
+	}
+
+	cases := map[string]struct {
+		reason string
+		errs   []error
+		want   want
+	}{
+		"Tracked": {
+			reason: "Usage that's tracked should not be retried.",
+			want:   want{calls: 1},
+		},
+		"TransientThenTracked": {
+			reason: "Usage should be tracked once a transient API server error passes.",
+			errs:   []error{errUnavailable, kerrors.NewConflict(gr, "cool-usage", errors.New("conflict"))},
+			want:   want{calls: 3},
+		},
+		"Transient": {
+			reason: "A transient API server error that persists should be returned as transient.",
+			errs:   []error{errUnavailable, errUnavailable, errUnavailable},
+			want: want{
+				calls: 3,
+				err:   errors.Wrap(errUnavailable, errTrackPCUsageTransient),
+			},
+		},
+		"Permanent": {
+			reason: "An API server error that isn't transient should be returned without retrying.",
+			errs:   []error{errForbidden},
+			want: want{
+				calls: 1,
+				err:   errors.Wrap(errForbidden, errTrackPCUsage),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			calls := 0
+			tr := resource.TrackerFn(func(_ context.Context, _ resource.Managed) error {
+				calls++
+				if calls <= len(tc.errs) {
+					return tc.errs[calls-1]
+				}
+				return nil
+			})
+			err := trackUsage(context.Background(), tr, grpcKind("cool-list"))
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\ntrackUsage(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if calls != tc.want.calls {
+				t.Errorf("\n%s\ntrackUsage(...): want %d calls, got %d", tc.reason, tc.want.calls, calls)
+			}
+		})
+	}
+}
@@ -0,0 +1,99 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpckind
+
+import (
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/metadata"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/version"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	"github.com/crossplane/provider-grpc/apis/mygroup/v1alpha1"
+)
+
+const (
+	errParseVersionFmt       = "cannot parse backend version %q"
+	errUnsupportedVersionFmt = "backend version %s is not supported, want at least %s and less than %s"
+)
+
+// versionKey is the response header metadata key a backend may use to report
+// its version. The ListService has no RPC for it.
+const versionKey = "x-server-version"
+
+// The range of backend versions the controller supports. The minimum is
+// inclusive and the maximum exclusive.
+var (
+	minBackendVersion = version.MustParseSemantic("1.0.0")
+	maxBackendVersion = version.MustParseSemantic("2.0.0")
+)
+
+// TypeBackendCompatible indicates whether the backend a GrpcKind's list is
+// stored in reported a version the controller supports.
+const TypeBackendCompatible xpv1.ConditionType = "BackendCompatible"
+
+// Condition reasons used for the BackendCompatible condition.
+const (
+	reasonSupportedVersion   xpv1.ConditionReason = "SupportedVersion"
+	reasonUnsupportedVersion xpv1.ConditionReason = "UnsupportedVersion"
+)
+
+// checkBackendVersion records the backend version reported in the supplied
+// response header, and sets a condition warning if it's outside the supported
+// range. It checks only the first header it's called with, i.e. once per
+// connection. Backends that don't report a version aren't checked.
+func (c *external) checkBackendVersion(cr *v1alpha1.GrpcKind, header metadata.MD) {
+	if c.versionChecked {
+		return
+	}
+	c.versionChecked = true
+
+	got := header.Get(versionKey)
+	if len(got) == 0 {
+		return
+	}
+	cr.Status.AtProvider.BackendVersion = got[0]
+
+	cond := xpv1.Condition{
+		Type:               TypeBackendCompatible,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: c.now(),
+		Reason:             reasonSupportedVersion,
+	}
+	if err := backendVersionSupported(got[0]); err != nil {
+		log.Infof("Observe::Backend of list \"%v\" is incompatible: %v", logName(cr.Spec.ForProvider.Name), err)
+		cond.Status = corev1.ConditionFalse
+		cond.Reason = reasonUnsupportedVersion
+		cond.Message = err.Error()
+	}
+	cr.Status.SetConditions(cond)
+}
+
+// backendVersionSupported returns an error unless the supplied backend version
+// is a semantic version within the supported range.
+func backendVersionSupported(v string) error {
+	sv, err := version.ParseGeneric(v)
+	if err != nil {
+		return errors.Wrapf(err, errParseVersionFmt, v)
+	}
+	if !sv.AtLeast(minBackendVersion) || sv.AtLeast(maxBackendVersion) {
+		return errors.Errorf(errUnsupportedVersionFmt, v, minBackendVersion, maxBackendVersion)
+	}
+	return nil
+}
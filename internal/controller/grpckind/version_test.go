@@ -0,0 +1,108 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpckind
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/grpc/metadata"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	testingclock "k8s.io/utils/clock/testing"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+func TestCheckBackendVersion(t *testing.T) {
+	now := time.Now().Truncate(time.Second)
+
+	type want struct {
+		version string
+		cond    xpv1.Condition
+	}
+
+	cases := map[string]struct {
+		reason  string
+		headers []metadata.MD
+		want    want
+	}{
+		"NotReported": {
+			reason:  "A backend that doesn't report a version should not be checked.",
+			headers: []metadata.MD{{}},
+			want: want{
+				cond: xpv1.Condition{Type: TypeBackendCompatible, Status: corev1.ConditionUnknown},
+			},
+		},
+		"Supported": {
+			reason:  "A backend version within the supported range should be recorded as compatible.",
+			headers: []metadata.MD{metadata.Pairs(versionKey, "1.4.2")},
+			want: want{
+				version: "1.4.2",
+				cond:    xpv1.Condition{Type: TypeBackendCompatible, Status: corev1.ConditionTrue, Reason: reasonSupportedVersion, LastTransitionTime: metav1.NewTime(now)},
+			},
+		},
+		"TooNew": {
+			reason:  "A backend version at or above the supported maximum should set a warning condition.",
+			headers: []metadata.MD{metadata.Pairs(versionKey, "2.0.0")},
+			want: want{
+				version: "2.0.0",
+				cond:    xpv1.Condition{Type: TypeBackendCompatible, Status: corev1.ConditionFalse, Reason: reasonUnsupportedVersion, LastTransitionTime: metav1.NewTime(now), Message: "backend version 2.0.0 is not supported, want at least 1.0.0 and less than 2.0.0"},
+			},
+		},
+		"TooOld": {
+			reason:  "A backend version below the supported minimum should set a warning condition.",
+			headers: []metadata.MD{metadata.Pairs(versionKey, "0.9")},
+			want: want{
+				version: "0.9",
+				cond:    xpv1.Condition{Type: TypeBackendCompatible, Status: corev1.ConditionFalse, Reason: reasonUnsupportedVersion, LastTransitionTime: metav1.NewTime(now), Message: "backend version 0.9 is not supported, want at least 1.0.0 and less than 2.0.0"},
+			},
+		},
+		"Unparseable": {
+			reason:  "A backend version that can't be parsed should set a warning condition.",
+			headers: []metadata.MD{metadata.Pairs(versionKey, "latest")},
+			want: want{
+				version: "latest",
+				cond:    xpv1.Condition{Type: TypeBackendCompatible, Status: corev1.ConditionFalse, Reason: reasonUnsupportedVersion, LastTransitionTime: metav1.NewTime(now), Message: `cannot parse backend version "latest": could not parse "latest" as version`},
+			},
+		},
+		"CheckedOnce": {
+			reason:  "Only the first version reported on a connection should be checked.",
+			headers: []metadata.MD{metadata.Pairs(versionKey, "3.0.0"), metadata.Pairs(versionKey, "1.0.0")},
+			want: want{
+				version: "3.0.0",
+				cond:    xpv1.Condition{Type: TypeBackendCompatible, Status: corev1.ConditionFalse, Reason: reasonUnsupportedVersion, LastTransitionTime: metav1.NewTime(now), Message: "backend version 3.0.0 is not supported, want at least 1.0.0 and less than 2.0.0"},
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{clock: testingclock.NewFakeClock(now)}
+			cr := grpcKind("cool-list")
+			for _, h := range tc.headers {
+				e.checkBackendVersion(cr, h)
+			}
+			if diff := cmp.Diff(tc.want.version, cr.Status.AtProvider.BackendVersion); diff != "" {
+				t.Errorf("\n%s\ne.checkBackendVersion(...): -want version, +got version:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.cond, cr.GetCondition(TypeBackendCompatible)); diff != "" {
+				t.Errorf("\n%s\ne.checkBackendVersion(...): -want condition, +got condition:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,49 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpckind
+
+import (
+	log "github.com/sirupsen/logrus"
+
+	apisv1alpha1 "github.com/crossplane/provider-grpc/apis/v1alpha1"
+)
+
+// WarmUpConnections makes Setup dial the configured endpoint, i.e. the one
+// ProviderConfigs that don't describe their own use, so that its connection is
+// cached before the first GrpcKind is reconciled.
+var WarmUpConnections = false
+
+// warmUp dials each of the supplied targets in the background using the
+// supplied function, as ProviderConfigs without credentials or TLS settings
+// would, so that reconciles reuse the connections rather than waiting for
+// them to be dialed. Targets that can't be dialed are only logged.
+func warmUp(newService func(target string, creds []byte, t transport) (*ListService, error), targets ...string) {
+	for _, target := range targets {
+		go func(target string) {
+			if _, err := newService(target, nil, transport{}); err != nil {
+				log.Infof("Setup::Cannot warm up the connection to %q: %v", target, err)
+				return
+			}
+			log.Infof("Setup::Warmed up the connection to %q", target)
+		}(target)
+	}
+}
+
+// warmUpTargets returns the endpoints Setup warms up the connections to.
+func (so SetupOptions) warmUpTargets() []string {
+	return []string{dialTarget(apisv1alpha1.ProviderConfigSpec{}, so.Endpoint)}
+}
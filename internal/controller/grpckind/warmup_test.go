@@ -0,0 +1,75 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpckind
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/grpc"
+	"k8s.io/apimachinery/pkg/util/wait"
+)
+
+func TestWarmUp(t *testing.T) {
+	cases := map[string]struct {
+		reason string
+		so     SetupOptions
+		want   []string
+	}{
+		"DefaultAddress": {
+			reason: "The default Address should be dialed if the SetupOptions don't specify an endpoint.",
+			so:     SetupOptions{},
+			want:   []string{Address},
+		},
+		"Endpoint": {
+			reason: "The endpoint the SetupOptions specify should be dialed.",
+			so:     SetupOptions{Endpoint: "backend:50051"},
+			want:   []string{"backend:50051"},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var mu sync.Mutex
+			var dialed []string
+			tc.so.NewService = func(target string, creds []byte, _ ...grpc.DialOption) (*ListService, error) {
+				mu.Lock()
+				defer mu.Unlock()
+				if creds != nil {
+					t.Errorf("\n%s\nwarmUp(...): want no credentials sent, got %q", tc.reason, creds)
+				}
+				dialed = append(dialed, target)
+				return &ListService{}, nil
+			}
+
+			warmUp(tc.so.serviceFn(nil), tc.so.warmUpTargets()...)
+
+			_ = wait.PollImmediate(time.Millisecond, 5*time.Second, func() (bool, error) {
+				mu.Lock()
+				defer mu.Unlock()
+				return len(dialed) >= len(tc.want), nil
+			})
+			mu.Lock()
+			defer mu.Unlock()
+			if diff := cmp.Diff(tc.want, dialed); diff != "" {
+				t.Errorf("\n%s\nwarmUp(...): -want dialed, +got dialed:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,147 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpckind
+
+import (
+	"context"
+	"strings"
+	"sync"
+
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	corev1 "k8s.io/api/core/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	listServicepb "github.com/ashwinshirva/provider-grpc-server/proto"
+	"github.com/crossplane/provider-grpc/apis/mygroup/v1alpha1"
+)
+
+// warningKey is the response trailer metadata key a backend may use to report
+// non-fatal warnings about a call, e.g. that a list is nearly full. It may
+// have several values.
+const warningKey = "x-list-warning"
+
+// TypeBackendWarning indicates whether the backend reported warnings the last
+// time it was called to reconcile a GrpcKind's list.
+const TypeBackendWarning xpv1.ConditionType = "BackendWarning"
+
+// Condition reasons used for the BackendWarning condition.
+const (
+	reasonWarned     xpv1.ConditionReason = "Warned"
+	reasonNoWarnings xpv1.ConditionReason = "NoWarnings"
+)
+
+// An rpcWarnings records the warnings the backend reported in the trailers of
+// the calls made while reconciling a managed resource, until they're recorded
+// as a condition.
+type rpcWarnings struct {
+	mu       sync.Mutex
+	called   bool
+	warnings []string
+}
+
+// record records the warnings in the supplied trailer. A nil rpcWarnings
+// records nothing.
+func (w *rpcWarnings) record(trailer metadata.MD) {
+	if w == nil {
+		return
+	}
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.called = true
+	w.warnings = append(w.warnings, trailer.Get(warningKey)...)
+}
+
+// drain returns the warnings recorded since it was last called, and whether
+// any calls were made since.
+func (w *rpcWarnings) drain() ([]string, bool) {
+	if w == nil {
+		return nil, false
+	}
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	warnings, called := w.warnings, w.called
+	w.warnings, w.called = nil, false
+	return warnings, called
+}
+
+// A warningListService is a ListServiceClient that records the warnings the
+// backend reports in the trailer of each call to the ListServiceClient it
+// wraps.
+type warningListService struct {
+	listServicepb.ListServiceClient
+	warnings *rpcWarnings
+}
+
+func (c *warningListService) CreateList(ctx context.Context, in *listServicepb.CreateListReq, opts ...grpc.CallOption) (*listServicepb.CreateListResp, error) {
+	var trailer metadata.MD
+	resp, err := c.ListServiceClient.CreateList(ctx, in, append(opts, grpc.Trailer(&trailer))...)
+	c.warnings.record(trailer)
+	return resp, err
+}
+
+func (c *warningListService) GetList(ctx context.Context, in *listServicepb.GetListReq, opts ...grpc.CallOption) (*listServicepb.GetListResp, error) {
+	var trailer metadata.MD
+	resp, err := c.ListServiceClient.GetList(ctx, in, append(opts, grpc.Trailer(&trailer))...)
+	c.warnings.record(trailer)
+	return resp, err
+}
+
+func (c *warningListService) UpdateListItems(ctx context.Context, in *listServicepb.UpdateListItemsReq, opts ...grpc.CallOption) (*listServicepb.UpdateListItemsResp, error) {
+	var trailer metadata.MD
+	resp, err := c.ListServiceClient.UpdateListItems(ctx, in, append(opts, grpc.Trailer(&trailer))...)
+	c.warnings.record(trailer)
+	return resp, err
+}
+
+func (c *warningListService) DeleteList(ctx context.Context, in *listServicepb.DeleteListReq, opts ...grpc.CallOption) (*listServicepb.DeleteListResp, error) {
+	var trailer metadata.MD
+	resp, err := c.ListServiceClient.DeleteList(ctx, in, append(opts, grpc.Trailer(&trailer))...)
+	c.warnings.record(trailer)
+	return resp, err
+}
+
+// recordWarnings sets a condition describing the warnings the backend reported
+// in the calls made since it was last called. A GrpcKind that was warned has
+// its condition cleared the next time calls are made without warnings.
+func (c *external) recordWarnings(cr *v1alpha1.GrpcKind) {
+	warnings, called := c.warnings.drain()
+	if !called {
+		return
+	}
+	if len(warnings) == 0 {
+		if cr.GetCondition(TypeBackendWarning).Status == corev1.ConditionTrue {
+			cr.Status.SetConditions(xpv1.Condition{
+				Type:               TypeBackendWarning,
+				Status:             corev1.ConditionFalse,
+				LastTransitionTime: c.now(),
+				Reason:             reasonNoWarnings,
+			})
+		}
+		return
+	}
+	log.Infof("Backend warned about list \"%v\": %s", logName(cr.Spec.ForProvider.Name), strings.Join(warnings, "; "))
+	cr.Status.SetConditions(xpv1.Condition{
+		Type:               TypeBackendWarning,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: c.now(),
+		Reason:             reasonWarned,
+		Message:            strings.Join(warnings, "; "),
+	})
+}
@@ -0,0 +1,96 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpckind
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	corev1 "k8s.io/api/core/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	listServicepb "github.com/ashwinshirva/provider-grpc-server/proto"
+)
+
+func TestBackendWarnings(t *testing.T) {
+	var trailer metadata.MD
+	warnings := &rpcWarnings{}
+	e := &external{
+		service: &ListService{grpcClient: &warningListService{warnings: warnings, ListServiceClient: &fakeListServiceClient{
+			MockGetList: func(_ context.Context, _ *listServicepb.GetListReq, opts ...grpc.CallOption) (*listServicepb.GetListResp, error) {
+				for _, o := range opts {
+					if tr, ok := o.(grpc.TrailerCallOption); ok {
+						*tr.TrailerAddr = trailer
+					}
+				}
+				return &listServicepb.GetListResp{Status: statusSuccess, Items: []int32{1}}, nil
+			},
+		}}},
+		warnings: warnings,
+	}
+	cr := grpcKindWith("cool-list", withItems(1))
+
+	// Warnings in the trailer become the condition's message.
+	trailer = metadata.Pairs(warningKey, "list is nearly full", warningKey, "backend is deprecated")
+	if _, err := e.Observe(context.Background(), cr); err != nil {
+		t.Fatalf("e.Observe(...): %v", err)
+	}
+	want := xpv1.Condition{
+		Type:    TypeBackendWarning,
+		Status:  corev1.ConditionTrue,
+		Reason:  reasonWarned,
+		Message: "list is nearly full; backend is deprecated",
+	}
+	if diff := cmp.Diff(want, cr.GetCondition(TypeBackendWarning), test.EquateConditions()); diff != "" {
+		t.Errorf("e.Observe(...): -want condition, +got condition:\n%s\n", diff)
+	}
+
+	// The condition is cleared once the backend stops warning.
+	trailer = nil
+	if _, err := e.Observe(context.Background(), cr); err != nil {
+		t.Fatalf("e.Observe(...): %v", err)
+	}
+	want = xpv1.Condition{Type: TypeBackendWarning, Status: corev1.ConditionFalse, Reason: reasonNoWarnings}
+	if diff := cmp.Diff(want, cr.GetCondition(TypeBackendWarning), test.EquateConditions()); diff != "" {
+		t.Errorf("e.Observe(...): -want condition, +got condition:\n%s\n", diff)
+	}
+}
+
+func TestBackendWarningsNeverWarned(t *testing.T) {
+	warnings := &rpcWarnings{}
+	e := &external{
+		service: &ListService{grpcClient: &warningListService{warnings: warnings, ListServiceClient: &fakeListServiceClient{
+			MockGetList: func(_ context.Context, _ *listServicepb.GetListReq, _ ...grpc.CallOption) (*listServicepb.GetListResp, error) {
+				return &listServicepb.GetListResp{Status: statusSuccess, Items: []int32{1}}, nil
+			},
+		}}},
+		warnings: warnings,
+	}
+	cr := grpcKindWith("cool-list", withItems(1))
+
+	if _, err := e.Observe(context.Background(), cr); err != nil {
+		t.Fatalf("e.Observe(...): %v", err)
+	}
+	if got := cr.GetCondition(TypeBackendWarning); got.Status != corev1.ConditionUnknown {
+		t.Errorf("e.Observe(...): want no condition for a backend that never warned, got %+v", got)
+	}
+}
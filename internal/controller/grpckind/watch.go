@@ -0,0 +1,224 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpckind
+
+import (
+	"context"
+	"sync"
+
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"k8s.io/apimachinery/pkg/types"
+
+	listServicepb "github.com/ashwinshirva/provider-grpc-server/proto"
+)
+
+// watchListMethod is a server-streaming RPC some backends serve in addition to
+// the generated ListService. It streams the state of the named list, first
+// when the stream starts and then each time the list changes. The stream ends
+// with a NotFound status error if the list doesn't exist or is deleted.
+const watchListMethod = "/proto.ListService/WatchList"
+
+// WatchLists makes the controller watch each list it observes using the
+// WatchList RPC, if the backend serves it, and observe lists using the state
+// the backend last pushed rather than getting them every reconcile.
+var WatchLists = false
+
+// A watchListClient watches lists using the WatchList RPC.
+type watchListClient interface {
+	WatchList(ctx context.Context, in *listServicepb.GetListReq, opts ...grpc.CallOption) (watchListStream, error)
+}
+
+// A watchListStream receives the state of a list each time it changes.
+type watchListStream interface {
+	Header() (metadata.MD, error)
+	Recv() (*listServicepb.GetListResp, error)
+}
+
+// newWatchListClient returns a watchListClient that uses the supplied
+// connection.
+func newWatchListClient(cc grpc.ClientConnInterface) watchListClient {
+	return &watchClient{cc: cc}
+}
+
+type watchClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func (c *watchClient) WatchList(ctx context.Context, in *listServicepb.GetListReq, opts ...grpc.CallOption) (watchListStream, error) {
+	stream, err := c.cc.NewStream(ctx, &grpc.StreamDesc{StreamName: "WatchList", ServerStreams: true}, watchListMethod, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &watchListClientStream{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type watchListClientStream struct {
+	grpc.ClientStream
+}
+
+func (x *watchListClientStream) Recv() (*listServicepb.GetListResp, error) {
+	m := new(listServicepb.GetListResp)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// A listWatches caches the state of each managed resource's list, as pushed by
+// a WatchList stream, so that it may be observed without calling GetList.
+// A watch is stopped when the managed resource's list is written to and
+// started again the next time the list is observed, so that the cache never
+// predates a write. Lists whose watch ended, e.g. because the list was
+// deleted, are observed using GetList until their watch is started again.
+type listWatches struct {
+	mu      sync.Mutex
+	watches map[types.UID]*listWatch
+}
+
+type listWatch struct {
+	name   string
+	cancel context.CancelFunc
+
+	// resp and header are the state of the list the backend last pushed, and
+	// the response header metadata of the stream. resp is nil until the
+	// backend pushed the list's state.
+	resp   *listServicepb.GetListResp
+	header metadata.MD
+
+	// unsupported is true if the backend doesn't serve WatchList.
+	unsupported bool
+}
+
+// newListWatches returns a listWatches that watches no lists.
+func newListWatches() *listWatches {
+	return &listWatches{watches: make(map[types.UID]*listWatch)}
+}
+
+// Get returns the state the backend last pushed of the named list of the
+// managed resource with the supplied UID, along with the response header
+// metadata of its watch. It returns false if the list isn't being watched,
+// or the backend hasn't pushed its state yet. A nil listWatches watches no
+// lists.
+func (w *listWatches) Get(uid types.UID, name string) (*listServicepb.GetListResp, metadata.MD, bool) {
+	if w == nil {
+		return nil, nil, false
+	}
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	lw, ok := w.watches[uid]
+	if !ok || lw.name != name || lw.resp == nil {
+		return nil, nil, false
+	}
+	return lw.resp, lw.header, true
+}
+
+// Start starts watching the named list of the managed resource with the
+// supplied UID using the supplied client, unless it's already being watched
+// or the backend doesn't serve WatchList. The watch outlives the supplied
+// context, but sends the same request metadata, e.g. the tenant. A watch of a
+// list the managed resource no longer manages is stopped. A nil listWatches
+// or client watches no lists.
+func (w *listWatches) Start(ctx context.Context, uid types.UID, name string, c watchListClient) {
+	if w == nil || c == nil {
+		return
+	}
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if lw, ok := w.watches[uid]; ok {
+		if lw.name == name {
+			return
+		}
+		lw.cancel()
+	}
+
+	wctx, cancel := context.WithCancel(context.Background())
+	if md, ok := metadata.FromOutgoingContext(ctx); ok {
+		wctx = metadata.NewOutgoingContext(wctx, md)
+	}
+	lw := &listWatch{name: name, cancel: cancel}
+	w.watches[uid] = lw
+	go w.watch(wctx, uid, lw, c)
+}
+
+// watch receives the state of the list of the supplied watch until its stream
+// ends, which stops the watch.
+func (w *listWatches) watch(ctx context.Context, uid types.UID, lw *listWatch, c watchListClient) {
+	err := func() error {
+		stream, err := c.WatchList(ctx, &listServicepb.GetListReq{Name: lw.name})
+		if err != nil {
+			return err
+		}
+		header, err := stream.Header()
+		if err != nil {
+			return err
+		}
+		for {
+			resp, err := stream.Recv()
+			if err != nil {
+				return err
+			}
+			w.mu.Lock()
+			lw.resp, lw.header = resp, header
+			w.mu.Unlock()
+		}
+	}()
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if w.watches[uid] != lw {
+		// The watch was stopped or replaced.
+		return
+	}
+	if status.Code(err) == codes.Unimplemented {
+		// Keep the watch so that it isn't started again.
+		lw.unsupported, lw.resp = true, nil
+		return
+	}
+	log.Infof("Watch::Watch of list \"%v\" ended: %v", logName(lw.name), err)
+	lw.cancel()
+	delete(w.watches, uid)
+}
+
+// Stop stops watching the list of the managed resource with the supplied UID.
+// It's called when the list is written to, or deleted.
+func (w *listWatches) Stop(uid types.UID) {
+	if w == nil {
+		return
+	}
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	lw, ok := w.watches[uid]
+	if !ok || lw.unsupported {
+		return
+	}
+	lw.cancel()
+	delete(w.watches, uid)
+}
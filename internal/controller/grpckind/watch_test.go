@@ -0,0 +1,227 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpckind
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+
+	listServicepb "github.com/ashwinshirva/provider-grpc-server/proto"
+	"github.com/crossplane/provider-grpc/apis/mygroup/v1alpha1"
+)
+
+// A fakeWatchListClient streams the states sent to its channel to each of its
+// watches. Watches end with the supplied error once the channel is closed.
+type fakeWatchListClient struct {
+	states chan *listServicepb.GetListResp
+	err    error
+
+	mu      sync.Mutex
+	watches int
+}
+
+func (f *fakeWatchListClient) WatchList(ctx context.Context, _ *listServicepb.GetListReq, _ ...grpc.CallOption) (watchListStream, error) {
+	f.mu.Lock()
+	f.watches++
+	f.mu.Unlock()
+	return &fakeWatchListStream{ctx: ctx, client: f}, nil
+}
+
+func (f *fakeWatchListClient) started() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.watches
+}
+
+// waitStarted returns how many watches were started, once the supplied number
+// were or it timed out. Watches are started asynchronously.
+func (f *fakeWatchListClient) waitStarted(n int) int {
+	_ = wait.PollImmediate(time.Millisecond, time.Second, func() (bool, error) {
+		return f.started() >= n, nil
+	})
+	return f.started()
+}
+
+type fakeWatchListStream struct {
+	ctx    context.Context
+	client *fakeWatchListClient
+}
+
+func (s *fakeWatchListStream) Header() (metadata.MD, error) { return metadata.MD{}, nil }
+
+func (s *fakeWatchListStream) Recv() (*listServicepb.GetListResp, error) {
+	select {
+	case resp, ok := <-s.client.states:
+		if !ok {
+			return nil, s.client.err
+		}
+		return resp, nil
+	case <-s.ctx.Done():
+		return nil, s.ctx.Err()
+	}
+}
+
+// waitForWatch waits until the state the watch of the supplied list caches has
+// the supplied items.
+func waitForWatch(t *testing.T, w *listWatches, uid types.UID, name string, items []int32) {
+	t.Helper()
+	err := wait.PollImmediate(time.Millisecond, 5*time.Second, func() (bool, error) {
+		resp, _, ok := w.Get(uid, name)
+		return ok && cmp.Equal(items, resp.GetItems()), nil
+	})
+	if err != nil {
+		t.Fatalf("w.Get(...): want the watch to cache items %v: %v", items, err)
+	}
+}
+
+func TestObserveWatch(t *testing.T) {
+	gets := 0
+	wc := &fakeWatchListClient{states: make(chan *listServicepb.GetListResp)}
+	w := newListWatches()
+	e := external{watches: w, service: &ListService{
+		watchClient: wc,
+		grpcClient: &fakeListServiceClient{
+			MockGetList: func(_ context.Context, _ *listServicepb.GetListReq, _ ...grpc.CallOption) (*listServicepb.GetListResp, error) {
+				gets++
+				return &listServicepb.GetListResp{Status: statusSuccess, Items: []int32{1, 2}}, nil
+			},
+			MockUpdateListItems: func(_ context.Context, _ *listServicepb.UpdateListItemsReq, _ ...grpc.CallOption) (*listServicepb.UpdateListItemsResp, error) {
+				return &listServicepb.UpdateListItemsResp{Status: statusSuccess}, nil
+			},
+		},
+	}}
+	cr := grpcKindWith("cool-list", withItems(1, 2))
+	cr.SetUID(types.UID("cool-uid"))
+
+	observe := func(step string, wantUpToDate bool, wantGets int) {
+		t.Helper()
+		o, err := e.Observe(context.Background(), cr)
+		if err != nil {
+			t.Fatalf("%s: e.Observe(...): %v", step, err)
+		}
+		want := managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: wantUpToDate, ConnectionDetails: managed.ConnectionDetails{}}
+		if diff := cmp.Diff(want, o); diff != "" {
+			t.Errorf("%s: e.Observe(...): -want, +got:\n%s\n", step, diff)
+		}
+		if gets != wantGets {
+			t.Errorf("%s: e.Observe(...): want %d GetList calls, got %d", step, wantGets, gets)
+		}
+	}
+
+	// The list is got the first time it's observed, which starts watching it.
+	observe("Unwatched", true, 1)
+
+	// Once the backend pushes the list's state it's observed from the cache.
+	wc.states <- &listServicepb.GetListResp{Status: statusSuccess, Items: []int32{1, 2}}
+	waitForWatch(t, w, cr.GetUID(), "cool-list", []int32{1, 2})
+	observe("Watched", true, 1)
+
+	// Changes the backend pushes are observed without getting the list.
+	wc.states <- &listServicepb.GetListResp{Status: statusSuccess, Items: []int32{1}}
+	waitForWatch(t, w, cr.GetUID(), "cool-list", []int32{1})
+	observe("Drifted", false, 1)
+
+	// Updating the list stops watching it, so it's got when next observed,
+	// which watches it again.
+	if _, err := e.Update(context.Background(), cr); err != nil {
+		t.Fatalf("e.Update(...): %v", err)
+	}
+	observe("Updated", true, 2)
+	if got := wc.waitStarted(2); got != 2 {
+		t.Errorf("e.Observe(...): want the list watched again after it was updated, got %d watches", got)
+	}
+}
+
+func TestListWatchesEnded(t *testing.T) {
+	cases := map[string]struct {
+		reason  string
+		err     error
+		watches int
+	}{
+		"NotFound": {
+			reason:  "A watch that ended, e.g. because its list was deleted, should be started again.",
+			err:     status.Error(codes.NotFound, "list cool-list does not exist"),
+			watches: 2,
+		},
+		"Unimplemented": {
+			reason:  "A list whose backend doesn't serve WatchList should not be watched again.",
+			err:     status.Error(codes.Unimplemented, "unknown method WatchList"),
+			watches: 1,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			wc := &fakeWatchListClient{states: make(chan *listServicepb.GetListResp), err: tc.err}
+			close(wc.states)
+			w := newListWatches()
+			uid := types.UID("cool-uid")
+
+			w.Start(context.Background(), uid, "cool-list", wc)
+			err := wait.PollImmediate(time.Millisecond, 5*time.Second, func() (bool, error) {
+				w.mu.Lock()
+				defer w.mu.Unlock()
+				lw, ok := w.watches[uid]
+				return !ok || lw.unsupported, nil
+			})
+			if err != nil {
+				t.Fatalf("\n%s\nw.Start(...): want the watch to end: %v", tc.reason, err)
+			}
+			if _, _, ok := w.Get(uid, "cool-list"); ok {
+				t.Errorf("\n%s\nw.Get(...): want no cached state once the watch ended", tc.reason)
+			}
+
+			w.Start(context.Background(), uid, "cool-list", wc)
+			if diff := cmp.Diff(tc.watches, wc.waitStarted(tc.watches)); diff != "" {
+				t.Errorf("\n%s\nw.Start(...): -want watches, +got watches:\n%s\n", tc.reason, diff)
+			}
+			w.Stop(uid)
+		})
+	}
+}
+
+func TestObserveUnwatched(t *testing.T) {
+	var w *listWatches
+	e := external{watches: w, service: &ListService{
+		watchClient: &fakeWatchListClient{},
+		grpcClient: &fakeListServiceClient{
+			MockGetList: func(_ context.Context, _ *listServicepb.GetListReq, _ ...grpc.CallOption) (*listServicepb.GetListResp, error) {
+				return &listServicepb.GetListResp{Status: statusSuccess, Items: []int32{1}}, nil
+			},
+		},
+	}}
+	cr := grpcKindWith("cool-list", withItems(1), func(cr *v1alpha1.GrpcKind) { cr.SetUID(types.UID("cool-uid")) })
+
+	if _, err := e.Observe(context.Background(), cr); err != nil {
+		t.Fatalf("e.Observe(...): %v", err)
+	}
+	if got := e.service.watchClient.(*fakeWatchListClient).started(); got != 0 {
+		t.Errorf("e.Observe(...): want no list watched unless watching is enabled, got %d watches", got)
+	}
+}
@@ -0,0 +1,174 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpckind
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-grpc/apis/mygroup/v1alpha1"
+	apisv1alpha1 "github.com/crossplane/provider-grpc/apis/v1alpha1"
+)
+
+const (
+	errIndexListName    = "cannot index GrpcKinds by list name"
+	errSetupWebhook     = "cannot set up GrpcKind webhook"
+	errListGrpcKinds    = "cannot list GrpcKinds"
+	errListNameTakenFmt = "list %q of ProviderConfig %q is already managed by GrpcKind %q"
+)
+
+// listNameIndex indexes GrpcKinds by the ProviderConfig and identity of the
+// backend list they manage, i.e. its name and tenant.
+const listNameIndex = "spec.forProvider.name+providerConfigRef"
+
+// listNameKey returns the listNameIndex key of the supplied GrpcKind.
+func listNameKey(cr *v1alpha1.GrpcKind) string {
+	return providerConfigName(cr) + "/" + listID(cr)
+}
+
+// providerConfigName returns the name of the supplied GrpcKind's
+// ProviderConfig, if it references one.
+func providerConfigName(cr *v1alpha1.GrpcKind) string {
+	if ref := cr.GetProviderConfigReference(); ref != nil {
+		return ref.Name
+	}
+	return ""
+}
+
+// indexListName is a client.IndexerFunc for listNameIndex.
+func indexListName(o client.Object) []string {
+	cr, ok := o.(*v1alpha1.GrpcKind)
+	if !ok {
+		return nil
+	}
+	return []string{listNameKey(cr)}
+}
+
+// SetupWebhook adds a webhook that validates GrpcKind managed resources. It
+// also serves conversion between the v1alpha1, v1alpha2, and v1alpha3 GrpcKind
+// APIs.
+//
+// +kubebuilder:webhook:verbs=create;update,path=/validate-mygroup-grpc-crossplane-io-v1alpha1-grpckind,mutating=false,failurePolicy=fail,groups=mygroup.grpc.crossplane.io,resources=grpckinds,versions=v1alpha1,name=grpckinds.mygroup.grpc.crossplane.io,sideEffects=None,admissionReviewVersions=v1
+func SetupWebhook(mgr ctrl.Manager) error {
+	if err := mgr.GetFieldIndexer().IndexField(context.Background(), &v1alpha1.GrpcKind{}, listNameIndex, indexListName); err != nil {
+		return errors.Wrap(err, errIndexListName)
+	}
+	return errors.Wrap(ctrl.NewWebhookManagedBy(mgr).
+		For(&v1alpha1.GrpcKind{}).
+		WithValidator(&grpcKindValidator{kube: mgr.GetClient()}).
+		Complete(), errSetupWebhook)
+}
+
+// A grpcKindValidator rejects GrpcKinds that would manage the same backend
+// list as another GrpcKind, which would otherwise fight over it, and GrpcKinds
+// with more list items than their ProviderConfig allows. GrpcKinds created at
+// the same time may still collide, because each is validated before the other
+// exists. Updates are only validated if they change the list or ProviderConfig
+// of a GrpcKind that isn't being deleted.
+type grpcKindValidator struct {
+	kube client.Reader
+}
+
+func (v *grpcKindValidator) ValidateCreate(ctx context.Context, obj runtime.Object) error {
+	cr, ok := obj.(*v1alpha1.GrpcKind)
+	if !ok {
+		return ErrNotGrpcKind
+	}
+	return v.validate(ctx, cr)
+}
+
+func (v *grpcKindValidator) ValidateUpdate(ctx context.Context, oldObj, obj runtime.Object) error {
+	old, ok := oldObj.(*v1alpha1.GrpcKind)
+	if !ok {
+		return ErrNotGrpcKind
+	}
+	cr, ok := obj.(*v1alpha1.GrpcKind)
+	if !ok {
+		return ErrNotGrpcKind
+	}
+	// GrpcKinds that were admitted must remain updatable, e.g. so that their
+	// finalizer can be removed once they're deleted, even if another GrpcKind
+	// now manages their list or their ProviderConfig allows fewer items.
+	if meta.WasDeleted(cr) || !specChanged(old, cr) {
+		return nil
+	}
+	return v.validate(ctx, cr)
+}
+
+func (v *grpcKindValidator) ValidateDelete(context.Context, runtime.Object) error {
+	return nil
+}
+
+// validate returns an error if the supplied GrpcKind may not be admitted.
+func (v *grpcKindValidator) validate(ctx context.Context, cr *v1alpha1.GrpcKind) error {
+	if err := v.validateListName(ctx, cr); err != nil {
+		return err
+	}
+	return v.validateListSize(ctx, cr)
+}
+
+// specChanged returns true if the supplied GrpcKinds differ in what they're
+// validated against, i.e. their list or their ProviderConfig.
+func specChanged(old, cr *v1alpha1.GrpcKind) bool {
+	return !reflect.DeepEqual(old.Spec.ForProvider, cr.Spec.ForProvider) || providerConfigName(old) != providerConfigName(cr)
+}
+
+// validateListName returns an error if a GrpcKind other than the supplied one
+// manages the same backend list.
+func (v *grpcKindValidator) validateListName(ctx context.Context, cr *v1alpha1.GrpcKind) error {
+	// GrpcKinds without a name manage no list until their ProviderConfig
+	// generates a name for it, which is unique.
+	if cr.Spec.ForProvider.Name == "" {
+		return nil
+	}
+	l := &v1alpha1.GrpcKindList{}
+	if err := v.kube.List(ctx, l, client.MatchingFields{listNameIndex: listNameKey(cr)}); err != nil {
+		return errors.Wrap(err, errListGrpcKinds)
+	}
+	for _, other := range l.Items {
+		if other.GetName() == cr.GetName() {
+			continue
+		}
+		return errors.Errorf(errListNameTakenFmt, listID(cr), providerConfigName(cr), other.GetName())
+	}
+	return nil
+}
+
+// validateListSize returns an error if the supplied GrpcKind has more list
+// items than its ProviderConfig allows. GrpcKinds whose ProviderConfig doesn't
+// exist yet are checked once they're reconciled, as are items read from a
+// ConfigMap.
+func (v *grpcKindValidator) validateListSize(ctx context.Context, cr *v1alpha1.GrpcKind) error {
+	name := providerConfigName(cr)
+	if name == "" {
+		return nil
+	}
+	pc := &apisv1alpha1.ProviderConfig{}
+	if err := v.kube.Get(ctx, types.NamespacedName{Name: name}, pc); err != nil {
+		return errors.Wrap(resource.IgnoreNotFound(err), errGetPC)
+	}
+	return checkListSize(pc.Spec, cr)
+}
@@ -0,0 +1,244 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpckind
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-grpc/apis/mygroup/v1alpha1"
+	apisv1alpha1 "github.com/crossplane/provider-grpc/apis/v1alpha1"
+)
+
+// indexedClient returns a client that lists the supplied GrpcKinds matching
+// the listNameIndex field selector. It gets ProviderConfigs that allow any
+// number of list items.
+func indexedClient(existing ...*v1alpha1.GrpcKind) client.Reader {
+	return &test.MockClient{
+		MockGet: test.NewMockGetFn(nil),
+		MockList: func(_ context.Context, obj client.ObjectList, opts ...client.ListOption) error {
+			lo := &client.ListOptions{}
+			lo.ApplyOptions(opts)
+			key, _ := lo.FieldSelector.RequiresExactMatch(listNameIndex)
+			l := obj.(*v1alpha1.GrpcKindList)
+			for _, cr := range existing {
+				if indexListName(cr)[0] == key {
+					l.Items = append(l.Items, *cr)
+				}
+			}
+			return nil
+		},
+	}
+}
+
+func managedGrpcKind(name, list, pc string, mods ...grpcKindModifier) *v1alpha1.GrpcKind {
+	return grpcKindWith(list, append([]grpcKindModifier{func(cr *v1alpha1.GrpcKind) {
+		cr.SetName(name)
+		cr.SetProviderConfigReference(&xpv1.Reference{Name: pc})
+	}}, mods...)...)
+}
+
+func TestValidateListName(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	cases := map[string]struct {
+		reason string
+		kube   client.Reader
+		cr     *v1alpha1.GrpcKind
+		want   error
+	}{
+		"Collision": {
+			reason: "A GrpcKind managing the same list of the same ProviderConfig as another should be rejected.",
+			kube:   indexedClient(managedGrpcKind("first", "cool-list", "default")),
+			cr:     managedGrpcKind("second", "cool-list", "default"),
+			want:   errors.Errorf(errListNameTakenFmt, "cool-list", "default", "first"),
+		},
+		"DistinctName": {
+			reason: "A GrpcKind managing a list no other GrpcKind manages should be accepted.",
+			kube:   indexedClient(managedGrpcKind("first", "cool-list", "default")),
+			cr:     managedGrpcKind("second", "other-list", "default"),
+		},
+		"DistinctProviderConfig": {
+			reason: "A GrpcKind managing a list of the same name on another ProviderConfig should be accepted.",
+			kube:   indexedClient(managedGrpcKind("first", "cool-list", "default")),
+			cr:     managedGrpcKind("second", "cool-list", "other"),
+		},
+		"DistinctTenant": {
+			reason: "A GrpcKind managing a list of the same name of another tenant should be accepted.",
+			kube:   indexedClient(managedGrpcKind("first", "cool-list", "default", withListTenant("acme"))),
+			cr:     managedGrpcKind("second", "cool-list", "default", withListTenant("globex")),
+		},
+		"SameTenant": {
+			reason: "A GrpcKind managing the same list of the same tenant as another should be rejected.",
+			kube:   indexedClient(managedGrpcKind("first", "cool-list", "default", withListTenant("acme"))),
+			cr:     managedGrpcKind("second", "cool-list", "default", withListTenant("acme")),
+			want:   errors.Errorf(errListNameTakenFmt, "acme/cool-list", "default", "first"),
+		},
+		"Self": {
+			reason: "A GrpcKind should not collide with itself when it is updated.",
+			kube:   indexedClient(managedGrpcKind("first", "cool-list", "default")),
+			cr:     managedGrpcKind("first", "cool-list", "default"),
+		},
+		"EmptyName": {
+			reason: "GrpcKinds without a name should not collide, since each is named uniquely or rejected when reconciled.",
+			kube:   indexedClient(managedGrpcKind("first", "", "default")),
+			cr:     managedGrpcKind("second", "", "default"),
+		},
+		"ListError": {
+			reason: "Errors listing GrpcKinds should be returned.",
+			kube: &test.MockClient{
+				MockList: test.NewMockListFn(errBoom),
+			},
+			cr:   managedGrpcKind("second", "cool-list", "default"),
+			want: errors.Wrap(errBoom, errListGrpcKinds),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			v := &grpcKindValidator{kube: tc.kube}
+			err := v.ValidateCreate(context.Background(), tc.cr)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nv.ValidateCreate(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			old := tc.cr.DeepCopy()
+			old.Spec.ForProvider.Description = strPtr("previous")
+			err = v.ValidateUpdate(context.Background(), old, tc.cr)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nv.ValidateUpdate(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestValidateListSize(t *testing.T) {
+	errBoom := errors.New("boom")
+	max := func(n int32) func(obj client.Object) error {
+		return func(obj client.Object) error {
+			obj.(*apisv1alpha1.ProviderConfig).Spec.MaxListItems = &n
+			return nil
+		}
+	}
+
+	cases := map[string]struct {
+		reason string
+		get    test.MockGetFn
+		cr     *v1alpha1.GrpcKind
+		want   error
+	}{
+		"TooManyItems": {
+			reason: "A GrpcKind with more list items than its ProviderConfig allows should be rejected.",
+			get:    test.NewMockGetFn(nil, max(2)),
+			cr:     managedGrpcKind("cool", "cool-list", "default", withItems(1, 2, 3)),
+			want:   errors.Errorf(errTooManyItemsFmt, 3, 2),
+		},
+		"WithinLimit": {
+			reason: "A GrpcKind with no more list items than its ProviderConfig allows should be accepted.",
+			get:    test.NewMockGetFn(nil, max(2)),
+			cr:     managedGrpcKind("cool", "cool-list", "default", withItems(1, 2)),
+		},
+		"NoProviderConfig": {
+			reason: "A GrpcKind whose ProviderConfig doesn't exist yet should be accepted, and checked once it's reconciled.",
+			get:    test.NewMockGetFn(kerrors.NewNotFound(schema.GroupResource{}, "default")),
+			cr:     managedGrpcKind("cool", "cool-list", "default", withItems(1, 2, 3)),
+		},
+		"GetError": {
+			reason: "Errors getting the ProviderConfig should be returned.",
+			get:    test.NewMockGetFn(errBoom),
+			cr:     managedGrpcKind("cool", "cool-list", "default", withItems(1)),
+			want:   errors.Wrap(errBoom, errGetPC),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			kube := indexedClient().(*test.MockClient)
+			kube.MockGet = tc.get
+			v := &grpcKindValidator{kube: kube}
+			err := v.ValidateCreate(context.Background(), tc.cr)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nv.ValidateCreate(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			old := tc.cr.DeepCopy()
+			old.Spec.ForProvider.Description = strPtr("previous")
+			err = v.ValidateUpdate(context.Background(), old, tc.cr)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nv.ValidateUpdate(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestValidateUpdate(t *testing.T) {
+	taken := errors.Errorf(errListNameTakenFmt, "cool-list", "default", "first")
+	kube := indexedClient(managedGrpcKind("first", "cool-list", "default"))
+
+	cases := map[string]struct {
+		reason string
+		old    *v1alpha1.GrpcKind
+		cr     *v1alpha1.GrpcKind
+		want   error
+	}{
+		"SpecChanged": {
+			reason: "A GrpcKind whose list changed should be validated again.",
+			old:    managedGrpcKind("second", "other-list", "default"),
+			cr:     managedGrpcKind("second", "cool-list", "default"),
+			want:   taken,
+		},
+		"ProviderConfigChanged": {
+			reason: "A GrpcKind whose ProviderConfig changed should be validated again.",
+			old:    managedGrpcKind("second", "cool-list", "other"),
+			cr:     managedGrpcKind("second", "cool-list", "default"),
+			want:   taken,
+		},
+		"SpecUnchanged": {
+			reason: "A GrpcKind whose list didn't change should be accepted, e.g. when its labels are updated.",
+			old:    managedGrpcKind("second", "cool-list", "default"),
+			cr: managedGrpcKind("second", "cool-list", "default", func(cr *v1alpha1.GrpcKind) {
+				cr.SetLabels(map[string]string{"cool": "true"})
+			}),
+		},
+		"Deleted": {
+			reason: "A GrpcKind that was deleted should be accepted, so that its finalizer can be removed.",
+			old:    managedGrpcKind("second", "other-list", "default"),
+			cr: managedGrpcKind("second", "cool-list", "default", func(cr *v1alpha1.GrpcKind) {
+				now := metav1.Now()
+				cr.SetDeletionTimestamp(&now)
+			}),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			v := &grpcKindValidator{kube: kube}
+			err := v.ValidateUpdate(context.Background(), tc.old, tc.cr)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nv.ValidateUpdate(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,46 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package grpcerr classifies errors returned by gRPC backends.
+package grpcerr
+
+import (
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// IsRetryable returns true if the supplied error indicates a call failed for a
+// transient reason, such that making the same call again may succeed. Errors
+// that aren't gRPC status errors are not retryable.
+func IsRetryable(err error) bool {
+	switch status.Code(err) {
+	case codes.Unavailable, codes.DeadlineExceeded, codes.ResourceExhausted, codes.Aborted:
+		return true
+	default:
+		return false
+	}
+}
+
+// IsDuplicateItems returns true if the supplied error indicates the backend
+// rejected a list because it enforces that its items are unique and they
+// aren't. Backends report this as an InvalidArgument status error whose message
+// mentions duplicate items. Making the same call again won't succeed.
+func IsDuplicateItems(err error) bool {
+	s, ok := status.FromError(err)
+	return ok && s.Code() == codes.InvalidArgument && strings.Contains(strings.ToLower(s.Message()), "duplicate")
+}
@@ -0,0 +1,82 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpcerr
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestIsRetryable(t *testing.T) {
+	cases := map[string]struct {
+		err  error
+		want bool
+	}{
+		"Nil":                {err: nil, want: false},
+		"NotStatus":          {err: errors.New("boom"), want: false},
+		"OK":                 {err: status.Error(codes.OK, ""), want: false},
+		"Canceled":           {err: status.Error(codes.Canceled, "boom"), want: false},
+		"Unknown":            {err: status.Error(codes.Unknown, "boom"), want: false},
+		"InvalidArgument":    {err: status.Error(codes.InvalidArgument, "boom"), want: false},
+		"DeadlineExceeded":   {err: status.Error(codes.DeadlineExceeded, "boom"), want: true},
+		"NotFound":           {err: status.Error(codes.NotFound, "boom"), want: false},
+		"AlreadyExists":      {err: status.Error(codes.AlreadyExists, "boom"), want: false},
+		"PermissionDenied":   {err: status.Error(codes.PermissionDenied, "boom"), want: false},
+		"ResourceExhausted":  {err: status.Error(codes.ResourceExhausted, "boom"), want: true},
+		"FailedPrecondition": {err: status.Error(codes.FailedPrecondition, "boom"), want: false},
+		"Aborted":            {err: status.Error(codes.Aborted, "boom"), want: true},
+		"OutOfRange":         {err: status.Error(codes.OutOfRange, "boom"), want: false},
+		"Unimplemented":      {err: status.Error(codes.Unimplemented, "boom"), want: false},
+		"Internal":           {err: status.Error(codes.Internal, "boom"), want: false},
+		"Unavailable":        {err: status.Error(codes.Unavailable, "boom"), want: true},
+		"DataLoss":           {err: status.Error(codes.DataLoss, "boom"), want: false},
+		"Unauthenticated":    {err: status.Error(codes.Unauthenticated, "boom"), want: false},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if got := IsRetryable(tc.err); got != tc.want {
+				t.Errorf("IsRetryable(%v): want %t, got %t", tc.err, tc.want, got)
+			}
+		})
+	}
+}
+
+func TestIsDuplicateItems(t *testing.T) {
+	cases := map[string]struct {
+		err  error
+		want bool
+	}{
+		"Nil":             {err: nil, want: false},
+		"NotStatus":       {err: errors.New("duplicate item 1"), want: false},
+		"Duplicate":       {err: status.Error(codes.InvalidArgument, "list contains duplicate item 1"), want: true},
+		"DuplicateCase":   {err: status.Error(codes.InvalidArgument, "Duplicate items: [1]"), want: true},
+		"InvalidArgument": {err: status.Error(codes.InvalidArgument, "boom"), want: false},
+		"OtherCode":       {err: status.Error(codes.Internal, "duplicate item 1"), want: false},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if got := IsDuplicateItems(tc.err); got != tc.want {
+				t.Errorf("IsDuplicateItems(%v): want %t, got %t", tc.err, tc.want, got)
+			}
+		})
+	}
+}